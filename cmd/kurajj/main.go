@@ -7,16 +7,19 @@ import (
 	"Kurajj/internal/repository"
 	logic "Kurajj/internal/services"
 	zlog "Kurajj/pkg/logger"
+	"context"
 	"flag"
 	"github.com/joho/godotenv"
 	"os"
+	"time"
 )
 
 var (
-	dbConfig      = flag.String("db-config", "configs/db.yaml", "Provide Database's config values")
-	authConfig    = flag.String("auth-config", "configs/auth.yaml", "Provide Authentication's config values")
-	emailConfig   = flag.String("admin-config", "configs/gmail.yaml", "Provide Email's config values")
-	messageConfig = flag.String("message-config", "configs/message.yaml", "Provide Message's config values")
+	dbConfig        = flag.String("db-config", "configs/db.yaml", "Provide Database's config values")
+	authConfig      = flag.String("auth-config", "configs/auth.yaml", "Provide Authentication's config values")
+	emailConfig     = flag.String("admin-config", "configs/gmail.yaml", "Provide Email's config values")
+	messageConfig   = flag.String("message-config", "configs/message.yaml", "Provide Message's config values")
+	schedulerConfig = flag.String("scheduler-config", "configs/scheduler.yaml", "Provide Scheduler's config values")
 )
 
 var port = flag.Int("port", 8080, "HTTP server port number")
@@ -69,6 +72,12 @@ func main() {
 		os.Exit(1)
 	}
 
+	schedulerConfig, err := configs.NewSchedulerConfigFromFile(*schedulerConfig)
+	if err != nil {
+		zlog.Log.Error(err, "could not read scheduler config")
+		os.Exit(1)
+	}
+
 	conn, err := repository.NewConnector(dbConfig)
 	if err != nil {
 		zlog.Log.Error(err, "could not create connector")
@@ -93,7 +102,7 @@ func main() {
 		BucketName:      s3Bucket,
 	})
 
-	service := logic.New(repo, &authConfig, &emailConfig, &messageConfig)
+	service := logic.New(repo, &authConfig, &emailConfig, &messageConfig, &schedulerConfig)
 	handlers := handlers2.New(service)
 
 	httpServer, err := server.NewHTTPServer(*port, server.TLSCertPair{
@@ -101,4 +110,11 @@ func main() {
 		Cert: *privateCertPath,
 	}, handlers.InitRoutes())
 	httpServer.Run()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	err = service.Stop(ctx)
+	if err != nil {
+		zlog.Log.Error(err, "could not stop background jobs")
+	}
 }
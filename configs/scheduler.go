@@ -0,0 +1,50 @@
+package configs
+
+import (
+	"gopkg.in/yaml.v3"
+	"os"
+	"time"
+)
+
+const (
+	defaultSchedulerTimezone  = "Europe/Kiev"
+	defaultSchedulerBatchSize = 100
+)
+
+type Scheduler struct {
+	// Timezone is the zone event dates are entered in. Dates are stored without time zone,
+	// so deadlines are compared with the wall clock of this zone.
+	Timezone  string `yaml:"timezone"`
+	BatchSize int    `yaml:"batchSize"`
+	location  *time.Location
+}
+
+func NewSchedulerConfigFromFile(filename string) (Scheduler, error) {
+	configData, err := os.ReadFile(filename)
+	if err != nil {
+		return Scheduler{}, err
+	}
+	config := Scheduler{}
+	if err := yaml.Unmarshal(configData, &config); err != nil {
+		return Scheduler{}, err
+	}
+	if config.Timezone == "" {
+		config.Timezone = defaultSchedulerTimezone
+	}
+	if config.BatchSize <= 0 {
+		config.BatchSize = defaultSchedulerBatchSize
+	}
+	config.location, err = time.LoadLocation(config.Timezone)
+	if err != nil {
+		return Scheduler{}, err
+	}
+
+	return config, nil
+}
+
+func (s Scheduler) Location() *time.Location {
+	if s.location == nil {
+		return time.UTC
+	}
+	return s.location
+}
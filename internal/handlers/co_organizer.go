@@ -0,0 +1,150 @@
+package handlers
+
+import (
+	"Kurajj/internal/models"
+	httpHelper "Kurajj/pkg/http"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/gorilla/mux"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+func (h *Handler) initCoOrganizerHandlers(events *mux.Router) {
+	events.HandleFunc("/{type}/{id}/co-organizers", h.handleAddCoOrganizer).Methods(http.MethodPost)
+	events.HandleFunc("/{type}/{id}/co-organizers/{memberID}", h.handleRemoveCoOrganizer).Methods(http.MethodDelete)
+}
+
+// handleAddCoOrganizer adds a co-organizer to the user's help or proposal event
+// @Summary      Lets the member manage the user's help or proposal event. Editors edit the event, transaction managers accept and complete its transactions. Adding the member again changes the role.
+// @Tags         Co-organizer
+// @Accept       json
+// @Param        type path string true "Event type" Enums(proposal, help)
+// @Param        id   path int  true  "ID"
+// @Param request body models.CoOrganizerRequest true "query params"
+// @Success      200
+// @Failure      400  {object}  models.ErrResponse
+// @Failure      401  {object}  models.ErrResponse
+// @Failure      403  {object}  models.ErrResponse
+// @Failure      404  {object}  models.ErrResponse
+// @Failure      408  {object}  models.ErrResponse
+// @Failure      500  {object}  models.ErrResponse
+// @Router       /api/events/{type}/{id}/co-organizers [post]
+func (h *Handler) handleAddCoOrganizer(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+	eventID, eventType, ok := parseEventPath(w, r)
+	if !ok {
+		return
+	}
+
+	userID, ok := r.Context().Value(MemberIDContextKey).(uint)
+	if !ok {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, "user id isn't in context")
+		return
+	}
+
+	request, err := models.UnmarshalCoOrganizerRequest(&r.Body)
+	if err != nil {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	eventch := make(chan errResponse)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	go func() {
+		err := h.services.AddCoOrganizer(ctx, eventID, eventType, userID, request)
+
+		eventch <- errResponse{
+			err: err,
+		}
+	}()
+	select {
+	case <-ctx.Done():
+		httpHelper.SendErrorResponse(w, http.StatusRequestTimeout,
+			fmt.Sprintf("adding co-organizer of event with id - %d took too long", eventID))
+		return
+	case resp := <-eventch:
+		if resp.err != nil {
+			sendCoOrganizerError(w, resp.err)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// handleRemoveCoOrganizer removes a co-organizer from the help or proposal event
+// @Summary      Removes the co-organizer from the help or proposal event. The author removes any co-organizer, a co-organizer can leave the event.
+// @Tags         Co-organizer
+// @Accept       json
+// @Param        type     path string true "Event type" Enums(proposal, help)
+// @Param        id       path int  true  "ID"
+// @Param        memberID path int  true  "Co-organizer ID"
+// @Success      200
+// @Failure      400  {object}  models.ErrResponse
+// @Failure      401  {object}  models.ErrResponse
+// @Failure      403  {object}  models.ErrResponse
+// @Failure      404  {object}  models.ErrResponse
+// @Failure      408  {object}  models.ErrResponse
+// @Failure      500  {object}  models.ErrResponse
+// @Router       /api/events/{type}/{id}/co-organizers/{memberID} [delete]
+func (h *Handler) handleRemoveCoOrganizer(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+	eventID, eventType, ok := parseEventPath(w, r)
+	if !ok {
+		return
+	}
+	memberID, ok := mux.Vars(r)["memberID"]
+	parsedMemberID, err := strconv.Atoi(memberID)
+	if !ok || err != nil {
+		response := "there is no co-organizer id in URL"
+		if err != nil {
+			response = err.Error()
+		}
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	userID, ok := r.Context().Value(MemberIDContextKey).(uint)
+	if !ok {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, "user id isn't in context")
+		return
+	}
+
+	eventch := make(chan errResponse)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	go func() {
+		err := h.services.RemoveCoOrganizer(ctx, eventID, eventType, uint(parsedMemberID), userID)
+
+		eventch <- errResponse{
+			err: err,
+		}
+	}()
+	select {
+	case <-ctx.Done():
+		httpHelper.SendErrorResponse(w, http.StatusRequestTimeout,
+			fmt.Sprintf("removing co-organizer of event with id - %d took too long", eventID))
+		return
+	case resp := <-eventch:
+		if resp.err != nil {
+			sendCoOrganizerError(w, resp.err)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+func sendCoOrganizerError(w http.ResponseWriter, err error) {
+	status := 500
+	switch err.Error() {
+	case models.ErrNotFound.Error():
+		status = 404
+	}
+	if errors.Is(err, models.ErrInvalidCoOrganizer) {
+		status = http.StatusBadRequest
+	}
+	httpHelper.SendErrorResponse(w, uint(status), err.Error())
+}
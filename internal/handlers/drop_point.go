@@ -0,0 +1,234 @@
+package handlers
+
+import (
+	"Kurajj/internal/models"
+	httpHelper "Kurajj/pkg/http"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/gorilla/mux"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// handleAddDropPoint adds a drop-off or pickup point to the user's help event
+// @Summary      Adds a drop-off or pickup point with its address, opening hours and contact to the user's help event
+// @Tags         Help Event
+// @Accept       json
+// @Produce      json
+// @Param        id   path int  true  "ID"
+// @Param request body models.DropPointRequest true "query params"
+// @Success      200  {object}  models.CreationResponse
+// @Failure      400  {object}  models.ErrResponse
+// @Failure      401  {object}  models.ErrResponse
+// @Failure      403  {object}  models.ErrResponse
+// @Failure      404  {object}  models.ErrResponse
+// @Failure      408  {object}  models.ErrResponse
+// @Failure      500  {object}  models.ErrResponse
+// @Router       /api/events/help/{id}/drop-points [post]
+func (h *Handler) handleAddDropPoint(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+	id, ok := mux.Vars(r)["id"]
+	parsedID, err := strconv.Atoi(id)
+	if !ok || err != nil {
+		response := "there is no help event id in URL"
+		if err != nil {
+			response = err.Error()
+		}
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	userID, ok := r.Context().Value(MemberIDContextKey).(uint)
+	if !ok {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, "user id isn't in context")
+		return
+	}
+
+	point, err := models.UnmarshalDropPointRequest(&r.Body)
+	if err != nil {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	eventch := make(chan idResponse)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	go func() {
+		id, err := h.services.AddDropPoint(ctx, uint(parsedID), userID, point.ToInternal())
+
+		eventch <- idResponse{
+			id:  int(id),
+			err: err,
+		}
+	}()
+	select {
+	case <-ctx.Done():
+		httpHelper.SendErrorResponse(w, http.StatusRequestTimeout,
+			fmt.Sprintf("adding drop-off point to help event with id - %d took too long", parsedID))
+		return
+	case resp := <-eventch:
+		if resp.err != nil {
+			sendDropPointError(w, resp.err)
+			return
+		}
+		httpHelper.SendHTTPResponse(w, models.CreationResponse{ID: resp.id})
+	}
+}
+
+// handleDeleteDropPoint deletes a drop-off or pickup point of the user's help event
+// @Summary      Deletes a drop-off or pickup point of the user's help event, transactions at the point keep it
+// @Tags         Help Event
+// @Accept       json
+// @Param        id      path int  true  "ID"
+// @Param        pointID path int  true  "Drop-off point ID"
+// @Success      200
+// @Failure      400  {object}  models.ErrResponse
+// @Failure      401  {object}  models.ErrResponse
+// @Failure      403  {object}  models.ErrResponse
+// @Failure      404  {object}  models.ErrResponse
+// @Failure      408  {object}  models.ErrResponse
+// @Failure      500  {object}  models.ErrResponse
+// @Router       /api/events/help/{id}/drop-points/{pointID} [delete]
+func (h *Handler) handleDeleteDropPoint(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+	eventID, pointID, ok := parseDropPointPath(w, r)
+	if !ok {
+		return
+	}
+
+	userID, ok := r.Context().Value(MemberIDContextKey).(uint)
+	if !ok {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, "user id isn't in context")
+		return
+	}
+
+	eventch := make(chan errResponse)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	go func() {
+		err := h.services.DeleteDropPoint(ctx, eventID, pointID, userID)
+
+		eventch <- errResponse{
+			err: err,
+		}
+	}()
+	select {
+	case <-ctx.Done():
+		httpHelper.SendErrorResponse(w, http.StatusRequestTimeout,
+			fmt.Sprintf("deleting drop-off point with id - %d took too long", pointID))
+		return
+	case resp := <-eventch:
+		if resp.err != nil {
+			sendDropPointError(w, resp.err)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// handleGetDropPointArrivals gets the responders expected at the point of the user's help event
+// @Summary      Gets the responders of unfinished transactions expected at the point of the user's help event during the day
+// @Tags         Help Event
+// @Accept       json
+// @Produce      json
+// @Param        id      path  int    true  "ID"
+// @Param        pointID path  int    true  "Drop-off point ID"
+// @Param        date    query string false "Day of the arrivals in the YYYY-MM-DD format, today by default"
+// @Success      200  {object}  models.DropPointArrivalsResponse
+// @Failure      400  {object}  models.ErrResponse
+// @Failure      401  {object}  models.ErrResponse
+// @Failure      403  {object}  models.ErrResponse
+// @Failure      404  {object}  models.ErrResponse
+// @Failure      408  {object}  models.ErrResponse
+// @Failure      500  {object}  models.ErrResponse
+// @Router       /api/events/help/{id}/drop-points/{pointID}/arrivals [get]
+func (h *Handler) handleGetDropPointArrivals(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+	eventID, pointID, ok := parseDropPointPath(w, r)
+	if !ok {
+		return
+	}
+
+	userID, ok := r.Context().Value(MemberIDContextKey).(uint)
+	if !ok {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, "user id isn't in context")
+		return
+	}
+
+	day := time.Now()
+	if date := r.URL.Query().Get("date"); date != "" {
+		var err error
+		day, err = time.ParseInLocation(models.ArrivalDateLayout, date, time.Local)
+		if err != nil {
+			httpHelper.SendErrorResponse(w, http.StatusBadRequest,
+				fmt.Sprintf("date %q should be in YYYY-MM-DD format", date))
+			return
+		}
+	}
+
+	eventch := make(chan dropPointArrivalsResponse)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	go func() {
+		arrivals, err := h.services.GetDropPointArrivals(ctx, eventID, pointID, userID, day)
+
+		eventch <- dropPointArrivalsResponse{
+			arrivals: arrivals,
+			err:      err,
+		}
+	}()
+	select {
+	case <-ctx.Done():
+		httpHelper.SendErrorResponse(w, http.StatusRequestTimeout,
+			fmt.Sprintf("getting arrivals at drop-off point with id - %d took too long", pointID))
+		return
+	case resp := <-eventch:
+		if resp.err != nil {
+			sendDropPointError(w, resp.err)
+			return
+		}
+
+		httpHelper.SendHTTPResponse(w, models.CreateDropPointArrivalsResponse(day, resp.arrivals))
+	}
+}
+
+func sendDropPointError(w http.ResponseWriter, err error) {
+	status := 500
+	switch err.Error() {
+	case models.ErrNotFound.Error():
+		status = 404
+	}
+	if errors.Is(err, models.ErrInvalidDropPoint) || errors.Is(err, models.ErrInvalidCoordinates) {
+		status = http.StatusBadRequest
+	}
+	httpHelper.SendErrorResponse(w, uint(status), err.Error())
+}
+
+// parseDropPointPath reads the help event and point ids from the URL, it sends the bad request response
+// when they are invalid.
+func parseDropPointPath(w http.ResponseWriter, r *http.Request) (uint, uint, bool) {
+	id, ok := mux.Vars(r)["id"]
+	parsedID, err := strconv.Atoi(id)
+	if !ok || err != nil {
+		response := "there is no help event id in URL"
+		if err != nil {
+			response = err.Error()
+		}
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, response)
+		return 0, 0, false
+	}
+	pointID, ok := mux.Vars(r)["pointID"]
+	parsedPointID, err := strconv.Atoi(pointID)
+	if !ok || err != nil {
+		response := "there is no drop-off point id in URL"
+		if err != nil {
+			response = err.Error()
+		}
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, response)
+		return 0, 0, false
+	}
+
+	return uint(parsedID), uint(parsedPointID), true
+}
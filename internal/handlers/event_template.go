@@ -0,0 +1,342 @@
+package handlers
+
+import (
+	"Kurajj/internal/models"
+	httpHelper "Kurajj/pkg/http"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/gorilla/mux"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+func (h *Handler) initEventTemplateHandlers(api *mux.Router) {
+	templates := api.PathPrefix("/templates").Subrouter()
+	templates.HandleFunc("/", h.handleGetEventTemplates).Methods(http.MethodGet)
+	templates.HandleFunc("/", h.handleCreateEventTemplate).Methods(http.MethodPost)
+	templates.HandleFunc("/{id}", h.handleDeleteEventTemplate).Methods(http.MethodDelete)
+	templates.HandleFunc("/{id}/instantiate", h.handleInstantiateEventTemplate).Methods(http.MethodPost)
+}
+
+// handleCloneEvent copies the user's help or proposal event into a new draft
+// @Summary      Copies the user's help or proposal event with its needs, tags, location and image into a new draft with reset counters. The copy gets the requested end date and, for proposal events with time slots, the requested time slots
+// @Tags         Event Template
+// @Accept       json
+// @Produce      json
+// @Param        type    path string                   true "Event type" Enums(proposal, help)
+// @Param        id      path int                      true "ID"
+// @Param        request body models.CloneEventRequest true "Dates of the copy"
+// @Success      200  {object}  models.CreationResponse
+// @Failure      400  {object}  models.ErrResponse
+// @Failure      401  {object}  models.ErrResponse
+// @Failure      403  {object}  models.ErrResponse
+// @Failure      404  {object}  models.ErrResponse
+// @Failure      408  {object}  models.ErrResponse
+// @Failure      500  {object}  models.ErrResponse
+// @Router       /api/events/{type}/{id}/clone [post]
+func (h *Handler) handleCloneEvent(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+	id, ok := mux.Vars(r)["id"]
+	parsedID, err := strconv.Atoi(id)
+	if !ok || err != nil {
+		response := "there is no event id in URL"
+		if err != nil {
+			response = err.Error()
+		}
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	userID, ok := r.Context().Value(MemberIDContextKey).(uint)
+	if !ok {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, "user id isn't in context")
+		return
+	}
+
+	request, err := models.UnmarshalCloneEventRequest(&r.Body)
+	if err != nil {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var clone func(ctx context.Context) (uint, error)
+	switch eventType := mux.Vars(r)["type"]; eventType {
+	case "proposal":
+		clone = func(ctx context.Context) (uint, error) {
+			return h.services.CloneEvent(ctx, uint(parsedID), userID, request)
+		}
+	case "help":
+		clone = func(ctx context.Context) (uint, error) {
+			return h.services.CloneHelpEvent(ctx, models.ID(parsedID), userID, request)
+		}
+	default:
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("unknown event type %s", eventType))
+		return
+	}
+
+	eventch := make(chan idResponse)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	go func() {
+		id, err := clone(ctx)
+
+		eventch <- idResponse{
+			id:  int(id),
+			err: err,
+		}
+	}()
+	select {
+	case <-ctx.Done():
+		httpHelper.SendErrorResponse(w, http.StatusRequestTimeout,
+			fmt.Sprintf("cloning event with id - %d took too long", parsedID))
+		return
+	case resp := <-eventch:
+		h.sendEventCreationResponse(w, resp)
+	}
+}
+
+// handleGetEventTemplates gets the user's event templates
+// @Summary      Gets the user's event templates
+// @Tags         Event Template
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  models.EventTemplatesResponse
+// @Failure      401  {object}  models.ErrResponse
+// @Failure      403  {object}  models.ErrResponse
+// @Failure      408  {object}  models.ErrResponse
+// @Failure      500  {object}  models.ErrResponse
+// @Router       /api/templates/ [get]
+func (h *Handler) handleGetEventTemplates(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+	userID, ok := r.Context().Value(MemberIDContextKey).(uint)
+	if !ok {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, "user id isn't in context")
+		return
+	}
+
+	eventch := make(chan eventTemplatesResponse)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	go func() {
+		templates, err := h.services.GetMemberEventTemplates(ctx, userID)
+
+		eventch <- eventTemplatesResponse{
+			templates: templates,
+			err:       err,
+		}
+	}()
+	select {
+	case <-ctx.Done():
+		httpHelper.SendErrorResponse(w, http.StatusRequestTimeout, "getting event templates took too long")
+		return
+	case resp := <-eventch:
+		if resp.err != nil {
+			httpHelper.SendErrorResponse(w, http.StatusInternalServerError, resp.err.Error())
+			return
+		}
+
+		httpHelper.SendHTTPResponse(w, models.CreateEventTemplatesResponse(resp.templates))
+	}
+}
+
+// handleCreateEventTemplate saves a named template of a help or proposal event
+// @Summary      Saves a named template of a help or proposal event. The event field is the body of the create event request.
+// @Tags         Event Template
+// @Accept       json
+// @Produce      json
+// @Param request body models.EventTemplateCreateRequest true "query params"
+// @Success      200  {object}  models.CreationResponse
+// @Failure      400  {object}  models.ErrResponse
+// @Failure      401  {object}  models.ErrResponse
+// @Failure      403  {object}  models.ErrResponse
+// @Failure      408  {object}  models.ErrResponse
+// @Failure      500  {object}  models.ErrResponse
+// @Router       /api/templates/ [post]
+func (h *Handler) handleCreateEventTemplate(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+	userID, ok := r.Context().Value(MemberIDContextKey).(uint)
+	if !ok {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, "user id isn't in context")
+		return
+	}
+
+	request, err := models.UnmarshalEventTemplateCreateRequest(&r.Body)
+	if err != nil {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	template, err := request.Internal(userID)
+	if err != nil {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	eventch := make(chan idResponse)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	go func() {
+		id, err := h.services.CreateEventTemplate(ctx, template)
+
+		eventch <- idResponse{
+			id:  int(id),
+			err: err,
+		}
+	}()
+	select {
+	case <-ctx.Done():
+		httpHelper.SendErrorResponse(w, http.StatusRequestTimeout, "creating event template took too long")
+		return
+	case resp := <-eventch:
+		if resp.err != nil {
+			httpHelper.SendErrorResponse(w, http.StatusInternalServerError, resp.err.Error())
+			return
+		}
+		httpHelper.SendHTTPResponse(w, models.CreationResponse{ID: resp.id})
+	}
+}
+
+// handleDeleteEventTemplate deletes the user's event template
+// @Summary      Deletes the user's event template
+// @Tags         Event Template
+// @Accept       json
+// @Param        id   path int  true  "ID"
+// @Success      200
+// @Failure      401  {object}  models.ErrResponse
+// @Failure      403  {object}  models.ErrResponse
+// @Failure      404  {object}  models.ErrResponse
+// @Failure      408  {object}  models.ErrResponse
+// @Failure      500  {object}  models.ErrResponse
+// @Router       /api/templates/{id} [delete]
+func (h *Handler) handleDeleteEventTemplate(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+	id, ok := mux.Vars(r)["id"]
+	parsedID, err := strconv.Atoi(id)
+	if !ok || err != nil {
+		response := "there is no template id in URL"
+		if err != nil {
+			response = err.Error()
+		}
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	userID, ok := r.Context().Value(MemberIDContextKey).(uint)
+	if !ok {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, "user id isn't in context")
+		return
+	}
+
+	eventch := make(chan errResponse)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	go func() {
+		err := h.services.DeleteEventTemplate(ctx, uint(parsedID), userID)
+
+		eventch <- errResponse{
+			err: err,
+		}
+	}()
+	select {
+	case <-ctx.Done():
+		httpHelper.SendErrorResponse(w, http.StatusRequestTimeout,
+			fmt.Sprintf("deleting event template with id - %d took too long", parsedID))
+		return
+	case resp := <-eventch:
+		if resp.err != nil {
+			status := 500
+			switch resp.err.Error() {
+			case models.ErrNotFound.Error():
+				status = 404
+			}
+			httpHelper.SendErrorResponse(w, uint(status), resp.err.Error())
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// handleInstantiateEventTemplate creates an event from the user's template
+// @Summary      Creates an event from the user's template. The body is an optional partial create event request whose fields override the template.
+// @Tags         Event Template
+// @Accept       json
+// @Produce      json
+// @Param        id   path int  true  "ID"
+// @Success      200  {object}  models.CreationResponse
+// @Failure      400  {object}  models.ErrResponse
+// @Failure      401  {object}  models.ErrResponse
+// @Failure      403  {object}  models.ErrResponse
+// @Failure      404  {object}  models.ErrResponse
+// @Failure      408  {object}  models.ErrResponse
+// @Failure      500  {object}  models.ErrResponse
+// @Router       /api/templates/{id}/instantiate [post]
+func (h *Handler) handleInstantiateEventTemplate(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+	id, ok := mux.Vars(r)["id"]
+	parsedID, err := strconv.Atoi(id)
+	if !ok || err != nil {
+		response := "there is no template id in URL"
+		if err != nil {
+			response = err.Error()
+		}
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	userID, ok := r.Context().Value(MemberIDContextKey).(uint)
+	if !ok {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, "user id isn't in context")
+		return
+	}
+
+	overrides, err := io.ReadAll(r.Body)
+	if err != nil {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	eventch := make(chan idResponse)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	go func() {
+		id, err := h.services.InstantiateEventTemplate(ctx, uint(parsedID), userID, overrides)
+
+		eventch <- idResponse{
+			id:  int(id),
+			err: err,
+		}
+	}()
+	select {
+	case <-ctx.Done():
+		httpHelper.SendErrorResponse(w, http.StatusRequestTimeout,
+			fmt.Sprintf("creating event from template with id - %d took too long", parsedID))
+		return
+	case resp := <-eventch:
+		h.sendEventCreationResponse(w, resp)
+	}
+}
+
+func (h *Handler) sendEventCreationResponse(w http.ResponseWriter, resp idResponse) {
+	if resp.err != nil {
+		status := 500
+		switch resp.err.Error() {
+		case models.ErrNotFound.Error():
+			status = 404
+		}
+		if errors.Is(resp.err, models.ErrContentRejected) || errors.Is(resp.err, models.ErrInvalidRecurrenceRule) ||
+			errors.Is(resp.err, models.ErrInvalidPublishDate) || errors.Is(resp.err, models.ErrInvalidTemplate) ||
+			errors.Is(resp.err, models.ErrInvalidQuestionnaire) || errors.Is(resp.err, models.ErrInvalidUnit) ||
+			errors.Is(resp.err, models.ErrInvalidMoney) || errors.Is(resp.err, models.ErrInvalidDropPoint) ||
+			errors.Is(resp.err, models.ErrInvalidClone) || errors.Is(resp.err, models.ErrInvalidTimeSlot) {
+			status = http.StatusBadRequest
+		}
+		if errors.Is(resp.err, models.ErrQuotaExceeded) {
+			status = http.StatusTooManyRequests
+		}
+		httpHelper.SendErrorResponse(w, uint(status), resp.err.Error())
+		return
+	}
+	httpHelper.SendHTTPResponse(w, models.CreationResponse{ID: resp.id})
+}
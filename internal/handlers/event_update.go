@@ -0,0 +1,243 @@
+package handlers
+
+import (
+	"Kurajj/internal/models"
+	httpHelper "Kurajj/pkg/http"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/gorilla/mux"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+func (h *Handler) initEventUpdateHandlers(events *mux.Router) {
+	events.HandleFunc("/{type}/{id}/updates", h.handlePostEventUpdate).Methods(http.MethodPost)
+	events.HandleFunc("/{type}/{id}/updates/{updateID}", h.handleDeleteEventUpdate).Methods(http.MethodDelete)
+	events.HandleFunc("/{type}/{id}/follow", h.handleFollowEvent).Methods(http.MethodPost)
+	events.HandleFunc("/{type}/{id}/follow", h.handleUnfollowEvent).Methods(http.MethodDelete)
+}
+
+// handlePostEventUpdate posts an update of the user's help or proposal event
+// @Summary      Posts an update with text and images to the user's help or proposal event. Members with transactions on the event and its followers are notified.
+// @Tags         Event Update
+// @Accept       json
+// @Produce      json
+// @Param        type path string true "Event type" Enums(proposal, help)
+// @Param        id   path int  true  "ID"
+// @Param request body models.EventUpdateCreateRequest true "query params"
+// @Success      200  {object}  models.CreationResponse
+// @Failure      400  {object}  models.ErrResponse
+// @Failure      401  {object}  models.ErrResponse
+// @Failure      403  {object}  models.ErrResponse
+// @Failure      404  {object}  models.ErrResponse
+// @Failure      408  {object}  models.ErrResponse
+// @Failure      500  {object}  models.ErrResponse
+// @Router       /api/events/{type}/{id}/updates [post]
+func (h *Handler) handlePostEventUpdate(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+	eventID, eventType, ok := parseEventPath(w, r)
+	if !ok {
+		return
+	}
+
+	userID, ok := r.Context().Value(MemberIDContextKey).(uint)
+	if !ok {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, "user id isn't in context")
+		return
+	}
+
+	update, err := models.UnmarshalEventUpdateCreateRequest(&r.Body)
+	if err != nil {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	eventch := make(chan idResponse)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	go func() {
+		id, err := h.services.PostEventUpdate(ctx, eventID, eventType, userID, update)
+
+		eventch <- idResponse{
+			id:  int(id),
+			err: err,
+		}
+	}()
+	select {
+	case <-ctx.Done():
+		httpHelper.SendErrorResponse(w, http.StatusRequestTimeout,
+			fmt.Sprintf("posting update of event with id - %d took too long", eventID))
+		return
+	case resp := <-eventch:
+		if resp.err != nil {
+			sendEventUpdateError(w, resp.err)
+			return
+		}
+		httpHelper.SendHTTPResponse(w, models.CreationResponse{ID: resp.id})
+	}
+}
+
+// handleDeleteEventUpdate deletes an update of the user's event
+// @Summary      Deletes an update of the user's help or proposal event
+// @Tags         Event Update
+// @Accept       json
+// @Param        type     path string true "Event type" Enums(proposal, help)
+// @Param        id       path int  true  "Event ID"
+// @Param        updateID path int  true  "Update ID"
+// @Success      200
+// @Failure      400  {object}  models.ErrResponse
+// @Failure      401  {object}  models.ErrResponse
+// @Failure      403  {object}  models.ErrResponse
+// @Failure      404  {object}  models.ErrResponse
+// @Failure      408  {object}  models.ErrResponse
+// @Failure      500  {object}  models.ErrResponse
+// @Router       /api/events/{type}/{id}/updates/{updateID} [delete]
+func (h *Handler) handleDeleteEventUpdate(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+	id, ok := mux.Vars(r)["updateID"]
+	parsedID, err := strconv.Atoi(id)
+	if !ok || err != nil {
+		response := "there is no update id in URL"
+		if err != nil {
+			response = err.Error()
+		}
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	userID, ok := r.Context().Value(MemberIDContextKey).(uint)
+	if !ok {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, "user id isn't in context")
+		return
+	}
+
+	h.sendEventUpdateAction(w, fmt.Sprintf("deleting event update with id - %d took too long", parsedID),
+		func(ctx context.Context) error {
+			return h.services.DeleteEventUpdate(ctx, uint(parsedID), userID)
+		})
+}
+
+// handleFollowEvent subscribes the user to the event updates
+// @Summary      Subscribes the user to the updates of the help or proposal event
+// @Tags         Event Update
+// @Accept       json
+// @Param        type path string true "Event type" Enums(proposal, help)
+// @Param        id   path int  true  "ID"
+// @Success      200
+// @Failure      400  {object}  models.ErrResponse
+// @Failure      401  {object}  models.ErrResponse
+// @Failure      403  {object}  models.ErrResponse
+// @Failure      404  {object}  models.ErrResponse
+// @Failure      408  {object}  models.ErrResponse
+// @Failure      500  {object}  models.ErrResponse
+// @Router       /api/events/{type}/{id}/follow [post]
+func (h *Handler) handleFollowEvent(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+	eventID, eventType, ok := parseEventPath(w, r)
+	if !ok {
+		return
+	}
+
+	userID, ok := r.Context().Value(MemberIDContextKey).(uint)
+	if !ok {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, "user id isn't in context")
+		return
+	}
+
+	h.sendEventUpdateAction(w, fmt.Sprintf("following event with id - %d took too long", eventID),
+		func(ctx context.Context) error {
+			return h.services.FollowEvent(ctx, eventID, eventType, userID)
+		})
+}
+
+// handleUnfollowEvent unsubscribes the user from the event updates
+// @Summary      Unsubscribes the user from the updates of the help or proposal event
+// @Tags         Event Update
+// @Accept       json
+// @Param        type path string true "Event type" Enums(proposal, help)
+// @Param        id   path int  true  "ID"
+// @Success      200
+// @Failure      400  {object}  models.ErrResponse
+// @Failure      401  {object}  models.ErrResponse
+// @Failure      403  {object}  models.ErrResponse
+// @Failure      408  {object}  models.ErrResponse
+// @Failure      500  {object}  models.ErrResponse
+// @Router       /api/events/{type}/{id}/follow [delete]
+func (h *Handler) handleUnfollowEvent(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+	eventID, eventType, ok := parseEventPath(w, r)
+	if !ok {
+		return
+	}
+
+	userID, ok := r.Context().Value(MemberIDContextKey).(uint)
+	if !ok {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, "user id isn't in context")
+		return
+	}
+
+	h.sendEventUpdateAction(w, fmt.Sprintf("unfollowing event with id - %d took too long", eventID),
+		func(ctx context.Context) error {
+			return h.services.UnfollowEvent(ctx, eventID, eventType, userID)
+		})
+}
+
+func (h *Handler) sendEventUpdateAction(w http.ResponseWriter, timeoutMessage string, action func(ctx context.Context) error) {
+	eventch := make(chan errResponse)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	go func() {
+		eventch <- errResponse{
+			err: action(ctx),
+		}
+	}()
+	select {
+	case <-ctx.Done():
+		httpHelper.SendErrorResponse(w, http.StatusRequestTimeout, timeoutMessage)
+		return
+	case resp := <-eventch:
+		if resp.err != nil {
+			sendEventUpdateError(w, resp.err)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+func sendEventUpdateError(w http.ResponseWriter, err error) {
+	status := 500
+	switch err.Error() {
+	case models.ErrNotFound.Error():
+		status = 404
+	}
+	if errors.Is(err, models.ErrInvalidEventUpdate) || errors.Is(err, models.ErrContentRejected) {
+		status = http.StatusBadRequest
+	}
+	httpHelper.SendErrorResponse(w, uint(status), err.Error())
+}
+
+// parseEventPath reads the event id and type from the URL, it sends the bad request response when they are invalid.
+func parseEventPath(w http.ResponseWriter, r *http.Request) (uint, models.EventType, bool) {
+	id, ok := mux.Vars(r)["id"]
+	parsedID, err := strconv.Atoi(id)
+	if !ok || err != nil {
+		response := "there is no event id in URL"
+		if err != nil {
+			response = err.Error()
+		}
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, response)
+		return 0, "", false
+	}
+
+	switch eventType := mux.Vars(r)["type"]; eventType {
+	case "proposal":
+		return uint(parsedID), models.ProposalEventType, true
+	case "help":
+		return uint(parsedID), models.HelpEventType, true
+	default:
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("unknown event type %s", eventType))
+		return 0, "", false
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"github.com/gorilla/mux"
 	"net/http"
@@ -22,11 +23,20 @@ func (h *Handler) initHelpEventHandlers(events *mux.Router) {
 	helpEvent.HandleFunc("/transaction", h.handleUpdateTransactionResponseHelpEvent).Methods(http.MethodPut)
 	helpEvent.HandleFunc("/own", h.handleGetOwnHelpEvents).Methods(http.MethodGet)
 	helpEvent.HandleFunc("/{id}", h.handleUpdateHelpEvent).Methods(http.MethodPut)
+	helpEvent.HandleFunc("/{id}", h.handleDeleteHelpEvent).Methods(http.MethodDelete)
 	helpEvent.HandleFunc("/comment", h.handleWriteCommentInHelpEvent).Methods(http.MethodPost)
 	helpEvent.HandleFunc("/comment/{id}", h.handleUpdateHelpEventComment).Methods(http.MethodPut)
 	helpEvent.HandleFunc("/comment/{id}", h.handleDeleteHelpEventComment).Methods(http.MethodDelete)
 	helpEvent.HandleFunc("/comments/{id}", h.handleGetCommentsInHelpEvent).Methods(http.MethodGet)
 	helpEvent.HandleFunc("/statistics", h.handleGetHelpEventStatistics).Methods(http.MethodGet)
+	helpEvent.HandleFunc("/tags/{id}", h.handleGetHelpEventTags).Methods(http.MethodGet)
+	helpEvent.HandleFunc("/tags/{id}", h.handleUpsertHelpEventTags).Methods(http.MethodPut)
+	helpEvent.HandleFunc("/{id}/intake", h.handleGetIntakeLedger).Methods(http.MethodGet)
+	helpEvent.HandleFunc("/{id}/intake", h.handleCorrectIntake).Methods(http.MethodPost)
+	helpEvent.HandleFunc("/{id}/intake/{entryID}/reverse", h.handleReverseIntake).Methods(http.MethodPost)
+	helpEvent.HandleFunc("/{id}/drop-points", h.handleAddDropPoint).Methods(http.MethodPost)
+	helpEvent.HandleFunc("/{id}/drop-points/{pointID}", h.handleDeleteDropPoint).Methods(http.MethodDelete)
+	helpEvent.HandleFunc("/{id}/drop-points/{pointID}/arrivals", h.handleGetDropPointArrivals).Methods(http.MethodGet)
 }
 
 // GetHelpEventByID gets help event by id
@@ -61,6 +71,10 @@ func (h *Handler) handleGetHelpEventByID(w http.ResponseWriter, r *http.Request)
 	}
 	go func() {
 		event, err := h.services.GetHelpEventByID(ctx, models.ID(parsedID))
+		userID, _ := r.Context().Value(MemberIDContextKey).(uint)
+		if err == nil && event.Status == models.InActive && !event.Organizes(userID, "") {
+			err = models.ErrNotFound
+		}
 
 		eventch <- getHelpEvent{
 			helpEvent: event,
@@ -88,19 +102,132 @@ func (h *Handler) handleGetHelpEventByID(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-func (h *Handler) GetUserHelpEvents(w http.ResponseWriter, r *http.Request) {
-	//TODO implement me
-	panic("implement me")
+// handleGetHelpEvents gets a page of all active help events
+// @Summary      Get a page of all active help events
+// @Tags         Help Event
+// @Produce      json
+// @Param        pageNumber query int false "Page number, the first page by default"
+// @Param        pageSize   query int false "Page size, 10 by default"
+// @Success      200  {object}  models.HelpEventsWithPagination
+// @Failure      400  {object}  models.ErrResponse
+// @Failure      404  {object}  models.ErrResponse
+// @Failure      408  {object}  models.ErrResponse
+// @Failure      500  {object}  models.ErrResponse
+// @Router       /open-api/help/ [get]
+func (h *Handler) handleGetHelpEvents(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+	pagination, err := parsePagination(r)
+	if err != nil {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	eventch := make(chan getHelpEventPagination)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	go func() {
+		events, err := h.services.GetHelpEvents(ctx, pagination)
+
+		eventch <- getHelpEventPagination{
+			resp: models.HelpEventsWithPagination{
+				HelpEventsItems: models.GetHelpEventItems(events.Events...),
+				Pagination:      events.Pagination,
+			},
+			err: err,
+		}
+	}()
+	sendHelpEventPage(ctx, w, eventch, "getting help events took too long")
 }
 
-func (h *Handler) SearchHelpEvents(w http.ResponseWriter, r *http.Request) {
-	//TODO implement me
-	panic("implement me")
+// handleGetMemberHelpEvents gets a page of the help events created by the member
+// @Summary      Get a page of the active and done help events created by the member
+// @Tags         Help Event
+// @Produce      json
+// @Param        id         path  int true  "Member ID"
+// @Param        pageNumber query int false "Page number, the first page by default"
+// @Param        pageSize   query int false "Page size, 10 by default"
+// @Success      200  {object}  models.HelpEventsWithPagination
+// @Failure      400  {object}  models.ErrResponse
+// @Failure      404  {object}  models.ErrResponse
+// @Failure      408  {object}  models.ErrResponse
+// @Failure      500  {object}  models.ErrResponse
+// @Router       /open-api/help/member/{id} [get]
+func (h *Handler) handleGetMemberHelpEvents(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+	id, ok := mux.Vars(r)["id"]
+	parsedID, err := strconv.Atoi(id)
+	if !ok || err != nil {
+		response := "there is no member id in URL"
+		if err != nil {
+			response = err.Error()
+		}
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, response)
+		return
+	}
+	pagination, err := parsePagination(r)
+	if err != nil {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	eventch := make(chan getHelpEventPagination)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	go func() {
+		events, err := h.services.GetMemberHelpEvents(ctx, uint(parsedID), pagination)
+
+		eventch <- getHelpEventPagination{
+			resp: models.HelpEventsWithPagination{
+				HelpEventsItems: models.GetHelpEventItems(events.Events...),
+				Pagination:      events.Pagination,
+			},
+			err: err,
+		}
+	}()
+	sendHelpEventPage(ctx, w, eventch, fmt.Sprintf("getting help events of member with id - %d took too long", parsedID))
 }
 
-func (h *Handler) GetHelpEvents(w http.ResponseWriter, r *http.Request) {
-	//TODO implement me
-	panic("implement me")
+func sendHelpEventPage(ctx context.Context, w http.ResponseWriter, eventch chan getHelpEventPagination, timeoutMessage string) {
+	select {
+	case <-ctx.Done():
+		httpHelper.SendErrorResponse(w, http.StatusRequestTimeout, timeoutMessage)
+		return
+	case resp := <-eventch:
+		if resp.err != nil {
+			status := 500
+			switch resp.err.Error() {
+			case models.ErrNotFound.Error():
+				status = 404
+			}
+			httpHelper.SendErrorResponse(w, uint(status), resp.err.Error())
+			return
+		}
+
+		err := httpHelper.SendHTTPResponse(w, resp.resp)
+		if err != nil {
+			zlog.Log.Error(err, "could not send help events")
+		}
+	}
+}
+
+// parsePagination reads the optional pageNumber and pageSize query parameters.
+func parsePagination(r *http.Request) (models.PaginationRequest, error) {
+	pagination := models.PaginationRequest{}
+	var err error
+	if pageNumber := r.URL.Query().Get("pageNumber"); pageNumber != "" {
+		pagination.PageNumber, err = strconv.Atoi(pageNumber)
+		if err != nil || pagination.PageNumber < 1 {
+			return models.PaginationRequest{}, fmt.Errorf("pageNumber %q should be a positive number", pageNumber)
+		}
+	}
+	if pageSize := r.URL.Query().Get("pageSize"); pageSize != "" {
+		pagination.PageSize, err = strconv.Atoi(pageSize)
+		if err != nil || pagination.PageSize < 1 {
+			return models.PaginationRequest{}, fmt.Errorf("pageSize %q should be a positive number", pageSize)
+		}
+	}
+
+	return pagination, nil
 }
 
 // CreateHelpEvent creates a new help event
@@ -158,6 +285,15 @@ func (h *Handler) handleCreateHelpEvent(w http.ResponseWriter, r *http.Request)
 			case models.ErrNotFound.Error():
 				status = 404
 			}
+			if errors.Is(resp.err, models.ErrContentRejected) || errors.Is(resp.err, models.ErrInvalidPublishDate) ||
+				errors.Is(resp.err, models.ErrInvalidQuestionnaire) || errors.Is(resp.err, models.ErrInvalidCoordinates) ||
+				errors.Is(resp.err, models.ErrInvalidUnit) || errors.Is(resp.err, models.ErrInvalidMoney) ||
+				errors.Is(resp.err, models.ErrInvalidUrgency) || errors.Is(resp.err, models.ErrInvalidDropPoint) {
+				status = http.StatusBadRequest
+			}
+			if errors.Is(resp.err, models.ErrQuotaExceeded) {
+				status = http.StatusTooManyRequests
+			}
 			httpHelper.SendErrorResponse(w, uint(status), resp.err.Error())
 			return
 		}
@@ -208,11 +344,17 @@ func (h *Handler) handleUpdateHelpEvent(w http.ResponseWriter, r *http.Request)
 	}
 	event.ID = uint(parsedID)
 
+	userID, ok := r.Context().Value(MemberIDContextKey).(uint)
+	if !ok {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, "user id isn't in context")
+		return
+	}
+
 	eventch := make(chan errResponse)
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
 	defer cancel()
 	go func() {
-		err = h.services.UpdateHelpEvent(ctx, event.Internal())
+		err = h.services.UpdateHelpEvent(ctx, event.Internal(), userID)
 
 		eventch <- errResponse{
 			err: err,
@@ -229,6 +371,13 @@ func (h *Handler) handleUpdateHelpEvent(w http.ResponseWriter, r *http.Request)
 			case models.ErrNotFound.Error():
 				status = 404
 			}
+			if errors.Is(resp.err, models.ErrContentRejected) || errors.Is(resp.err, models.ErrInvalidPublishDate) ||
+				errors.Is(resp.err, models.ErrInvalidUrgency) {
+				status = http.StatusBadRequest
+			}
+			if errors.Is(resp.err, models.ErrQuotaExceeded) {
+				status = http.StatusTooManyRequests
+			}
 			httpHelper.SendErrorResponse(w, uint(status), resp.err.Error())
 			return
 		}
@@ -239,6 +388,67 @@ func (h *Handler) handleUpdateHelpEvent(w http.ResponseWriter, r *http.Request)
 	}
 }
 
+// handleDeleteHelpEvent deletes the user's help event
+// @Summary      Deletes the user's help event, cancels its unfinished transactions, gives their pledges back and notifies the responders and co-organizers
+// @Tags         Help Event
+// @Accept       json
+// @Param        id   path int  true  "ID"
+// @Success      200
+// @Failure      400  {object}  models.ErrResponse
+// @Failure      401  {object}  models.ErrResponse
+// @Failure      403  {object}  models.ErrResponse
+// @Failure      404  {object}  models.ErrResponse
+// @Failure      408  {object}  models.ErrResponse
+// @Failure      500  {object}  models.ErrResponse
+// @Router       /api/events/help/{id} [delete]
+func (h *Handler) handleDeleteHelpEvent(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+	id, ok := mux.Vars(r)["id"]
+	parsedID, err := strconv.Atoi(id)
+	if !ok || err != nil {
+		response := "there is no help event id in URL"
+		if err != nil {
+			response = err.Error()
+		}
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	userID, ok := r.Context().Value(MemberIDContextKey).(uint)
+	if !ok {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, "user id isn't in context")
+		return
+	}
+
+	eventch := make(chan errResponse)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	go func() {
+		err := h.services.DeleteHelpEvent(ctx, uint(parsedID), userID)
+
+		eventch <- errResponse{
+			err: err,
+		}
+	}()
+	select {
+	case <-ctx.Done():
+		httpHelper.SendErrorResponse(w, http.StatusRequestTimeout,
+			fmt.Sprintf("deleting help event with id - %d took too long", parsedID))
+		return
+	case resp := <-eventch:
+		if resp.err != nil {
+			status := 500
+			switch resp.err.Error() {
+			case models.ErrNotFound.Error():
+				status = 404
+			}
+			httpHelper.SendErrorResponse(w, uint(status), resp.err.Error())
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
 // handleSearchHelpEvents gets models.HelpEventsResponse by given order and filter values
 // @Param        id   path int  true  "ID"
 // @Summary      Return help events by given order and filter values
@@ -297,6 +507,9 @@ func (h *Handler) handleSearchHelpEvents(w http.ResponseWriter, r *http.Request)
 			case models.ErrNotFound.Error():
 				status = 404
 			}
+			if errors.Is(resp.err, models.ErrInvalidCoordinates) || errors.Is(resp.err, models.ErrInvalidUrgency) {
+				status = http.StatusBadRequest
+			}
 			httpHelper.SendErrorResponse(w, uint(status), resp.err.Error())
 			return
 		}
@@ -366,7 +579,7 @@ func (h *Handler) handleUpdateTransactionResponseHelpEvent(w http.ResponseWriter
 		return
 	}
 
-	eventCreator := userID.(uint) == helpEvent.CreatedBy
+	eventCreator := helpEvent.Organizes(userID.(uint), models.TransactionManagerRole)
 	eventch := make(chan errResponse)
 	go func() {
 		err := h.services.UpdateTransactionStatus(ctx, transaction.ToInternal(eventCreator, models.ID(helpEvent.ID), userID.(uint)),
@@ -387,6 +600,11 @@ func (h *Handler) handleUpdateTransactionResponseHelpEvent(w http.ResponseWriter
 			case models.ErrNotFound.Error():
 				status = 404
 			}
+			if errors.Is(resp.err, models.ErrInvalidPledge) || errors.Is(resp.err, models.ErrInvalidUnit) ||
+				errors.Is(resp.err, models.ErrInvalidMoney) || errors.Is(resp.err, models.ErrInvalidDropPoint) ||
+				errors.Is(resp.err, models.ErrInvalidReport) {
+				status = http.StatusBadRequest
+			}
 			httpHelper.SendErrorResponse(w, uint(status), resp.err.Error())
 			return
 		}
@@ -454,6 +672,14 @@ func (h *Handler) handleApplyTransaction(w http.ResponseWriter, r *http.Request)
 			case models.ErrNotFound.Error():
 				status = 404
 			}
+			if errors.Is(resp.err, models.ErrInvalidAnswers) || errors.Is(resp.err, models.ErrInvalidPledge) ||
+				errors.Is(resp.err, models.ErrPledgeExceedsNeed) || errors.Is(resp.err, models.ErrInvalidUnit) ||
+				errors.Is(resp.err, models.ErrInvalidMoney) {
+				status = http.StatusBadRequest
+			}
+			if errors.Is(resp.err, models.ErrQuotaExceeded) {
+				status = http.StatusTooManyRequests
+			}
 			httpHelper.SendErrorResponse(w, uint(status), resp.err.Error())
 			return
 		}
@@ -578,6 +804,12 @@ func (h *Handler) handleWriteCommentInHelpEvent(w http.ResponseWriter, r *http.R
 			case models.ErrNotFound.Error():
 				status = 404
 			}
+			if errors.Is(resp.err, models.ErrContentRejected) {
+				status = http.StatusBadRequest
+			}
+			if errors.Is(resp.err, models.ErrQuotaExceeded) {
+				status = http.StatusTooManyRequests
+			}
 			httpHelper.SendErrorResponse(w, uint(status), resp.err.Error())
 			return
 		}
@@ -735,6 +967,9 @@ func (h *Handler) handleUpdateHelpEventComment(w http.ResponseWriter, r *http.Re
 			case models.ErrNotFound.Error():
 				status = 404
 			}
+			if errors.Is(resp.err, models.ErrContentRejected) {
+				status = http.StatusBadRequest
+			}
 			httpHelper.SendErrorResponse(w, uint(status), resp.err.Error())
 			return
 		}
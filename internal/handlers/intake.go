@@ -0,0 +1,222 @@
+package handlers
+
+import (
+	"Kurajj/internal/models"
+	httpHelper "Kurajj/pkg/http"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/gorilla/mux"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// handleGetIntakeLedger gets the intake ledger of the user's help event
+// @Summary      Gets the receipts, corrections and reversals of the received amounts of the user's help event needs, the oldest first
+// @Tags         Help Event
+// @Accept       json
+// @Produce      json
+// @Param        id   path int  true  "ID"
+// @Success      200  {object}  models.IntakeLedgerResponse
+// @Failure      400  {object}  models.ErrResponse
+// @Failure      401  {object}  models.ErrResponse
+// @Failure      403  {object}  models.ErrResponse
+// @Failure      404  {object}  models.ErrResponse
+// @Failure      408  {object}  models.ErrResponse
+// @Failure      500  {object}  models.ErrResponse
+// @Router       /api/events/help/{id}/intake [get]
+func (h *Handler) handleGetIntakeLedger(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+	id, ok := mux.Vars(r)["id"]
+	parsedID, err := strconv.Atoi(id)
+	if !ok || err != nil {
+		response := "there is no help event id in URL"
+		if err != nil {
+			response = err.Error()
+		}
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	userID, ok := r.Context().Value(MemberIDContextKey).(uint)
+	if !ok {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, "user id isn't in context")
+		return
+	}
+
+	eventch := make(chan intakeLedgerResponse)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	go func() {
+		entries, err := h.services.GetIntakeLedger(ctx, uint(parsedID), userID)
+
+		eventch <- intakeLedgerResponse{
+			entries: entries,
+			err:     err,
+		}
+	}()
+	select {
+	case <-ctx.Done():
+		httpHelper.SendErrorResponse(w, http.StatusRequestTimeout,
+			fmt.Sprintf("getting intake ledger of help event with id - %d took too long", parsedID))
+		return
+	case resp := <-eventch:
+		if resp.err != nil {
+			sendIntakeError(w, resp.err)
+			return
+		}
+
+		httpHelper.SendHTTPResponse(w, models.CreateIntakeLedgerResponse(resp.entries))
+	}
+}
+
+// handleCorrectIntake posts a correction of the received amount of the user's help event need
+// @Summary      Posts a correction of the received amount of the user's help event need, a negative quantity decreases it
+// @Tags         Help Event
+// @Accept       json
+// @Param        id   path int  true  "ID"
+// @Param request body models.IntakeCorrectionRequest true "query params"
+// @Success      200
+// @Failure      400  {object}  models.ErrResponse
+// @Failure      401  {object}  models.ErrResponse
+// @Failure      403  {object}  models.ErrResponse
+// @Failure      404  {object}  models.ErrResponse
+// @Failure      408  {object}  models.ErrResponse
+// @Failure      500  {object}  models.ErrResponse
+// @Router       /api/events/help/{id}/intake [post]
+func (h *Handler) handleCorrectIntake(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+	id, ok := mux.Vars(r)["id"]
+	parsedID, err := strconv.Atoi(id)
+	if !ok || err != nil {
+		response := "there is no help event id in URL"
+		if err != nil {
+			response = err.Error()
+		}
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	userID, ok := r.Context().Value(MemberIDContextKey).(uint)
+	if !ok {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, "user id isn't in context")
+		return
+	}
+
+	correction, err := models.UnmarshalIntakeCorrectionRequest(&r.Body)
+	if err != nil {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	eventch := make(chan errResponse)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	go func() {
+		err := h.services.CorrectIntake(ctx, uint(parsedID), userID, correction)
+
+		eventch <- errResponse{
+			err: err,
+		}
+	}()
+	select {
+	case <-ctx.Done():
+		httpHelper.SendErrorResponse(w, http.StatusRequestTimeout,
+			fmt.Sprintf("correcting intake of help event with id - %d took too long", parsedID))
+		return
+	case resp := <-eventch:
+		if resp.err != nil {
+			sendIntakeError(w, resp.err)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// handleReverseIntake reverses an entry of the intake ledger of the user's help event
+// @Summary      Reverses a receipt or a correction of the user's help event need by recording the opposite quantity
+// @Tags         Help Event
+// @Accept       json
+// @Param        id      path int  true  "ID"
+// @Param        entryID path int  true  "Ledger entry ID"
+// @Param request body models.IntakeReversalRequest false "query params"
+// @Success      200
+// @Failure      400  {object}  models.ErrResponse
+// @Failure      401  {object}  models.ErrResponse
+// @Failure      403  {object}  models.ErrResponse
+// @Failure      404  {object}  models.ErrResponse
+// @Failure      408  {object}  models.ErrResponse
+// @Failure      500  {object}  models.ErrResponse
+// @Router       /api/events/help/{id}/intake/{entryID}/reverse [post]
+func (h *Handler) handleReverseIntake(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+	id, ok := mux.Vars(r)["id"]
+	parsedID, err := strconv.Atoi(id)
+	if !ok || err != nil {
+		response := "there is no help event id in URL"
+		if err != nil {
+			response = err.Error()
+		}
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, response)
+		return
+	}
+	entryID, ok := mux.Vars(r)["entryID"]
+	parsedEntryID, err := strconv.Atoi(entryID)
+	if !ok || err != nil {
+		response := "there is no ledger entry id in URL"
+		if err != nil {
+			response = err.Error()
+		}
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	userID, ok := r.Context().Value(MemberIDContextKey).(uint)
+	if !ok {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, "user id isn't in context")
+		return
+	}
+
+	reversal, err := models.UnmarshalIntakeReversalRequest(&r.Body)
+	if err != nil {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	eventch := make(chan errResponse)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	go func() {
+		err := h.services.ReverseIntake(ctx, uint(parsedID), uint(parsedEntryID), userID, reversal.Comment)
+
+		eventch <- errResponse{
+			err: err,
+		}
+	}()
+	select {
+	case <-ctx.Done():
+		httpHelper.SendErrorResponse(w, http.StatusRequestTimeout,
+			fmt.Sprintf("reversing intake entry with id - %d took too long", parsedEntryID))
+		return
+	case resp := <-eventch:
+		if resp.err != nil {
+			sendIntakeError(w, resp.err)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+func sendIntakeError(w http.ResponseWriter, err error) {
+	status := 500
+	switch err.Error() {
+	case models.ErrNotFound.Error():
+		status = 404
+	}
+	if errors.Is(err, models.ErrInvalidIntake) || errors.Is(err, models.ErrInvalidUnit) ||
+		errors.Is(err, models.ErrInvalidMoney) {
+		status = http.StatusBadRequest
+	}
+	httpHelper.SendErrorResponse(w, uint(status), err.Error())
+}
@@ -6,6 +6,7 @@ import (
 	"context"
 	"net/http"
 	"strings"
+	"time"
 )
 
 const MemberIDContextKey = "id"
@@ -37,6 +38,31 @@ func (h *Handler) Authentication(next http.Handler) http.Handler {
 	})
 }
 
+// AdminAuthorization lets only admins through, it runs after Authentication put the user id in the context.
+func (h *Handler) AdminAuthorization(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, ok := r.Context().Value(MemberIDContextKey).(uint)
+		if !ok {
+			httpHelper.SendErrorResponse(w, http.StatusBadRequest, "user id isn't in context")
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), time.Second*5)
+		defer cancel()
+		isAdmin, err := h.services.IsAdmin(ctx, userID)
+		if err != nil {
+			zlog.Log.Error(err, "could not check if the user is an admin")
+			httpHelper.SendErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		if !isAdmin {
+			httpHelper.SendErrorResponse(w, http.StatusForbidden, "only admins can do it")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
 func (h *Handler) SetId(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		defer r.Body.Close()
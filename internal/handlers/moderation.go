@@ -0,0 +1,286 @@
+package handlers
+
+import (
+	"Kurajj/internal/models"
+	httpHelper "Kurajj/pkg/http"
+	"context"
+	"github.com/gorilla/mux"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+func (h *Handler) initModerationHandlers(api *mux.Router) {
+	moderation := api.PathPrefix("/moderation").Subrouter()
+	moderation.Use(h.AdminAuthorization)
+	moderation.HandleFunc("/", h.handleGetModerationQueue).Methods(http.MethodGet)
+	moderation.HandleFunc("/resolve/{id}", h.handleResolveModerationItem).Methods(http.MethodPost)
+	moderation.HandleFunc("/blocked-words", h.handleGetBlockedWords).Methods(http.MethodGet)
+	moderation.HandleFunc("/blocked-words", h.handleCreateBlockedWord).Methods(http.MethodPost)
+	moderation.HandleFunc("/blocked-words/{id}", h.handleDeleteBlockedWord).Methods(http.MethodDelete)
+}
+
+// handleGetModerationQueue gets all flagged comments and events waiting for a review
+// @Summary      Gets all flagged comments and events waiting for a review
+// @Accept       json
+// @Produce      json
+// @Tags   		 Moderation
+// @Success      200  {object}  models.ModerationQueueResponse
+// @Failure      401  {object}  models.ErrResponse
+// @Failure      403  {object}  models.ErrResponse
+// @Failure      404  {object}  models.ErrResponse
+// @Failure      408  {object}  models.ErrResponse
+// @Failure      500  {object}  models.ErrResponse
+// @Router       /api/moderation/ [get]
+func (h *Handler) handleGetModerationQueue(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	eventch := make(chan moderationQueueResponse)
+	defer cancel()
+	go func() {
+		items, err := h.services.GetModerationQueue(ctx)
+
+		eventch <- moderationQueueResponse{
+			items: items,
+			err:   err,
+		}
+	}()
+	select {
+	case <-ctx.Done():
+		httpHelper.SendErrorResponse(w, http.StatusRequestTimeout, "getting moderation queue took too long")
+		return
+	case resp := <-eventch:
+		if resp.err != nil {
+			status := 500
+			switch resp.err.Error() {
+			case models.ErrNotFound.Error():
+				status = 404
+			}
+			httpHelper.SendErrorResponse(w, uint(status), resp.err.Error())
+			return
+		}
+
+		httpHelper.SendHTTPResponse(w, models.CreateModerationQueueResponse(resp.items))
+	}
+}
+
+// handleResolveModerationItem approves flagged content or removes it
+// @Summary      Approves flagged content or removes it
+// @Accept       json
+// @Produce      json
+// @Tags   		 Moderation
+// @Param        id   path int  true  "ID"
+// @Param request body models.ModerationResolveRequest true "query params"
+// @Success      200
+// @Failure      401  {object}  models.ErrResponse
+// @Failure      403  {object}  models.ErrResponse
+// @Failure      404  {object}  models.ErrResponse
+// @Failure      408  {object}  models.ErrResponse
+// @Failure      500  {object}  models.ErrResponse
+// @Router       /api/moderation/resolve/{id} [post]
+func (h *Handler) handleResolveModerationItem(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+	id, ok := mux.Vars(r)["id"]
+	parsedID, err := strconv.Atoi(id)
+	if !ok || err != nil {
+		response := "there is no moderation item id in URL"
+		if err != nil {
+			response = err.Error()
+		}
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	resolve, err := models.UnmarshalModerationResolveRequest(&r.Body)
+	if err != nil {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	userID := r.Context().Value(MemberIDContextKey)
+	if userID == "" {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, "user id isn't in context")
+		return
+	}
+
+	eventch := make(chan errResponse)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	go func() {
+		err := h.services.ResolveModerationItem(ctx, uint(parsedID), userID.(uint), resolve.Approve)
+
+		eventch <- errResponse{
+			err: err,
+		}
+	}()
+	select {
+	case <-ctx.Done():
+		httpHelper.SendErrorResponse(w, http.StatusRequestTimeout, "resolving moderation item took too long")
+		return
+	case resp := <-eventch:
+		if resp.err != nil {
+			status := 500
+			switch resp.err.Error() {
+			case models.ErrNotFound.Error():
+				status = 404
+			}
+			httpHelper.SendErrorResponse(w, uint(status), resp.err.Error())
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// handleGetBlockedWords gets Ukrainian and English blocked words
+// @Summary      Gets Ukrainian and English blocked words
+// @Accept       json
+// @Produce      json
+// @Tags   		 Moderation
+// @Success      200  {object}  models.BlockedWordsResponse
+// @Failure      401  {object}  models.ErrResponse
+// @Failure      403  {object}  models.ErrResponse
+// @Failure      404  {object}  models.ErrResponse
+// @Failure      408  {object}  models.ErrResponse
+// @Failure      500  {object}  models.ErrResponse
+// @Router       /api/moderation/blocked-words [get]
+func (h *Handler) handleGetBlockedWords(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	eventch := make(chan blockedWordsResponse)
+	defer cancel()
+	go func() {
+		words, err := h.services.GetBlockedWords(ctx)
+
+		eventch <- blockedWordsResponse{
+			words: words,
+			err:   err,
+		}
+	}()
+	select {
+	case <-ctx.Done():
+		httpHelper.SendErrorResponse(w, http.StatusRequestTimeout, "getting blocked words took too long")
+		return
+	case resp := <-eventch:
+		if resp.err != nil {
+			status := 500
+			switch resp.err.Error() {
+			case models.ErrNotFound.Error():
+				status = 404
+			}
+			httpHelper.SendErrorResponse(w, uint(status), resp.err.Error())
+			return
+		}
+
+		httpHelper.SendHTTPResponse(w, models.BlockedWordsResponse{Words: resp.words})
+	}
+}
+
+// handleCreateBlockedWord adds a word to the Ukrainian or English blocked words
+// @Summary      Adds a word to the Ukrainian or English blocked words. Words match whole words, a word ending with * is a stem matching every word it starts.
+// @Accept       json
+// @Produce      json
+// @Tags   		 Moderation
+// @Param request body models.BlockedWordCreateRequest true "query params"
+// @Success      201  {object}  models.CreationResponse
+// @Failure      401  {object}  models.ErrResponse
+// @Failure      403  {object}  models.ErrResponse
+// @Failure      404  {object}  models.ErrResponse
+// @Failure      408  {object}  models.ErrResponse
+// @Failure      500  {object}  models.ErrResponse
+// @Router       /api/moderation/blocked-words [post]
+func (h *Handler) handleCreateBlockedWord(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	word, err := models.UnmarshalBlockedWordCreateRequest(&r.Body)
+	if err != nil {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	eventch := make(chan idResponse)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	go func() {
+		id, err := h.services.CreateBlockedWord(ctx, word.Internal())
+
+		eventch <- idResponse{
+			id:  int(id),
+			err: err,
+		}
+	}()
+	select {
+	case <-ctx.Done():
+		httpHelper.SendErrorResponse(w, http.StatusRequestTimeout, "creating blocked word took too long")
+		return
+	case resp := <-eventch:
+		if resp.err != nil {
+			status := 500
+			switch resp.err.Error() {
+			case models.ErrNotFound.Error():
+				status = 404
+			}
+			httpHelper.SendErrorResponse(w, uint(status), resp.err.Error())
+			return
+		}
+		wordResponse := models.CreationResponse{ID: resp.id}
+		err := httpHelper.SendHTTPResponse(w, wordResponse)
+		if err != nil {
+			return
+		}
+	}
+}
+
+// handleDeleteBlockedWord removes a word from the blocked words
+// @Summary      Removes a word from the blocked words
+// @Accept       json
+// @Produce      json
+// @Tags   		 Moderation
+// @Param        id   path int  true  "ID"
+// @Success      200
+// @Failure      401  {object}  models.ErrResponse
+// @Failure      403  {object}  models.ErrResponse
+// @Failure      404  {object}  models.ErrResponse
+// @Failure      408  {object}  models.ErrResponse
+// @Failure      500  {object}  models.ErrResponse
+// @Router       /api/moderation/blocked-words/{id} [delete]
+func (h *Handler) handleDeleteBlockedWord(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+	id, ok := mux.Vars(r)["id"]
+	parsedID, err := strconv.Atoi(id)
+	if !ok || err != nil {
+		response := "there is no blocked word id in URL"
+		if err != nil {
+			response = err.Error()
+		}
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	eventch := make(chan errResponse)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	go func() {
+		err := h.services.DeleteBlockedWord(ctx, uint(parsedID))
+
+		eventch <- errResponse{
+			err: err,
+		}
+	}()
+	select {
+	case <-ctx.Done():
+		httpHelper.SendErrorResponse(w, http.StatusRequestTimeout, "deleting blocked word took too long")
+		return
+	case resp := <-eventch:
+		if resp.err != nil {
+			status := 500
+			switch resp.err.Error() {
+			case models.ErrNotFound.Error():
+				status = 404
+			}
+			httpHelper.SendErrorResponse(w, uint(status), resp.err.Error())
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"github.com/gorilla/mux"
 	"net/http"
@@ -64,6 +65,14 @@ func (h *Handler) CreateProposalEvent(w http.ResponseWriter, r *http.Request) {
 			case models.ErrNotFound.Error():
 				status = 404
 			}
+			if errors.Is(resp.err, models.ErrContentRejected) || errors.Is(resp.err, models.ErrInvalidRecurrenceRule) ||
+				errors.Is(resp.err, models.ErrInvalidPublishDate) || errors.Is(resp.err, models.ErrInvalidQuestionnaire) ||
+				errors.Is(resp.err, models.ErrInvalidCoordinates) {
+				status = http.StatusBadRequest
+			}
+			if errors.Is(resp.err, models.ErrQuotaExceeded) {
+				status = http.StatusTooManyRequests
+			}
 			httpHelper.SendErrorResponse(w, uint(status), resp.err.Error())
 			return
 		}
@@ -108,6 +117,12 @@ func (h *Handler) UpdateProposalEvent(w http.ResponseWriter, r *http.Request) {
 	}
 	event.ID = uint(parsedID)
 
+	userID, ok := r.Context().Value(MemberIDContextKey).(uint)
+	if !ok {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, "user id isn't in context")
+		return
+	}
+
 	eventch := make(chan errResponse)
 	defer close(eventch)
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
@@ -124,7 +139,7 @@ func (h *Handler) UpdateProposalEvent(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	go func() {
-		err = h.services.UpdateProposalEvent(ctx, event.Internal())
+		err = h.services.UpdateProposalEvent(ctx, event.Internal(), userID)
 
 		eventch <- errResponse{
 			err: err,
@@ -141,6 +156,14 @@ func (h *Handler) UpdateProposalEvent(w http.ResponseWriter, r *http.Request) {
 			case models.ErrNotFound.Error():
 				status = 404
 			}
+			if errors.Is(resp.err, models.ErrContentRejected) || errors.Is(resp.err, models.ErrInvalidRecurrenceRule) ||
+				errors.Is(resp.err, models.ErrInvalidPublishDate) || errors.Is(resp.err, models.ErrCapacityBelowTakenSlots) ||
+				errors.Is(resp.err, models.ErrInvalidTimeSlot) {
+				status = http.StatusBadRequest
+			}
+			if errors.Is(resp.err, models.ErrQuotaExceeded) {
+				status = http.StatusTooManyRequests
+			}
 			httpHelper.SendErrorResponse(w, uint(status), resp.err.Error())
 			return
 		}
@@ -238,6 +261,10 @@ func (h *Handler) GetProposalEvent(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 		event, err := h.services.GetEvent(ctx, uint(parsedID))
+		userID, _ := r.Context().Value(MemberIDContextKey).(uint)
+		if err == nil && event.Status == models.InActive && !event.Organizes(userID, "") {
+			err = models.ErrNotFound
+		}
 
 		eventch <- getProposalEvent{
 			proposalEvent: models.GetProposalEvent(event),
@@ -313,6 +340,74 @@ func (h *Handler) GetProposalEvents(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// handleGetMemberProposalEvents gets a page of the proposal events created by the member
+// @Summary      Get a page of the active and done proposal events created by the member
+// @Tags         Proposal Event
+// @Produce      json
+// @Param        id         path  int true  "Member ID"
+// @Param        pageNumber query int false "Page number, the first page by default"
+// @Param        pageSize   query int false "Page size, 10 by default"
+// @Success      200  {object}  models.ProposalEventsWithPagination
+// @Failure      400  {object}  models.ErrResponse
+// @Failure      404  {object}  models.ErrResponse
+// @Failure      408  {object}  models.ErrResponse
+// @Failure      500  {object}  models.ErrResponse
+// @Router       /open-api/proposal/member/{id} [get]
+func (h *Handler) handleGetMemberProposalEvents(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+	id, ok := mux.Vars(r)["id"]
+	parsedID, err := strconv.Atoi(id)
+	if !ok || err != nil {
+		response := "there is no member id in URL"
+		if err != nil {
+			response = err.Error()
+		}
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, response)
+		return
+	}
+	pagination, err := parsePagination(r)
+	if err != nil {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	eventch := make(chan getProposalEventPagination)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	go func() {
+		events, err := h.services.GetMemberProposalEvents(ctx, uint(parsedID), pagination)
+
+		eventch <- getProposalEventPagination{
+			resp: models.ProposalEventsWithPagination{
+				ProposalEventsItems: models.GetProposalEventItems(events.Events...),
+				Pagination:          events.Pagination,
+			},
+			err: err,
+		}
+	}()
+	select {
+	case <-ctx.Done():
+		httpHelper.SendErrorResponse(w, http.StatusRequestTimeout,
+			fmt.Sprintf("getting proposal events of member with id - %d took too long", parsedID))
+		return
+	case resp := <-eventch:
+		if resp.err != nil {
+			status := 500
+			switch resp.err.Error() {
+			case models.ErrNotFound.Error():
+				status = 404
+			}
+			httpHelper.SendErrorResponse(w, uint(status), resp.err.Error())
+			return
+		}
+
+		err = httpHelper.SendHTTPResponse(w, resp.resp)
+		if err != nil {
+			zlog.Log.Error(err, "could not send proposal events")
+		}
+	}
+}
+
 // GetUsersProposalEvents get all proposal events created by user requester id
 // @Summary      Get all proposal events created by user requester id
 // @SearchValuesResponse         Proposal Event
@@ -369,16 +464,14 @@ func (h *Handler) SendProposalEventComplaint(w http.ResponseWriter, r *http.Requ
 
 }
 
-func (h *Handler) GetProposalEventReports(w http.ResponseWriter, r *http.Request) {
-
-}
-
 // ResponseProposalEvent creates new transaction with waiting status for the proposal event if slot is available
-// @Summary      CreateNotification new transaction with waiting status for the proposal event if slot is available
+// @Summary      CreateNotification new transaction with waiting status for the proposal event if slot is available.
+// When there is no free slot and joinWaitlist is set the user is put into the event's waitlist.
 // @SearchValuesResponse         Proposal Event
 // @Param request body models.TransactionAcceptCreateRequest true "query params"
 // @Accept       json
-// @Success      200
+// @Success      200  {object}  models.WaitlistJoinResponse
+// @Failure      400  {object}  models.ErrResponse
 // @Failure      401  {object}  models.ErrResponse
 // @Failure      403  {object}  models.ErrResponse
 // @Failure      404  {object}  models.ErrResponse
@@ -398,15 +491,10 @@ func (h *Handler) ResponseProposalEvent(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	errch := make(chan errResponse)
-	defer close(errch)
+	respch := make(chan waitlistJoinResponse)
+	defer close(respch)
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
 	defer cancel()
-	err = h.validateProposalEventTransactionRequest(ctx, uint(transactionInfo.ID))
-	if err != nil {
-		httpHelper.SendErrorResponse(w, http.StatusBadRequest, err.Error())
-		return
-	}
 	proposalEvent, err := h.services.GetEvent(ctx, uint(transactionInfo.ID))
 	if err != nil {
 		httpHelper.SendErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("cannot get proposal event by requested %d id",
@@ -419,9 +507,20 @@ func (h *Handler) ResponseProposalEvent(w http.ResponseWriter, r *http.Request)
 		return
 	}
 	go func() {
-		err = h.services.Response(ctx, uint(transactionInfo.ID), userID.(uint), transactionInfo.Comment)
+		err := h.services.Response(ctx, uint(transactionInfo.ID), userID.(uint), transactionInfo.TimeSlotID,
+			transactionInfo.Comment, transactionInfo.Answers)
+		if errors.Is(err, models.ErrNoFreeSlots) && transactionInfo.JoinWaitlist {
+			waitlistEntry, err := h.services.JoinWaitlist(ctx, uint(transactionInfo.ID), userID.(uint),
+				transactionInfo.Comment, transactionInfo.Answers)
+			respch <- waitlistJoinResponse{
+				resp:   waitlistEntry,
+				joined: true,
+				err:    err,
+			}
+			return
+		}
 
-		errch <- errResponse{
+		respch <- waitlistJoinResponse{
 			err: err,
 		}
 	}()
@@ -429,16 +528,28 @@ func (h *Handler) ResponseProposalEvent(w http.ResponseWriter, r *http.Request)
 	case <-ctx.Done():
 		httpHelper.SendErrorResponse(w, http.StatusRequestTimeout, "responding proposal event took too long")
 		return
-	case resp := <-errch:
+	case resp := <-respch:
 		if resp.err != nil {
 			status := 500
 			switch resp.err.Error() {
 			case models.ErrNotFound.Error():
 				status = 404
+			case models.ErrNoFreeSlots.Error(), models.ErrTimeSlotFull.Error():
+				status = 400
+			}
+			if errors.Is(resp.err, models.ErrInvalidTimeSlot) || errors.Is(resp.err, models.ErrInvalidAnswers) {
+				status = http.StatusBadRequest
+			}
+			if errors.Is(resp.err, models.ErrQuotaExceeded) {
+				status = http.StatusTooManyRequests
 			}
 			httpHelper.SendErrorResponse(w, uint(status), resp.err.Error())
 			return
 		}
+		if resp.joined {
+			httpHelper.SendHTTPResponse(w, resp.resp)
+			return
+		}
 		w.WriteHeader(http.StatusOK)
 	}
 }
@@ -474,9 +585,15 @@ func (h *Handler) AcceptProposalEventResponse(w http.ResponseWriter, r *http.Req
 		httpHelper.SendErrorResponse(w, http.StatusBadRequest, response)
 		return
 	}
+	userID, ok := r.Context().Value(MemberIDContextKey).(uint)
+	if !ok {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, "user id isn't in context")
+		return
+	}
 	accept := models.AcceptRequest{
 		Accept:        transactionInfo.IsAccepted,
 		TransactionID: uint(parsedID),
+		MemberID:      userID,
 	}
 	errch := make(chan errResponse)
 	defer close(errch)
@@ -519,18 +636,6 @@ func (h *Handler) AcceptProposalEventResponse(w http.ResponseWriter, r *http.Req
 	}
 }
 
-func (h *Handler) validateProposalEventTransactionRequest(ctx context.Context, proposalEventID uint) error {
-	event, err := h.services.GetEvent(ctx, proposalEventID)
-	if err != nil {
-		return err
-	}
-
-	if event.RemainingHelps-1 < 0 {
-		return fmt.Errorf("there is no available slot")
-	}
-	return nil
-}
-
 // UpdateProposalEventTransactionStatus updates proposal event transaction's status to one of models.TransactionStatus state
 // @Summary      Update proposal event transaction's status to to one of models.TransactionStatus state
 // @SearchValuesResponse         Proposal Event
@@ -611,6 +716,9 @@ func (h *Handler) UpdateProposalEventTransactionStatus(w http.ResponseWriter, r
 			case models.ErrNotFound.Error():
 				status = 404
 			}
+			if errors.Is(resp.err, models.ErrInvalidReport) {
+				status = http.StatusBadRequest
+			}
 			httpHelper.SendErrorResponse(w, uint(status), resp.err.Error())
 			return
 		}
@@ -683,6 +791,12 @@ func (h *Handler) WriteCommentInProposalEvent(w http.ResponseWriter, r *http.Req
 			case models.ErrNotFound.Error():
 				status = 404
 			}
+			if errors.Is(resp.err, models.ErrContentRejected) {
+				status = http.StatusBadRequest
+			}
+			if errors.Is(resp.err, models.ErrQuotaExceeded) {
+				status = http.StatusTooManyRequests
+			}
 			httpHelper.SendErrorResponse(w, uint(status), resp.err.Error())
 			return
 		}
@@ -838,6 +952,9 @@ func (h *Handler) UpdateProposalEventComment(w http.ResponseWriter, r *http.Requ
 			case models.ErrNotFound.Error():
 				status = 404
 			}
+			if errors.Is(resp.err, models.ErrContentRejected) {
+				status = http.StatusBadRequest
+			}
 			httpHelper.SendErrorResponse(w, uint(status), resp.err.Error())
 			return
 		}
@@ -967,6 +1084,7 @@ func (h *Handler) GetProposalEventTransactions(w http.ResponseWriter, r *http.Re
 				ResponderStatus:   t.ResponderStatus,
 				Creator:           t.Creator.ToShortInfo(),
 				Responder:         t.Responder.ToShortInfo(),
+				Answers:           t.QuestionnaireAnswers,
 			}
 			if t.CompetitionDate.Valid {
 				transaction.CompetitionDate = t.CompetitionDate.Time
@@ -1038,6 +1156,9 @@ func (h *Handler) SearchProposalEvents(w http.ResponseWriter, r *http.Request) {
 			case models.ErrNotFound.Error():
 				status = 404
 			}
+			if errors.Is(resp.err, models.ErrInvalidCoordinates) {
+				status = http.StatusBadRequest
+			}
 			httpHelper.SendErrorResponse(w, uint(status), resp.err.Error())
 			return
 		}
@@ -0,0 +1,311 @@
+package handlers
+
+import (
+	"Kurajj/internal/models"
+	httpHelper "Kurajj/pkg/http"
+	"context"
+	"fmt"
+	"github.com/gorilla/mux"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+func (h *Handler) initQuotaHandlers(admin *mux.Router) {
+	admin.HandleFunc("/quota-policies", h.handleGetQuotaPolicies).Methods(http.MethodGet)
+	admin.HandleFunc("/quota-policies/member/{id}", h.handleSaveMemberQuotaPolicy).Methods(http.MethodPut)
+	admin.HandleFunc("/quota-policies/member/{id}", h.handleDeleteMemberQuotaPolicy).Methods(http.MethodDelete)
+	admin.HandleFunc("/quota-policies/{tier}", h.handleSaveQuotaPolicy).Methods(http.MethodPut)
+	admin.HandleFunc("/members/{id}/verified-organization", h.handleSetVerifiedOrganization).Methods(http.MethodPut)
+}
+
+// handleGetQuotaPolicies gets quota policies of all tiers and members' overrides
+// @Summary      Gets quota policies of all tiers and members' overrides
+// @Tags         Quota
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  models.QuotaPoliciesResponse
+// @Failure      401  {object}  models.ErrResponse
+// @Failure      403  {object}  models.ErrResponse
+// @Failure      408  {object}  models.ErrResponse
+// @Failure      500  {object}  models.ErrResponse
+// @Router       /api/admin/quota-policies [get]
+func (h *Handler) handleGetQuotaPolicies(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	eventch := make(chan quotaPoliciesResponse)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	go func() {
+		policies, err := h.services.GetQuotaPolicies(ctx)
+
+		eventch <- quotaPoliciesResponse{
+			policies: policies,
+			err:      err,
+		}
+	}()
+	select {
+	case <-ctx.Done():
+		httpHelper.SendErrorResponse(w, http.StatusRequestTimeout, "getting quota policies took too long")
+		return
+	case resp := <-eventch:
+		if resp.err != nil {
+			httpHelper.SendErrorResponse(w, http.StatusInternalServerError, resp.err.Error())
+			return
+		}
+
+		httpHelper.SendHTTPResponse(w, models.CreateQuotaPoliciesResponse(resp.policies))
+	}
+}
+
+// handleSaveQuotaPolicy replaces the policy of the default or verified organization tier
+// @Summary      Replaces the policy of the default or verified organization tier. A zero limit means no limit.
+// @Tags         Quota
+// @Accept       json
+// @Produce      json
+// @Param        tier path string true "Tier" Enums(default, verified_organization)
+// @Param request body models.QuotaPolicyRequest true "query params"
+// @Success      200  {object}  models.CreationResponse
+// @Failure      400  {object}  models.ErrResponse
+// @Failure      401  {object}  models.ErrResponse
+// @Failure      403  {object}  models.ErrResponse
+// @Failure      408  {object}  models.ErrResponse
+// @Failure      500  {object}  models.ErrResponse
+// @Router       /api/admin/quota-policies/{tier} [put]
+func (h *Handler) handleSaveQuotaPolicy(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+	tier := models.QuotaTier(mux.Vars(r)["tier"])
+	if tier == models.MemberOverrideQuotaTier {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, "member overrides are saved by member id")
+		return
+	}
+
+	h.saveQuotaPolicy(w, r, tier, 0)
+}
+
+// handleSaveMemberQuotaPolicy overrides quota policy of the member
+// @Summary      Overrides quota policy of the member regardless of the member's tier. A zero limit means no limit.
+// @Tags         Quota
+// @Accept       json
+// @Produce      json
+// @Param        id   path int  true  "Member ID"
+// @Param request body models.QuotaPolicyRequest true "query params"
+// @Success      200  {object}  models.CreationResponse
+// @Failure      400  {object}  models.ErrResponse
+// @Failure      401  {object}  models.ErrResponse
+// @Failure      403  {object}  models.ErrResponse
+// @Failure      408  {object}  models.ErrResponse
+// @Failure      500  {object}  models.ErrResponse
+// @Router       /api/admin/quota-policies/member/{id} [put]
+func (h *Handler) handleSaveMemberQuotaPolicy(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+	id, ok := mux.Vars(r)["id"]
+	parsedID, err := strconv.Atoi(id)
+	if !ok || err != nil {
+		response := "there is no member id in URL"
+		if err != nil {
+			response = err.Error()
+		}
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	h.saveQuotaPolicy(w, r, models.MemberOverrideQuotaTier, uint(parsedID))
+}
+
+func (h *Handler) saveQuotaPolicy(w http.ResponseWriter, r *http.Request, tier models.QuotaTier, memberID uint) {
+	request, err := models.UnmarshalQuotaPolicyRequest(&r.Body)
+	if err != nil {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	policy, err := request.Internal(tier, memberID)
+	if err != nil {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	eventch := make(chan idResponse)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	go func() {
+		id, err := h.services.SaveQuotaPolicy(ctx, policy)
+
+		eventch <- idResponse{
+			id:  int(id),
+			err: err,
+		}
+	}()
+	select {
+	case <-ctx.Done():
+		httpHelper.SendErrorResponse(w, http.StatusRequestTimeout, "saving quota policy took too long")
+		return
+	case resp := <-eventch:
+		if resp.err != nil {
+			httpHelper.SendErrorResponse(w, http.StatusInternalServerError, resp.err.Error())
+			return
+		}
+
+		httpHelper.SendHTTPResponse(w, models.CreationResponse{ID: resp.id})
+	}
+}
+
+// handleDeleteMemberQuotaPolicy removes the override, so the member's tier policy is applied again
+// @Summary      Removes the override, so the member's tier policy is applied again
+// @Tags         Quota
+// @Accept       json
+// @Param        id   path int  true  "Member ID"
+// @Success      200
+// @Failure      401  {object}  models.ErrResponse
+// @Failure      403  {object}  models.ErrResponse
+// @Failure      408  {object}  models.ErrResponse
+// @Failure      500  {object}  models.ErrResponse
+// @Router       /api/admin/quota-policies/member/{id} [delete]
+func (h *Handler) handleDeleteMemberQuotaPolicy(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+	id, ok := mux.Vars(r)["id"]
+	parsedID, err := strconv.Atoi(id)
+	if !ok || err != nil {
+		response := "there is no member id in URL"
+		if err != nil {
+			response = err.Error()
+		}
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	eventch := make(chan errResponse)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	go func() {
+		err := h.services.DeleteMemberQuotaPolicy(ctx, uint(parsedID))
+
+		eventch <- errResponse{
+			err: err,
+		}
+	}()
+	select {
+	case <-ctx.Done():
+		httpHelper.SendErrorResponse(w, http.StatusRequestTimeout,
+			fmt.Sprintf("deleting quota policy of member with id - %d took too long", parsedID))
+		return
+	case resp := <-eventch:
+		if resp.err != nil {
+			httpHelper.SendErrorResponse(w, http.StatusInternalServerError, resp.err.Error())
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// handleSetVerifiedOrganization marks the member as a verified organization or removes the mark
+// @Summary      Marks the member as a verified organization or removes the mark
+// @Tags         Quota
+// @Accept       json
+// @Param        id   path int  true  "Member ID"
+// @Param request body models.VerifiedOrganizationRequest true "query params"
+// @Success      200
+// @Failure      400  {object}  models.ErrResponse
+// @Failure      401  {object}  models.ErrResponse
+// @Failure      403  {object}  models.ErrResponse
+// @Failure      404  {object}  models.ErrResponse
+// @Failure      408  {object}  models.ErrResponse
+// @Failure      500  {object}  models.ErrResponse
+// @Router       /api/admin/members/{id}/verified-organization [put]
+func (h *Handler) handleSetVerifiedOrganization(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+	id, ok := mux.Vars(r)["id"]
+	parsedID, err := strconv.Atoi(id)
+	if !ok || err != nil {
+		response := "there is no member id in URL"
+		if err != nil {
+			response = err.Error()
+		}
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	request, err := models.UnmarshalVerifiedOrganizationRequest(&r.Body)
+	if err != nil {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	eventch := make(chan errResponse)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	go func() {
+		err := h.services.SetVerifiedOrganization(ctx, uint(parsedID), request.Verified)
+
+		eventch <- errResponse{
+			err: err,
+		}
+	}()
+	select {
+	case <-ctx.Done():
+		httpHelper.SendErrorResponse(w, http.StatusRequestTimeout,
+			fmt.Sprintf("updating member with id - %d took too long", parsedID))
+		return
+	case resp := <-eventch:
+		if resp.err != nil {
+			status := 500
+			switch resp.err.Error() {
+			case models.ErrNotFound.Error():
+				status = 404
+			}
+			httpHelper.SendErrorResponse(w, uint(status), resp.err.Error())
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// handleGetMemberQuota gets the quota policy applied to the user and the user's current usage
+// @Summary      Gets the quota policy applied to the user and the user's current usage
+// @Tags         Quota
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  models.MemberQuota
+// @Failure      401  {object}  models.ErrResponse
+// @Failure      403  {object}  models.ErrResponse
+// @Failure      404  {object}  models.ErrResponse
+// @Failure      408  {object}  models.ErrResponse
+// @Failure      500  {object}  models.ErrResponse
+// @Router       /api/quota [get]
+func (h *Handler) handleGetMemberQuota(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+	userID, ok := r.Context().Value(MemberIDContextKey).(uint)
+	if !ok {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, "user id isn't in context")
+		return
+	}
+
+	eventch := make(chan memberQuotaResponse)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	go func() {
+		quota, err := h.services.GetMemberQuota(ctx, userID)
+
+		eventch <- memberQuotaResponse{
+			quota: quota,
+			err:   err,
+		}
+	}()
+	select {
+	case <-ctx.Done():
+		httpHelper.SendErrorResponse(w, http.StatusRequestTimeout, "getting quota took too long")
+		return
+	case resp := <-eventch:
+		if resp.err != nil {
+			status := 500
+			switch resp.err.Error() {
+			case models.ErrNotFound.Error():
+				status = 404
+			}
+			httpHelper.SendErrorResponse(w, uint(status), resp.err.Error())
+			return
+		}
+
+		httpHelper.SendHTTPResponse(w, resp.quota)
+	}
+}
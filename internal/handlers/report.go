@@ -0,0 +1,236 @@
+package handlers
+
+import (
+	"Kurajj/internal/models"
+	httpHelper "Kurajj/pkg/http"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/gorilla/mux"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// GetProposalEventReports gets reports of the proposal event transactions
+// @Summary      Gets reports of the proposal event transactions. The author gets all reports, participants get only their own.
+// @Tags         Proposal Event
+// @Accept       json
+// @Produce      json
+// @Param        id   path int  true  "ID"
+// @Success      200  {object}  models.ReportsResponse
+// @Failure      401  {object}  models.ErrResponse
+// @Failure      403  {object}  models.ErrResponse
+// @Failure      404  {object}  models.ErrResponse
+// @Failure      408  {object}  models.ErrResponse
+// @Failure      500  {object}  models.ErrResponse
+// @Router       /api/events/proposal/reports/{id} [get]
+func (h *Handler) GetProposalEventReports(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+	id, ok := mux.Vars(r)["id"]
+	parsedID, err := strconv.Atoi(id)
+	if !ok || err != nil {
+		response := "there is no proposal event id in URL"
+		if err != nil {
+			response = err.Error()
+		}
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	userID, ok := r.Context().Value(MemberIDContextKey).(uint)
+	if !ok {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, "user id isn't in context")
+		return
+	}
+
+	eventch := make(chan reportsResponse)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	go func() {
+		reports, err := h.services.GetProposalEventReports(ctx, uint(parsedID), userID)
+
+		eventch <- reportsResponse{
+			reports: reports,
+			err:     err,
+		}
+	}()
+	select {
+	case <-ctx.Done():
+		httpHelper.SendErrorResponse(w, http.StatusRequestTimeout,
+			fmt.Sprintf("getting reports of proposal event with id - %d took too long", parsedID))
+		return
+	case resp := <-eventch:
+		if resp.err != nil {
+			status := 500
+			switch resp.err.Error() {
+			case models.ErrNotFound.Error():
+				status = 404
+			}
+			httpHelper.SendErrorResponse(w, uint(status), resp.err.Error())
+			return
+		}
+
+		httpHelper.SendHTTPResponse(w, models.CreateReportsResponse(resp.reports))
+	}
+}
+
+// handleAddTransactionReport attaches one more report file to the proposal event transaction
+// @Summary      Attaches one more report file to the proposal event transaction. Only the transaction participants can do it. filePath can only refer to a report file of the same transaction.
+// @Tags         Proposal Event
+// @Accept       json
+// @Produce      json
+// @Param        id   path int  true  "Transaction ID"
+// @Param request body models.ReportCreateRequest true "query params"
+// @Success      200  {object}  models.CreationResponse
+// @Failure      400  {object}  models.ErrResponse
+// @Failure      401  {object}  models.ErrResponse
+// @Failure      403  {object}  models.ErrResponse
+// @Failure      404  {object}  models.ErrResponse
+// @Failure      408  {object}  models.ErrResponse
+// @Failure      500  {object}  models.ErrResponse
+// @Router       /api/events/proposal/reports/transaction/{id} [post]
+func (h *Handler) handleAddTransactionReport(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+	id, ok := mux.Vars(r)["id"]
+	parsedID, err := strconv.Atoi(id)
+	if !ok || err != nil {
+		response := "there is no transaction id in URL"
+		if err != nil {
+			response = err.Error()
+		}
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	userID, ok := r.Context().Value(MemberIDContextKey).(uint)
+	if !ok {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, "user id isn't in context")
+		return
+	}
+
+	report, err := models.UnmarshalReportCreateRequest(&r.Body)
+	if err != nil {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if (len(report.FileBytes) == 0 || report.FileType == "") && report.FilePath == "" {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, "fileBytes and fileType or filePath should be set")
+		return
+	}
+
+	eventch := make(chan idResponse)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	go func() {
+		id, err := h.services.AddTransactionReport(ctx, uint(parsedID), userID,
+			bytes.NewReader(report.FileBytes), report.FileType, report.FilePath)
+
+		eventch <- idResponse{
+			id:  int(id),
+			err: err,
+		}
+	}()
+	select {
+	case <-ctx.Done():
+		httpHelper.SendErrorResponse(w, http.StatusRequestTimeout,
+			fmt.Sprintf("adding report to transaction with id - %d took too long", parsedID))
+		return
+	case resp := <-eventch:
+		if resp.err != nil {
+			status := 500
+			switch resp.err.Error() {
+			case models.ErrNotFound.Error():
+				status = 404
+			}
+			if errors.Is(resp.err, models.ErrInvalidReport) {
+				status = http.StatusBadRequest
+			}
+			httpHelper.SendErrorResponse(w, uint(status), resp.err.Error())
+			return
+		}
+
+		httpHelper.SendHTTPResponse(w, models.CreationResponse{ID: resp.id})
+	}
+}
+
+// handleDownloadReport downloads the report file
+// @Summary      Downloads the report file. Only the participants of the report's transaction can do it.
+// @Tags         Proposal Event
+// @Produce      octet-stream
+// @Param        id   path int  true  "ID"
+// @Success      200
+// @Failure      401  {object}  models.ErrResponse
+// @Failure      403  {object}  models.ErrResponse
+// @Failure      404  {object}  models.ErrResponse
+// @Failure      408  {object}  models.ErrResponse
+// @Failure      500  {object}  models.ErrResponse
+// @Router       /api/events/proposal/reports/file/{id} [get]
+func (h *Handler) handleDownloadReport(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+	id, ok := mux.Vars(r)["id"]
+	parsedID, err := strconv.Atoi(id)
+	if !ok || err != nil {
+		response := "there is no report id in URL"
+		if err != nil {
+			response = err.Error()
+		}
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	userID, ok := r.Context().Value(MemberIDContextKey).(uint)
+	if !ok {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, "user id isn't in context")
+		return
+	}
+
+	eventch := make(chan reportFileResponse)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	go func() {
+		report, file, err := h.services.GetReportFile(ctx, uint(parsedID), userID)
+		if err != nil {
+			eventch <- reportFileResponse{err: err}
+			return
+		}
+		if closer, ok := file.(io.Closer); ok {
+			defer closer.Close()
+		}
+		fileBytes, err := io.ReadAll(file)
+
+		eventch <- reportFileResponse{
+			report: report,
+			file:   fileBytes,
+			err:    err,
+		}
+	}()
+	select {
+	case <-ctx.Done():
+		httpHelper.SendErrorResponse(w, http.StatusRequestTimeout,
+			fmt.Sprintf("downloading report with id - %d took too long", parsedID))
+		return
+	case resp := <-eventch:
+		if resp.err != nil {
+			status := 500
+			switch resp.err.Error() {
+			case models.ErrNotFound.Error():
+				status = 404
+			}
+			httpHelper.SendErrorResponse(w, uint(status), resp.err.Error())
+			return
+		}
+
+		contentType := mime.TypeByExtension("." + resp.report.FileType)
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=report-%d.%s",
+			resp.report.ID, resp.report.FileType))
+		w.Write(resp.file)
+	}
+}
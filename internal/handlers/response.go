@@ -81,3 +81,69 @@ type geHelpStatistics struct {
 	statistics models.HelpEventStatistics
 	err        error
 }
+
+type moderationQueueResponse struct {
+	items []models.ModerationItem
+	err   error
+}
+
+type blockedWordsResponse struct {
+	words []models.BlockedWord
+	err   error
+}
+
+type waitlistJoinResponse struct {
+	resp   models.WaitlistJoinResponse
+	joined bool
+	err    error
+}
+
+type waitlistResponse struct {
+	entries []models.WaitlistEntry
+	err     error
+}
+
+type eventTemplatesResponse struct {
+	templates []models.EventTemplate
+	err       error
+}
+
+type reportsResponse struct {
+	reports []models.Report
+	err     error
+}
+
+type reportFileResponse struct {
+	report models.Report
+	file   []byte
+	err    error
+}
+
+type unitsResponse struct {
+	units []models.UnitDefinition
+	err   error
+}
+
+type quotaPoliciesResponse struct {
+	policies []models.QuotaPolicy
+	err      error
+}
+
+type memberQuotaResponse struct {
+	quota models.MemberQuota
+	err   error
+}
+
+type jobStatusesResponse struct {
+	jobs []models.JobStatus
+}
+
+type intakeLedgerResponse struct {
+	entries []models.IntakeEntry
+	err     error
+}
+
+type dropPointArrivalsResponse struct {
+	arrivals []models.DropPointArrival
+	err      error
+}
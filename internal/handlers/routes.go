@@ -29,14 +29,19 @@ func (h *Handler) InitRoutes() http.Handler {
 	openAPI.Use(h.SetId)
 	openAPI.HandleFunc("/proposal-search", h.SearchProposalEvents).
 		Methods(http.MethodPost)
+	openAPI.HandleFunc("/proposal/member/{id}", h.handleGetMemberProposalEvents).
+		Methods(http.MethodGet)
 	openAPI.HandleFunc("/proposal/{id}", h.GetProposalEvent).
 		Methods(http.MethodGet)
 	openAPI.HandleFunc("/proposal/", h.GetProposalEvents).
 		Methods(http.MethodGet)
+	openAPI.HandleFunc("/help/", h.handleGetHelpEvents).Methods(http.MethodGet)
+	openAPI.HandleFunc("/help/member/{id}", h.handleGetMemberHelpEvents).Methods(http.MethodGet)
 	openAPI.HandleFunc("/help/{id}", h.handleGetHelpEventByID).Methods(http.MethodGet)
 	openAPI.HandleFunc("/help-search", h.handleSearchHelpEvents).
 		Methods(http.MethodPost)
 	openAPI.HandleFunc("/statistics", h.handleGetGlobalStatistics).Methods(http.MethodGet)
+	openAPI.HandleFunc("/units", h.handleGetUnits).Methods(http.MethodGet)
 	fileRouter := openAPI.PathPrefix("/file").Subrouter()
 	fileRouter.HandleFunc("/", h.handleUploadFile).Methods(http.MethodPost)
 	fileRouter.HandleFunc("/{id}", h.handleDeleteFile).Methods(http.MethodDelete)
@@ -45,9 +50,12 @@ func (h *Handler) InitRoutes() http.Handler {
 	apiRouter.Use(h.Authentication)
 
 	h.initComplaintHandlers(apiRouter)
+	h.initModerationHandlers(apiRouter)
+	h.initEventTemplateHandlers(apiRouter)
 
 	apiRouter.HandleFunc("/refresh-user-data", h.RefreshUserData).Methods(http.MethodPost)
 	apiRouter.HandleFunc("/read-notifications", h.ReadNotifications).Methods(http.MethodPut)
+	apiRouter.HandleFunc("/quota", h.handleGetMemberQuota).Methods(http.MethodGet)
 
 	auth := r.PathPrefix("/auth").Subrouter()
 	auth.HandleFunc("/sign-up", h.UserSignUp).
@@ -64,8 +72,16 @@ func (h *Handler) InitRoutes() http.Handler {
 
 	adminSubRouter := apiRouter.PathPrefix("/admin").Subrouter()
 	adminSubRouter.HandleFunc("/create", h.CreateNewAdmin)
+	adminOnlySubRouter := adminSubRouter.NewRoute().Subrouter()
+	adminOnlySubRouter.Use(h.AdminAuthorization)
+	h.initQuotaHandlers(adminOnlySubRouter)
+	h.initScheduleHandlers(adminOnlySubRouter)
 
 	eventsSubRouter := apiRouter.PathPrefix("/events").Subrouter()
+	eventsSubRouter.HandleFunc("/{type}/{id}/clone", h.handleCloneEvent).
+		Methods(http.MethodPost)
+	h.initEventUpdateHandlers(eventsSubRouter)
+	h.initCoOrganizerHandlers(eventsSubRouter)
 	proposalEventSubRouter := eventsSubRouter.PathPrefix("/proposal").Subrouter()
 
 	proposalEventSubRouter.HandleFunc("/create", h.CreateProposalEvent).
@@ -78,6 +94,10 @@ func (h *Handler) InitRoutes() http.Handler {
 		Methods(http.MethodDelete)
 	proposalEventSubRouter.HandleFunc("/reports/{id}", h.GetProposalEventReports).
 		Methods(http.MethodGet)
+	proposalEventSubRouter.HandleFunc("/reports/transaction/{id}", h.handleAddTransactionReport).
+		Methods(http.MethodPost)
+	proposalEventSubRouter.HandleFunc("/reports/file/{id}", h.handleDownloadReport).
+		Methods(http.MethodGet)
 	proposalEventSubRouter.HandleFunc("/complain/{id}", h.SendProposalEventComplaint).
 		Methods(http.MethodPost)
 
@@ -99,6 +119,13 @@ func (h *Handler) InitRoutes() http.Handler {
 	proposalEventSubRouter.HandleFunc("/update-status/{id}", h.UpdateProposalEventTransactionStatus).
 		Methods(http.MethodPost)
 
+	proposalEventSubRouter.HandleFunc("/waitlist/{id}", h.handleGetWaitlist).
+		Methods(http.MethodGet)
+	proposalEventSubRouter.HandleFunc("/waitlist/{id}", h.handleLeaveWaitlist).
+		Methods(http.MethodDelete)
+	proposalEventSubRouter.HandleFunc("/waitlist/confirm/{id}", h.handleConfirmWaitlistSlot).
+		Methods(http.MethodPost)
+
 	proposalEventSubRouter.HandleFunc("/statistics", h.handleGetProposalEventStatistics).Methods(http.MethodGet)
 
 	proposalEventSubRouter.HandleFunc("/tags/{id}", h.GetProposalEventTags).Methods(http.MethodGet)
@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"Kurajj/internal/models"
+	httpHelper "Kurajj/pkg/http"
+	"context"
+	"github.com/gorilla/mux"
+	"net/http"
+	"time"
+)
+
+func (h *Handler) initScheduleHandlers(admin *mux.Router) {
+	admin.HandleFunc("/jobs", h.handleGetJobStatuses).Methods(http.MethodGet)
+}
+
+// handleGetJobStatuses gets statuses of background jobs
+// @Summary      Gets statuses of background jobs with their last run and last error
+// @Tags         Admin
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  models.JobStatusesResponse
+// @Failure      401  {object}  models.ErrResponse
+// @Failure      403  {object}  models.ErrResponse
+// @Failure      408  {object}  models.ErrResponse
+// @Router       /api/admin/jobs [get]
+func (h *Handler) handleGetJobStatuses(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	eventch := make(chan jobStatusesResponse)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	go func() {
+		jobs := h.services.GetJobStatuses(ctx)
+
+		eventch <- jobStatusesResponse{
+			jobs: jobs,
+		}
+	}()
+	select {
+	case <-ctx.Done():
+		httpHelper.SendErrorResponse(w, http.StatusRequestTimeout, "getting job statuses took too long")
+		return
+	case resp := <-eventch:
+		httpHelper.SendHTTPResponse(w, models.JobStatusesResponse{Jobs: resp.jobs})
+	}
+}
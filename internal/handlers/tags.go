@@ -5,6 +5,8 @@ import (
 	httpHelper "Kurajj/pkg/http"
 	zlog "Kurajj/pkg/logger"
 	"context"
+	"errors"
+	"fmt"
 	"github.com/gorilla/mux"
 	"net/http"
 	"strconv"
@@ -12,11 +14,12 @@ import (
 )
 
 // UpsertTags   deletes all previous tags and their values and creates new by input
-// @Summary      Delete all previous tags and their values and create new by input
+// @Summary      Delete all previous tags and their values and create new by input, only the event author and editors can do it
 // @Tags         Tag
 // @Accept       json
 // @Param request body models.TagGroupRequestCreate true "query params"
 // @Success      200
+// @Failure      400  {object}  models.ErrResponse
 // @Failure      401  {object}  models.ErrResponse
 // @Failure      403  {object}  models.ErrResponse
 // @Failure      404  {object}  models.ErrResponse
@@ -32,11 +35,60 @@ func (h *Handler) UpsertTags(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	h.upsertTags(w, r, tags)
+}
+
+// handleUpsertHelpEventTags deletes all previous tags of the help event and creates new by input
+// @Summary      Delete all previous tags of the user's help event and their values and create new by input, event id and type of the body are ignored
+// @Tags         Tag
+// @Accept       json
+// @Param        id   path int  true  "ID"
+// @Param request body models.TagGroupRequestCreate true "query params"
+// @Success      200
+// @Failure      400  {object}  models.ErrResponse
+// @Failure      401  {object}  models.ErrResponse
+// @Failure      403  {object}  models.ErrResponse
+// @Failure      404  {object}  models.ErrResponse
+// @Failure      408  {object}  models.ErrResponse
+// @Failure      500  {object}  models.ErrResponse
+// @Router       /api/events/help/tags/{id} [put]
+func (h *Handler) handleUpsertHelpEventTags(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	id, ok := mux.Vars(r)["id"]
+	parsedID, err := strconv.Atoi(id)
+	if !ok || err != nil {
+		response := "there is no help event id in URL"
+		if err != nil {
+			response = err.Error()
+		}
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	tags, err := models.UnmarshalTagGroupCreateRequest(&r.Body)
+	if err != nil {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	tags.EventID = uint(parsedID)
+	tags.EventType = models.HelpEventType
+
+	h.upsertTags(w, r, tags)
+}
+
+func (h *Handler) upsertTags(w http.ResponseWriter, r *http.Request, tags models.TagGroupRequestCreate) {
+	userID, ok := r.Context().Value(MemberIDContextKey).(uint)
+	if !ok {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, "user id isn't in context")
+		return
+	}
+
 	eventch := make(chan errResponse)
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
 	defer cancel()
 	go func() {
-		respError := h.services.UpsertTags(ctx, tags.EventID, tags.EventType, tags.Internal())
+		respError := h.services.UpsertTags(ctx, tags.EventID, tags.EventType, userID, tags.Internal())
 
 		eventch <- errResponse{
 			err: respError,
@@ -44,7 +96,8 @@ func (h *Handler) UpsertTags(w http.ResponseWriter, r *http.Request) {
 	}()
 	select {
 	case <-ctx.Done():
-		httpHelper.SendErrorResponse(w, http.StatusRequestTimeout, "getting all transactions for proposal event took too long")
+		httpHelper.SendErrorResponse(w, http.StatusRequestTimeout,
+			fmt.Sprintf("updating tags of event with id - %d took too long", tags.EventID))
 		return
 	case resp := <-eventch:
 		if resp.err != nil {
@@ -53,6 +106,9 @@ func (h *Handler) UpsertTags(w http.ResponseWriter, r *http.Request) {
 			case models.ErrNotFound.Error():
 				status = 404
 			}
+			if errors.Is(resp.err, models.ErrInvalidTag) {
+				status = http.StatusBadRequest
+			}
 			httpHelper.SendErrorResponse(w, uint(status), resp.err.Error())
 			return
 		}
@@ -62,6 +118,27 @@ func (h *Handler) UpsertTags(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *Handler) GetProposalEventTags(w http.ResponseWriter, r *http.Request) {
+	h.getEventTags(w, r, models.ProposalEventType)
+}
+
+// handleGetHelpEventTags gets tags of the help event
+// @Summary      Get tags of the help event with their values
+// @Tags         Tag
+// @Produce      json
+// @Param        id   path int  true  "ID"
+// @Success      200  {object}  models.Tags
+// @Failure      400  {object}  models.ErrResponse
+// @Failure      401  {object}  models.ErrResponse
+// @Failure      403  {object}  models.ErrResponse
+// @Failure      404  {object}  models.ErrResponse
+// @Failure      408  {object}  models.ErrResponse
+// @Failure      500  {object}  models.ErrResponse
+// @Router       /api/events/help/tags/{id} [get]
+func (h *Handler) handleGetHelpEventTags(w http.ResponseWriter, r *http.Request) {
+	h.getEventTags(w, r, models.HelpEventType)
+}
+
+func (h *Handler) getEventTags(w http.ResponseWriter, r *http.Request, eventType models.EventType) {
 	defer r.Body.Close()
 
 	id, ok := mux.Vars(r)["id"]
@@ -78,7 +155,7 @@ func (h *Handler) GetProposalEventTags(w http.ResponseWriter, r *http.Request) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
 	defer cancel()
 	go func() {
-		tags, respError := h.services.GetTagsByEvent(ctx, uint(parsedID), models.ProposalEventType)
+		tags, respError := h.services.GetTagsByEvent(ctx, uint(parsedID), eventType)
 
 		eventch <- getTagsResponse{
 			tags: tags,
@@ -87,7 +164,8 @@ func (h *Handler) GetProposalEventTags(w http.ResponseWriter, r *http.Request) {
 	}()
 	select {
 	case <-ctx.Done():
-		httpHelper.SendErrorResponse(w, http.StatusRequestTimeout, "getting all transactions for proposal event took too long")
+		httpHelper.SendErrorResponse(w, http.StatusRequestTimeout,
+			fmt.Sprintf("getting tags of %s event with id - %d took too long", eventType, parsedID))
 		return
 	case resp := <-eventch:
 		if resp.err != nil {
@@ -100,29 +178,9 @@ func (h *Handler) GetProposalEventTags(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
-		w.WriteHeader(http.StatusOK)
-		tagsResponse := models.Tags{
-			Tags: make([]models.TagsResponse, len(resp.tags)),
-		}
-		for i, t := range resp.tags {
-			tagValues := make([]models.TagValueResponse, len(resp.tags[i].Values))
-			for j, tagValue := range t.Values {
-				tagValues[j] = models.TagValueResponse{
-					ID:    tagValue.ID,
-					Value: tagValue.Value,
-				}
-			}
-			tagsResponse.Tags[i] = models.TagsResponse{
-				ID:        t.ID,
-				Title:     t.Title,
-				EventID:   t.EventID,
-				EventType: t.EventType,
-				Values:    tagValues,
-			}
-		}
-		err = httpHelper.SendHTTPResponse(w, tagsResponse)
+		err = httpHelper.SendHTTPResponse(w, models.GenerateTagsResponse(resp.tags))
 		if err != nil {
-			zlog.Log.Error(err, "could not send proposal events")
+			zlog.Log.Error(err, "could not send event tags")
 		}
 	}
 }
@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"Kurajj/internal/models"
+	httpHelper "Kurajj/pkg/http"
+	zlog "Kurajj/pkg/logger"
+	"context"
+	"net/http"
+	"time"
+)
+
+// handleGetUnits gets the units needs can be measured in
+// @Summary      Gets the units needs can be measured in with their dimensions and conversion factors to the base units
+// @Tags         Help Event
+// @Produce      json
+// @Success      200  {object}  models.UnitsResponse
+// @Failure      408  {object}  models.ErrResponse
+// @Failure      500  {object}  models.ErrResponse
+// @Router       /open-api/units [get]
+func (h *Handler) handleGetUnits(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	eventch := make(chan unitsResponse)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	go func() {
+		units, err := h.services.GetUnits(ctx)
+
+		eventch <- unitsResponse{
+			units: units,
+			err:   err,
+		}
+	}()
+	select {
+	case <-ctx.Done():
+		httpHelper.SendErrorResponse(w, http.StatusRequestTimeout, "getting units took too long")
+		return
+	case resp := <-eventch:
+		if resp.err != nil {
+			httpHelper.SendErrorResponse(w, http.StatusInternalServerError, resp.err.Error())
+			return
+		}
+		err := httpHelper.SendHTTPResponse(w, models.UnitsResponse{Units: resp.units})
+		if err != nil {
+			zlog.Log.Error(err, "got an error")
+		}
+	}
+}
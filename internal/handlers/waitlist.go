@@ -0,0 +1,148 @@
+package handlers
+
+import (
+	"Kurajj/internal/models"
+	httpHelper "Kurajj/pkg/http"
+	"context"
+	"fmt"
+	"github.com/gorilla/mux"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// handleGetWaitlist gets members waiting for a free slot in the proposal event in their order
+// @Summary      Gets members waiting for a free slot in the proposal event in their order
+// @Tags         Proposal Event
+// @Accept       json
+// @Param        id   path int  true  "ID"
+// @Success      200  {object}  models.WaitlistResponse
+// @Failure      401  {object}  models.ErrResponse
+// @Failure      403  {object}  models.ErrResponse
+// @Failure      404  {object}  models.ErrResponse
+// @Failure      408  {object}  models.ErrResponse
+// @Failure      500  {object}  models.ErrResponse
+// @Router       /api/events/proposal/waitlist/{id} [get]
+func (h *Handler) handleGetWaitlist(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+	id, ok := mux.Vars(r)["id"]
+	parsedID, err := strconv.Atoi(id)
+	if !ok || err != nil {
+		response := "there is no proposal event id in URL"
+		if err != nil {
+			response = err.Error()
+		}
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	eventch := make(chan waitlistResponse)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	go func() {
+		entries, err := h.services.GetWaitlist(ctx, uint(parsedID))
+
+		eventch <- waitlistResponse{
+			entries: entries,
+			err:     err,
+		}
+	}()
+	select {
+	case <-ctx.Done():
+		httpHelper.SendErrorResponse(w, http.StatusRequestTimeout,
+			fmt.Sprintf("getting waitlist of proposal event with id - %d took too long", parsedID))
+		return
+	case resp := <-eventch:
+		if resp.err != nil {
+			status := 500
+			switch resp.err.Error() {
+			case models.ErrNotFound.Error():
+				status = 404
+			}
+			httpHelper.SendErrorResponse(w, uint(status), resp.err.Error())
+			return
+		}
+
+		httpHelper.SendHTTPResponse(w, models.CreateWaitlistResponse(resp.entries))
+	}
+}
+
+// handleLeaveWaitlist removes the user from the proposal event's waitlist
+// @Summary      Removes the user from the proposal event's waitlist. A slot offered to the user goes to the next member.
+// @Tags         Proposal Event
+// @Accept       json
+// @Param        id   path int  true  "ID"
+// @Success      200
+// @Failure      401  {object}  models.ErrResponse
+// @Failure      403  {object}  models.ErrResponse
+// @Failure      404  {object}  models.ErrResponse
+// @Failure      408  {object}  models.ErrResponse
+// @Failure      500  {object}  models.ErrResponse
+// @Router       /api/events/proposal/waitlist/{id} [delete]
+func (h *Handler) handleLeaveWaitlist(w http.ResponseWriter, r *http.Request) {
+	h.handleWaitlistAction(w, r, h.services.LeaveWaitlist, "leaving waitlist")
+}
+
+// handleConfirmWaitlistSlot confirms the slot offered to the user from the waitlist
+// @Summary      Confirms the slot offered to the user from the waitlist and creates a transaction with waiting status
+// @Tags         Proposal Event
+// @Accept       json
+// @Param        id   path int  true  "ID"
+// @Success      200
+// @Failure      401  {object}  models.ErrResponse
+// @Failure      403  {object}  models.ErrResponse
+// @Failure      404  {object}  models.ErrResponse
+// @Failure      408  {object}  models.ErrResponse
+// @Failure      500  {object}  models.ErrResponse
+// @Router       /api/events/proposal/waitlist/confirm/{id} [post]
+func (h *Handler) handleConfirmWaitlistSlot(w http.ResponseWriter, r *http.Request) {
+	h.handleWaitlistAction(w, r, h.services.ConfirmWaitlistSlot, "confirming waitlist slot")
+}
+
+func (h *Handler) handleWaitlistAction(w http.ResponseWriter, r *http.Request,
+	action func(ctx context.Context, proposalEventID, memberID uint) error, actionName string) {
+	defer r.Body.Close()
+	id, ok := mux.Vars(r)["id"]
+	parsedID, err := strconv.Atoi(id)
+	if !ok || err != nil {
+		response := "there is no proposal event id in URL"
+		if err != nil {
+			response = err.Error()
+		}
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	userID := r.Context().Value(MemberIDContextKey)
+	if userID == "" {
+		httpHelper.SendErrorResponse(w, http.StatusBadRequest, "user id isn't in context")
+		return
+	}
+
+	eventch := make(chan errResponse)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	go func() {
+		err := action(ctx, uint(parsedID), userID.(uint))
+
+		eventch <- errResponse{
+			err: err,
+		}
+	}()
+	select {
+	case <-ctx.Done():
+		httpHelper.SendErrorResponse(w, http.StatusRequestTimeout, fmt.Sprintf("%s took too long", actionName))
+		return
+	case resp := <-eventch:
+		if resp.err != nil {
+			status := 500
+			switch resp.err.Error() {
+			case models.ErrNotFound.Error():
+				status = 404
+			}
+			httpHelper.SendErrorResponse(w, uint(status), resp.err.Error())
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}
+}
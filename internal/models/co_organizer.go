@@ -0,0 +1,102 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/go-playground/validator/v10"
+	"io"
+	"time"
+)
+
+var ErrInvalidCoOrganizer = errors.New("invalid co-organizer")
+
+type CoOrganizerRole string
+
+const (
+	// EditorRole lets the co-organizer edit the event.
+	EditorRole CoOrganizerRole = "editor"
+	// TransactionManagerRole lets the co-organizer accept, complete and cancel transactions of the event.
+	TransactionManagerRole CoOrganizerRole = "transaction_manager"
+)
+
+func (r CoOrganizerRole) Validate() error {
+	if r != EditorRole && r != TransactionManagerRole {
+		return fmt.Errorf("%w: role should be %s or %s", ErrInvalidCoOrganizer, EditorRole, TransactionManagerRole)
+	}
+
+	return nil
+}
+
+// EventCoOrganizer is a member the author invited to manage the event with the role.
+type EventCoOrganizer struct {
+	EventID      uint            `gorm:"column:event_id;primaryKey"`
+	EventType    EventType       `gorm:"column:event_type;primaryKey"`
+	MemberID     uint            `gorm:"column:member_id;primaryKey"`
+	Role         CoOrganizerRole `gorm:"column:role"`
+	InvitedBy    uint            `gorm:"column:invited_by"`
+	CreationDate time.Time       `gorm:"column:creation_date"`
+}
+
+func (EventCoOrganizer) TableName() string {
+	return "event_co_organizer"
+}
+
+// organizes checks that the member is the author of the event or its co-organizer with the role.
+// Any role fits when the role is empty.
+func organizes(authorID uint, coOrganizers []EventCoOrganizer, memberID uint, role CoOrganizerRole) bool {
+	if memberID == authorID {
+		return true
+	}
+	for _, coOrganizer := range coOrganizers {
+		if coOrganizer.MemberID == memberID && (role == "" || coOrganizer.Role == role) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Organizes checks that the member is the author of the help event or its co-organizer with the role.
+func (h HelpEvent) Organizes(memberID uint, role CoOrganizerRole) bool {
+	return organizes(h.CreatedBy, h.CoOrganizers, memberID, role)
+}
+
+// Organizes checks that the member is the author of the proposal event or its co-organizer with the role.
+func (p ProposalEvent) Organizes(memberID uint, role CoOrganizerRole) bool {
+	return organizes(p.AuthorID, p.CoOrganizers, memberID, role)
+}
+
+type CoOrganizerRequest struct {
+	MemberID uint            `json:"memberID" validate:"required"`
+	Role     CoOrganizerRole `json:"role" validate:"required"`
+}
+
+func UnmarshalCoOrganizerRequest(r *io.ReadCloser) (CoOrganizerRequest, error) {
+	request := CoOrganizerRequest{}
+	err := json.NewDecoder(*r).Decode(&request)
+	if err != nil {
+		return CoOrganizerRequest{}, err
+	}
+
+	return request, validator.New().Struct(request)
+}
+
+type CoOrganizerResponse struct {
+	MemberID     uint            `json:"memberID"`
+	Role         CoOrganizerRole `json:"role"`
+	CreationDate time.Time       `json:"creationDate"`
+}
+
+func GenerateCoOrganizerResponses(coOrganizers []EventCoOrganizer) []CoOrganizerResponse {
+	responses := make([]CoOrganizerResponse, len(coOrganizers))
+	for i, coOrganizer := range coOrganizers {
+		responses[i] = CoOrganizerResponse{
+			MemberID:     coOrganizer.MemberID,
+			Role:         coOrganizer.Role,
+			CreationDate: coOrganizer.CreationDate,
+		}
+	}
+
+	return responses
+}
@@ -44,6 +44,18 @@ type CommentCreateRequest struct {
 type TransactionAcceptCreateRequest struct {
 	ID      int    `json:"id"`
 	Comment string `json:"comment"`
+	// JoinWaitlist puts the responder into the event's waitlist when there are no free slots.
+	JoinWaitlist bool `json:"joinWaitlist"`
+	// TimeSlotID is the booked time slot, it is required for events with time slots.
+	TimeSlotID uint `json:"timeSlotID"`
+	// Answers are the responder's answers to the event's questionnaire, keyed by question id.
+	Answers QuestionnaireAnswers `json:"answers"`
+	// Pledges are the amounts of help event needs the responder brings. Without them the responder
+	// takes part in all needs of the event.
+	Pledges []NeedPledge `json:"pledges"`
+	// DropPointID is the help event point the responder comes to during the ArrivalWindow.
+	DropPointID   uint        `json:"dropPointID"`
+	ArrivalWindow *TimeWindow `json:"arrivalWindow"`
 }
 
 func UnmarshalTransactionAcceptCreateRequest(b *io.ReadCloser) (TransactionAcceptCreateRequest, error) {
@@ -0,0 +1,295 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/go-playground/validator/v10"
+	"io"
+	"time"
+)
+
+var ErrInvalidDropPoint = errors.New("invalid drop-off point")
+
+type DropPointKind string
+
+const (
+	// DropOffPoint is where responders bring the help.
+	DropOffPoint DropPointKind = "drop_off"
+	// PickupPoint is where the help is picked up from the responders.
+	PickupPoint DropPointKind = "pickup"
+)
+
+const (
+	// openingTimeLayout is the layout of opening and closing times, e.g. 09:30.
+	openingTimeLayout = "15:04"
+	// ArrivalDateLayout is the layout of the day of the expected arrivals, e.g. 2024-05-01.
+	ArrivalDateLayout = "2006-01-02"
+)
+
+// DropPoint is a place of a help event where responders bring the help or where it is picked up.
+type DropPoint struct {
+	ID           uint          `gorm:"column:id"`
+	HelpEventID  uint          `gorm:"column:help_event_id"`
+	Kind         DropPointKind `gorm:"column:kind"`
+	Title        string        `gorm:"column:title"`
+	Region       string        `gorm:"column:area"`
+	City         string        `gorm:"column:city"`
+	District     string        `gorm:"column:district"`
+	Street       string        `gorm:"column:street"`
+	HomeLocation string        `gorm:"column:home"`
+	Latitude     *float64      `gorm:"column:latitude"`
+	Longitude    *float64      `gorm:"column:longitude"`
+	OpeningHours OpeningHours  `gorm:"column:opening_hours"`
+	Contact      string        `gorm:"column:contact"`
+	IsDeleted    bool          `gorm:"column:is_deleted"`
+}
+
+func (DropPoint) TableName() string {
+	return "help_event_drop_point"
+}
+
+func (d DropPoint) Validate() error {
+	if d.Kind != DropOffPoint && d.Kind != PickupPoint {
+		return fmt.Errorf("%w: kind should be %s or %s", ErrInvalidDropPoint, DropOffPoint, PickupPoint)
+	}
+	if d.City == "" || d.Street == "" {
+		return fmt.Errorf("%w: city and street are required", ErrInvalidDropPoint)
+	}
+	if (d.Latitude == nil) != (d.Longitude == nil) {
+		return fmt.Errorf("%w: both latitude and longitude should be set", ErrInvalidCoordinates)
+	}
+	if d.Latitude != nil {
+		err := GeoPoint{Latitude: *d.Latitude, Longitude: *d.Longitude}.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	return d.OpeningHours.Validate()
+}
+
+// OpeningPeriod is a period of a weekday when the point is open, times are in the HH:MM format.
+type OpeningPeriod struct {
+	Weekday time.Weekday `json:"weekday"`
+	Opens   string       `json:"opens"`
+	Closes  string       `json:"closes"`
+}
+
+func (o OpeningPeriod) minutes() (int, int, error) {
+	opens, err := time.Parse(openingTimeLayout, o.Opens)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: opening time %q should be in HH:MM format", ErrInvalidDropPoint, o.Opens)
+	}
+	closes, err := time.Parse(openingTimeLayout, o.Closes)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: closing time %q should be in HH:MM format", ErrInvalidDropPoint, o.Closes)
+	}
+
+	return opens.Hour()*60 + opens.Minute(), closes.Hour()*60 + closes.Minute(), nil
+}
+
+// OpeningHours are the weekly opening periods of a point, a point without them is open by arrangement.
+type OpeningHours []OpeningPeriod
+
+func (h OpeningHours) Validate() error {
+	for _, period := range h {
+		if period.Weekday < time.Sunday || period.Weekday > time.Saturday {
+			return fmt.Errorf("%w: weekday should be between 0 (Sunday) and 6", ErrInvalidDropPoint)
+		}
+		opens, closes, err := period.minutes()
+		if err != nil {
+			return err
+		}
+		if opens >= closes {
+			return fmt.Errorf("%w: the point should open before it closes", ErrInvalidDropPoint)
+		}
+	}
+
+	return nil
+}
+
+// Contains checks that the point is open during the whole window, the window should be within one day.
+func (h OpeningHours) Contains(window TimeWindow) bool {
+	if len(h) == 0 {
+		return true
+	}
+	start := window.Start.Hour()*60 + window.Start.Minute()
+	end := start + int(window.End.Sub(window.Start).Minutes())
+	for _, period := range h {
+		opens, closes, err := period.minutes()
+		if err != nil || period.Weekday != window.Start.Weekday() {
+			continue
+		}
+		if opens <= start && end <= closes {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (h OpeningHours) Value() (driver.Value, error) {
+	if len(h) == 0 {
+		return nil, nil
+	}
+	bytes, err := json.Marshal(h)
+	return string(bytes), err
+}
+
+func (h *OpeningHours) Scan(value any) error {
+	return scanJSON(value, h)
+}
+
+// TimeWindow is when the responder arrives at the point.
+type TimeWindow struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+func (t TimeWindow) Validate() error {
+	if !t.Start.Before(t.End) {
+		return fmt.Errorf("%w: the arrival window should start before it ends", ErrInvalidDropPoint)
+	}
+	startYear, startMonth, startDay := t.Start.Date()
+	endYear, endMonth, endDay := t.End.Add(-time.Nanosecond).Date()
+	if startYear != endYear || startMonth != endMonth || startDay != endDay {
+		return fmt.Errorf("%w: the arrival window should be within one day", ErrInvalidDropPoint)
+	}
+
+	return nil
+}
+
+type DropPointRequest struct {
+	Kind         DropPointKind `json:"kind" validate:"required"`
+	Title        string        `json:"title" validate:"max=255"`
+	Region       string        `json:"region"`
+	City         string        `json:"city" validate:"required"`
+	District     string        `json:"district"`
+	Street       string        `json:"street" validate:"required"`
+	HomeLocation string        `json:"homeLocation"`
+	Coordinates  *GeoPoint     `json:"coordinates"`
+	OpeningHours OpeningHours  `json:"openingHours"`
+	Contact      string        `json:"contact" validate:"max=255"`
+}
+
+func (d DropPointRequest) ToInternal() DropPoint {
+	point := DropPoint{
+		Kind:         d.Kind,
+		Title:        d.Title,
+		Region:       d.Region,
+		City:         d.City,
+		District:     d.District,
+		Street:       d.Street,
+		HomeLocation: d.HomeLocation,
+		OpeningHours: d.OpeningHours,
+		Contact:      d.Contact,
+	}
+	if d.Coordinates != nil {
+		point.Latitude = &d.Coordinates.Latitude
+		point.Longitude = &d.Coordinates.Longitude
+	}
+
+	return point
+}
+
+func UnmarshalDropPointRequest(r *io.ReadCloser) (DropPointRequest, error) {
+	point := DropPointRequest{}
+	err := json.NewDecoder(*r).Decode(&point)
+	if err != nil {
+		return DropPointRequest{}, err
+	}
+
+	return point, validator.New().Struct(point)
+}
+
+type DropPointResponse struct {
+	ID           uint          `json:"id"`
+	Kind         DropPointKind `json:"kind"`
+	Title        string        `json:"title"`
+	Region       string        `json:"region"`
+	City         string        `json:"city"`
+	District     string        `json:"district"`
+	Street       string        `json:"street"`
+	HomeLocation string        `json:"homeLocation"`
+	Coordinates  *GeoPoint     `json:"coordinates,omitempty"`
+	OpeningHours OpeningHours  `json:"openingHours"`
+	Contact      string        `json:"contact"`
+}
+
+func (d DropPoint) Response() DropPointResponse {
+	response := DropPointResponse{
+		ID:           d.ID,
+		Kind:         d.Kind,
+		Title:        d.Title,
+		Region:       d.Region,
+		City:         d.City,
+		District:     d.District,
+		Street:       d.Street,
+		HomeLocation: d.HomeLocation,
+		OpeningHours: d.OpeningHours,
+		Contact:      d.Contact,
+	}
+	if response.OpeningHours == nil {
+		response.OpeningHours = OpeningHours{}
+	}
+	if d.Latitude != nil && d.Longitude != nil {
+		response.Coordinates = &GeoPoint{Latitude: *d.Latitude, Longitude: *d.Longitude}
+	}
+
+	return response
+}
+
+// DropPointArrival is a responder expected at the point.
+type DropPointArrival struct {
+	TransactionID     uint              `gorm:"column:transaction_id"`
+	ResponderID       uint              `gorm:"column:responder_id"`
+	ArrivalStart      time.Time         `gorm:"column:arrival_start"`
+	ArrivalEnd        time.Time         `gorm:"column:arrival_end"`
+	TransactionStatus TransactionStatus `gorm:"column:transaction_status"`
+	Responder         User              `gorm:"-"`
+	Needs             []Need            `gorm:"-"`
+}
+
+type DropPointArrivalResponse struct {
+	TransactionID     uint              `json:"transactionID"`
+	Responder         UserShortInfo     `json:"responder"`
+	ArrivalStart      time.Time         `json:"arrivalStart"`
+	ArrivalEnd        time.Time         `json:"arrivalEnd"`
+	TransactionStatus TransactionStatus `json:"transactionStatus"`
+	Needs             []NeedResponse    `json:"needs"`
+}
+
+type DropPointArrivalsResponse struct {
+	Date     string                     `json:"date"`
+	Arrivals []DropPointArrivalResponse `json:"arrivals"`
+}
+
+func (d DropPointArrivalsResponse) Bytes() []byte {
+	bytes, _ := json.Marshal(d)
+	return bytes
+}
+
+func CreateDropPointArrivalsResponse(day time.Time, arrivals []DropPointArrival) DropPointArrivalsResponse {
+	response := DropPointArrivalsResponse{
+		Date:     day.Format(ArrivalDateLayout),
+		Arrivals: make([]DropPointArrivalResponse, len(arrivals)),
+	}
+	for i, arrival := range arrivals {
+		needs := make([]NeedResponse, len(arrival.Needs))
+		for j, need := range arrival.Needs {
+			needs[j] = need.Response()
+		}
+		response.Arrivals[i] = DropPointArrivalResponse{
+			TransactionID:     arrival.TransactionID,
+			Responder:         arrival.Responder.ToShortInfo(),
+			ArrivalStart:      arrival.ArrivalStart,
+			ArrivalEnd:        arrival.ArrivalEnd,
+			TransactionStatus: arrival.TransactionStatus,
+			Needs:             needs,
+		}
+	}
+
+	return response
+}
@@ -0,0 +1,51 @@
+package models_test
+
+import (
+	"Kurajj/internal/models"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestOpeningHoursContains(t *testing.T) {
+	hours := models.OpeningHours{
+		{Weekday: time.Monday, Opens: "09:00", Closes: "13:00"},
+		{Weekday: time.Monday, Opens: "14:00", Closes: "18:30"},
+	}
+	assert.NoError(t, hours.Validate())
+
+	monday := time.Date(2024, time.May, 6, 0, 0, 0, 0, time.UTC)
+	window := func(start, end time.Duration) models.TimeWindow {
+		return models.TimeWindow{Start: monday.Add(start), End: monday.Add(end)}
+	}
+	assert.True(t, hours.Contains(window(9*time.Hour, 10*time.Hour)))
+	assert.True(t, hours.Contains(window(17*time.Hour+30*time.Minute, 18*time.Hour+30*time.Minute)))
+	assert.False(t, hours.Contains(window(12*time.Hour, 14*time.Hour+30*time.Minute)))
+	assert.False(t, hours.Contains(window(8*time.Hour, 9*time.Hour+30*time.Minute)))
+	assert.False(t, hours.Contains(models.TimeWindow{
+		Start: monday.AddDate(0, 0, 1).Add(10 * time.Hour),
+		End:   monday.AddDate(0, 0, 1).Add(11 * time.Hour),
+	}))
+	assert.True(t, models.OpeningHours{}.Contains(window(22*time.Hour, 23*time.Hour)))
+}
+
+func TestValidateDropPoint(t *testing.T) {
+	point := models.DropPoint{Kind: models.DropOffPoint, City: "Львів", Street: "Городоцька"}
+	assert.NoError(t, point.Validate())
+
+	point.Kind = "warehouse"
+	assert.ErrorIs(t, point.Validate(), models.ErrInvalidDropPoint)
+
+	point.Kind = models.PickupPoint
+	point.OpeningHours = models.OpeningHours{{Weekday: time.Friday, Opens: "18:00", Closes: "09:00"}}
+	assert.ErrorIs(t, point.Validate(), models.ErrInvalidDropPoint)
+
+	point.OpeningHours = models.OpeningHours{{Weekday: time.Friday, Opens: "9am", Closes: "18:00"}}
+	assert.ErrorIs(t, point.Validate(), models.ErrInvalidDropPoint)
+
+	monday := time.Date(2024, time.May, 6, 23, 0, 0, 0, time.UTC)
+	assert.ErrorIs(t, models.TimeWindow{Start: monday, End: monday.Add(2 * time.Hour)}.Validate(),
+		models.ErrInvalidDropPoint)
+	assert.ErrorIs(t, models.TimeWindow{Start: monday, End: monday}.Validate(), models.ErrInvalidDropPoint)
+	assert.NoError(t, models.TimeWindow{Start: monday, End: monday.Add(time.Hour)}.Validate())
+}
@@ -6,6 +6,10 @@ import (
 
 var ErrNotFound = errors.New("no such entity")
 
+var ErrContentRejected = errors.New("content was rejected by moderation")
+
+var ErrInvalidPublishDate = errors.New("invalid publish date")
+
 type ErrResponse struct {
 	Error string `json:"error"`
 }
@@ -0,0 +1,136 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+var ErrInvalidTemplate = errors.New("invalid event template")
+
+var ErrInvalidClone = errors.New("invalid event clone")
+
+// EventTemplate is a named, personal copy of an event create request.
+// Payload holds the JSON of HelpEventCreateRequest or ProposalEventRequestCreate, depending on EventType.
+type EventTemplate struct {
+	ID           uint      `gorm:"column:id"`
+	MemberID     uint      `gorm:"column:member_id"`
+	Name         string    `gorm:"column:name"`
+	EventType    EventType `gorm:"column:event_type"`
+	Payload      string    `gorm:"column:payload"`
+	CreationDate time.Time `gorm:"column:creation_date"`
+}
+
+func (EventTemplate) TableName() string {
+	return "event_template"
+}
+
+type EventTemplateCreateRequest struct {
+	Name      string          `json:"name"`
+	EventType EventType       `json:"eventType"`
+	Event     json.RawMessage `json:"event"`
+}
+
+func UnmarshalEventTemplateCreateRequest(r *io.ReadCloser) (EventTemplateCreateRequest, error) {
+	t := EventTemplateCreateRequest{}
+	err := json.NewDecoder(*r).Decode(&t)
+	return t, err
+}
+
+// Internal checks that the event payload is a create request of the template event type.
+func (t EventTemplateCreateRequest) Internal(memberID uint) (EventTemplate, error) {
+	if t.Name == "" {
+		return EventTemplate{}, fmt.Errorf("%w: name is required", ErrInvalidTemplate)
+	}
+
+	var event any
+	switch t.EventType {
+	case ProposalEventType:
+		event = &ProposalEventRequestCreate{}
+	case HelpEventType:
+		event = &HelpEventCreateRequest{}
+	default:
+		return EventTemplate{}, fmt.Errorf("%w: event type should be one of %s, %s",
+			ErrInvalidTemplate, ProposalEventType, HelpEventType)
+	}
+	if len(t.Event) == 0 {
+		return EventTemplate{}, fmt.Errorf("%w: event is required", ErrInvalidTemplate)
+	}
+	if err := json.Unmarshal(t.Event, event); err != nil {
+		return EventTemplate{}, fmt.Errorf("%w: %s", ErrInvalidTemplate, err)
+	}
+
+	return EventTemplate{
+		MemberID:     memberID,
+		Name:         t.Name,
+		EventType:    t.EventType,
+		Payload:      string(t.Event),
+		CreationDate: time.Now(),
+	}, nil
+}
+
+// CloneEventRequest holds the dates of the copy, the dates of the source event are never copied.
+// Time slots are required when the source proposal event has them.
+type CloneEventRequest struct {
+	EndDate   time.Time         `json:"endDate"`
+	TimeSlots []TimeSlotRequest `json:"timeSlots"`
+}
+
+func UnmarshalCloneEventRequest(r *io.ReadCloser) (CloneEventRequest, error) {
+	c := CloneEventRequest{}
+	err := json.NewDecoder(*r).Decode(&c)
+	return c, err
+}
+
+// Validate checks that the copy ends in the future.
+func (c CloneEventRequest) Validate(now time.Time) error {
+	if !c.EndDate.After(now) {
+		return fmt.Errorf("%w: end date should be in the future", ErrInvalidClone)
+	}
+	return nil
+}
+
+func (c CloneEventRequest) InternalTimeSlots() []TimeSlot {
+	timeSlots := make([]TimeSlot, len(c.TimeSlots))
+	for i, timeSlot := range c.TimeSlots {
+		timeSlots[i] = timeSlot.Internal()
+	}
+	return timeSlots
+}
+
+type EventTemplateResponse struct {
+	ID           uint            `json:"id"`
+	Name         string          `json:"name"`
+	EventType    EventType       `json:"eventType"`
+	Event        json.RawMessage `json:"event"`
+	CreationDate time.Time       `json:"creationDate"`
+}
+
+type EventTemplatesResponse struct {
+	Templates []EventTemplateResponse `json:"templates"`
+}
+
+func (e EventTemplatesResponse) Bytes() []byte {
+	bytes, _ := json.Marshal(e)
+	return bytes
+}
+
+func CreateEventTemplatesResponse(templates []EventTemplate) EventTemplatesResponse {
+	response := EventTemplatesResponse{
+		Templates: make([]EventTemplateResponse, len(templates)),
+	}
+
+	for i, template := range templates {
+		response.Templates[i] = EventTemplateResponse{
+			ID:           template.ID,
+			Name:         template.Name,
+			EventType:    template.EventType,
+			Event:        json.RawMessage(template.Payload),
+			CreationDate: template.CreationDate,
+		}
+	}
+
+	return response
+}
@@ -0,0 +1,130 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+var ErrInvalidEventUpdate = errors.New("invalid event update")
+
+const (
+	maxEventUpdateTextLength = 2000
+	maxEventUpdateImages     = 10
+)
+
+// EventUpdate is a post of the event author about its progress, e.g. "first truck delivered" with photos.
+type EventUpdate struct {
+	ID           uint       `gorm:"column:id"`
+	EventID      uint       `gorm:"column:event_id"`
+	EventType    EventType  `gorm:"column:event_type"`
+	AuthorID     uint       `gorm:"column:author_id"`
+	Text         string     `gorm:"column:text"`
+	ImagePaths   ImagePaths `gorm:"column:image_paths"`
+	CreationDate time.Time  `gorm:"column:creation_date"`
+}
+
+func (EventUpdate) TableName() string {
+	return "event_update"
+}
+
+// ImagePaths are stored as a json array of the uploaded files.
+type ImagePaths []string
+
+func (p ImagePaths) Value() (driver.Value, error) {
+	if len(p) == 0 {
+		return nil, nil
+	}
+	bytes, err := json.Marshal(p)
+	return string(bytes), err
+}
+
+func (p *ImagePaths) Scan(value any) error {
+	return scanJSON(value, p)
+}
+
+// EventFollower is a member who gets notified about the updates of the event.
+type EventFollower struct {
+	EventID      uint      `gorm:"column:event_id;primaryKey"`
+	EventType    EventType `gorm:"column:event_type;primaryKey"`
+	MemberID     uint      `gorm:"column:member_id;primaryKey"`
+	CreationDate time.Time `gorm:"column:creation_date"`
+}
+
+func (EventFollower) TableName() string {
+	return "event_follower"
+}
+
+// EventUpdateImage is either the content of a new image or the path of an already uploaded one.
+type EventUpdateImage struct {
+	FileBytes []byte `json:"fileBytes"`
+	FileType  string `json:"fileType"`
+	FilePath  string `json:"filePath"`
+}
+
+type EventUpdateCreateRequest struct {
+	Text   string             `json:"text"`
+	Images []EventUpdateImage `json:"images"`
+}
+
+func (e *EventUpdateCreateRequest) Validate() error {
+	e.Text = strings.TrimSpace(e.Text)
+	if e.Text == "" && len(e.Images) == 0 {
+		return fmt.Errorf("%w: text or images should be set", ErrInvalidEventUpdate)
+	}
+	if utf8.RuneCountInString(e.Text) > maxEventUpdateTextLength {
+		return fmt.Errorf("%w: text is longer than %d characters", ErrInvalidEventUpdate, maxEventUpdateTextLength)
+	}
+	if len(e.Images) > maxEventUpdateImages {
+		return fmt.Errorf("%w: at most %d images can be attached", ErrInvalidEventUpdate, maxEventUpdateImages)
+	}
+	for i, image := range e.Images {
+		if (len(image.FileBytes) == 0 || image.FileType == "") && image.FilePath == "" {
+			return fmt.Errorf("%w: image %d should have fileBytes and fileType or filePath", ErrInvalidEventUpdate, i)
+		}
+	}
+
+	return nil
+}
+
+func UnmarshalEventUpdateCreateRequest(r *io.ReadCloser) (EventUpdateCreateRequest, error) {
+	update := EventUpdateCreateRequest{}
+	err := json.NewDecoder(*r).Decode(&update)
+	if err != nil {
+		return EventUpdateCreateRequest{}, err
+	}
+
+	return update, update.Validate()
+}
+
+type EventUpdateResponse struct {
+	ID           uint      `json:"id"`
+	AuthorID     uint      `json:"authorID"`
+	Text         string    `json:"text"`
+	ImageURLs    []string  `json:"imageURLs"`
+	CreationDate time.Time `json:"creationDate"`
+}
+
+func GenerateEventUpdateResponses(updates []EventUpdate) []EventUpdateResponse {
+	responses := make([]EventUpdateResponse, len(updates))
+	for i, update := range updates {
+		imageURLs := []string(update.ImagePaths)
+		if imageURLs == nil {
+			imageURLs = []string{}
+		}
+		responses[i] = EventUpdateResponse{
+			ID:           update.ID,
+			AuthorID:     update.AuthorID,
+			Text:         update.Text,
+			ImageURLs:    imageURLs,
+			CreationDate: update.CreationDate,
+		}
+	}
+
+	return responses
+}
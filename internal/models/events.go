@@ -32,6 +32,9 @@ const (
 	Aborted           TransactionStatus = "aborted"
 )
 
+// FinishedTransactionStatuses are the terminal states of a transaction, it is never changed after reaching one.
+var FinishedTransactionStatuses = []TransactionStatus{Completed, Interrupted, Canceled, Aborted}
+
 type File struct {
 	Path string `json:"path"`
 }
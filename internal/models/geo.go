@@ -0,0 +1,82 @@
+package models
+
+import (
+	"Kurajj/pkg/gazetteer"
+	"errors"
+	"fmt"
+)
+
+var ErrInvalidCoordinates = errors.New("invalid coordinates")
+
+// DistanceSortField sorts search results by the distance from the search center, nearest first.
+const DistanceSortField = "distance"
+
+// maxSearchRadiusKm keeps radius searches within the country's size.
+const maxSearchRadiusKm = 1500
+
+type GeoPoint struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+func (g GeoPoint) Validate() error {
+	if g.Latitude < -90 || g.Latitude > 90 || g.Longitude < -180 || g.Longitude > 180 {
+		return fmt.Errorf("%w: coordinates are out of range", ErrInvalidCoordinates)
+	}
+	return nil
+}
+
+// ValidateGeoSearch checks the center and radius of a search. Both the radius filter
+// and the distance sort need the center.
+func ValidateGeoSearch(near *GeoPoint, radiusKm float64, sortField string) error {
+	if near == nil {
+		if radiusKm != 0 || sortField == DistanceSortField {
+			return fmt.Errorf("%w: the search center is required", ErrInvalidCoordinates)
+		}
+		return nil
+	}
+	if radiusKm < 0 || radiusKm > maxSearchRadiusKm {
+		return fmt.Errorf("%w: radius should be between 0 and %d km", ErrInvalidCoordinates, maxSearchRadiusKm)
+	}
+
+	return near.Validate()
+}
+
+func (a Address) ValidateCoordinates() error {
+	coordinates := a.Coordinates()
+	if coordinates == nil {
+		return nil
+	}
+	return coordinates.Validate()
+}
+
+func (a Address) HasCoordinates() bool {
+	return a.Latitude != nil && a.Longitude != nil
+}
+
+// Coordinates returns nil when the address has no coordinates.
+func (a Address) Coordinates() *GeoPoint {
+	if !a.HasCoordinates() {
+		return nil
+	}
+	return &GeoPoint{Latitude: *a.Latitude, Longitude: *a.Longitude}
+}
+
+func (a *Address) SetCoordinates(point GeoPoint) {
+	a.Latitude = &point.Latitude
+	a.Longitude = &point.Longitude
+}
+
+// Locate fills in the coordinates of the address's settlement when the author did not set them.
+// An unknown settlement leaves the address without coordinates.
+func (a *Address) Locate() error {
+	if a.HasCoordinates() || a.City == "" {
+		return nil
+	}
+	settlement, ok, err := gazetteer.Lookup(a.Region, a.City)
+	if err != nil || !ok {
+		return err
+	}
+	a.SetCoordinates(GeoPoint{Latitude: settlement.Latitude, Longitude: settlement.Longitude})
+	return nil
+}
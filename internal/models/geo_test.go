@@ -0,0 +1,35 @@
+package models_test
+
+import (
+	"Kurajj/internal/models"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestValidateGeoSearch(t *testing.T) {
+	kyiv := &models.GeoPoint{Latitude: 50.45, Longitude: 30.52}
+	assert.NoError(t, models.ValidateGeoSearch(nil, 0, ""))
+	assert.NoError(t, models.ValidateGeoSearch(kyiv, 10, models.DistanceSortField))
+
+	assert.ErrorIs(t, models.ValidateGeoSearch(nil, 10, ""), models.ErrInvalidCoordinates)
+	assert.ErrorIs(t, models.ValidateGeoSearch(nil, 0, models.DistanceSortField), models.ErrInvalidCoordinates)
+	assert.ErrorIs(t, models.ValidateGeoSearch(kyiv, -1, ""), models.ErrInvalidCoordinates)
+	assert.ErrorIs(t, models.ValidateGeoSearch(&models.GeoPoint{Latitude: 91}, 10, ""), models.ErrInvalidCoordinates)
+}
+
+func TestAddressLocate(t *testing.T) {
+	address := models.Address{Region: "Львівська", City: "Львів"}
+	assert.NoError(t, address.Locate())
+	assert.NotNil(t, address.Coordinates())
+	assert.InDelta(t, 49.84, *address.Latitude, 0.01)
+
+	address = models.Address{City: "Львів"}
+	address.SetCoordinates(models.GeoPoint{Latitude: 49.8, Longitude: 24})
+	assert.NoError(t, address.Locate())
+	assert.Equal(t, &models.GeoPoint{Latitude: 49.8, Longitude: 24}, address.Coordinates())
+
+	address = models.Address{City: "Atlantis"}
+	assert.NoError(t, address.Locate())
+	assert.Nil(t, address.Coordinates())
+	assert.False(t, models.Address{}.HasCoordinates())
+}
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"database/sql"
 	"github.com/samber/lo"
 	"io"
 	"time"
@@ -9,30 +10,39 @@ import (
 type ID uint
 
 type HelpEvent struct {
-	ID                    uint          `gorm:"column:id"`
-	Title                 string        `gorm:"column:title"`
-	Description           string        `gorm:"column:description"`
-	Needs                 []Need        `gorm:"gorm:foreignkey:HelpEventID"`
-	Tags                  []Tag         `gorm:"-"`
-	EndDate               time.Time     `gorm:"column:end_date"`
-	Status                EventStatus   `gorm:"column:status"`
-	CreatedBy             uint          `gorm:"column:created_by"`
-	CreatedAt             time.Time     `gorm:"column:creation_date"`
-	CompletionTime        time.Time     `gorm:"column:completion_time"`
-	Banned                bool          `gorm:"column:is_banned"`
-	Comments              []Comment     `gorm:"-"`
-	Transactions          []Transaction `gorm:"-"`
-	Location              Address       `gorm:"-"`
-	TransactionNeeds      map[ID][]Need `gorm:"-"`
-	User                  User          `gorm:"-"`
-	ImagePath             string        `gorm:"column:image_path"`
-	FileType              string        `gorm:"-"`
-	File                  io.Reader     `gorm:"-"`
-	CompletionPercentages float64       `gorm:"-"`
+	ID                    uint               `gorm:"column:id"`
+	Title                 string             `gorm:"column:title"`
+	Description           string             `gorm:"column:description"`
+	Needs                 []Need             `gorm:"gorm:foreignkey:HelpEventID"`
+	Tags                  []Tag              `gorm:"-"`
+	EndDate               time.Time          `gorm:"column:end_date"`
+	Status                EventStatus        `gorm:"column:status"`
+	Urgency               Urgency            `gorm:"column:urgency;default:normal"`
+	CreatedBy             uint               `gorm:"column:created_by"`
+	CreatedAt             time.Time          `gorm:"column:creation_date"`
+	CompletionTime        time.Time          `gorm:"column:completion_time"`
+	Banned                bool               `gorm:"column:is_banned"`
+	IsDeleted             bool               `gorm:"column:is_deleted"`
+	PublishAt             sql.NullTime       `gorm:"column:publish_at"`
+	Questionnaire         Questionnaire      `gorm:"column:questionnaire"`
+	Comments              []Comment          `gorm:"-"`
+	Updates               []EventUpdate      `gorm:"-"`
+	CoOrganizers          []EventCoOrganizer `gorm:"-"`
+	Transactions          []Transaction      `gorm:"-"`
+	Location              Address            `gorm:"-"`
+	DropPoints            []DropPoint        `gorm:"-"`
+	TransactionNeeds      map[ID][]Need      `gorm:"-"`
+	User                  User               `gorm:"-"`
+	ImagePath             string             `gorm:"column:image_path"`
+	FileType              string             `gorm:"-"`
+	File                  io.Reader          `gorm:"-"`
+	CompletionPercentages float64            `gorm:"-"`
+	MoneyProgress         []MoneyProgress    `gorm:"-"`
 }
 
 func (h *HelpEvent) CalculateCompletionPercentages() {
 	h.CalculateTransactionsCompletionPercentages()
+	h.MoneyProgress = moneyProgress(h.Needs)
 	if len(h.Needs) == 0 {
 		return
 	}
@@ -45,10 +55,12 @@ func (h *HelpEvent) CalculateTransactionsCompletionPercentages() {
 	needsCompetitionPercentages := 0
 	for i, transaction := range h.Transactions {
 		for _, need := range transaction.Needs {
-			if need.Amount == 0 {
-				continue
+			switch {
+			case need.IsMoney() && need.AmountMinor != 0:
+				needsCompetitionPercentages += int(need.ReceivedMinor/need.AmountMinor) * 100
+			case !need.IsMoney() && need.Amount != 0:
+				needsCompetitionPercentages += int(need.Received/need.Amount) * 100
 			}
-			needsCompetitionPercentages += int(need.Received/need.Amount) * 100
 		}
 
 		h.Transactions[i].CompletionPercentages = needsCompetitionPercentages
@@ -58,10 +70,7 @@ func (h *HelpEvent) CalculateTransactionsCompletionPercentages() {
 func (h *HelpEvent) calculateCompletionPercentagesForNeeds(needs []Need) float64 {
 	needsCompetitionPercentages := float64(0)
 	for _, need := range needs {
-		if need.Amount == 0 {
-			continue
-		}
-		needsCompetitionPercentages += float64(need.ReceivedTotal/need.Amount) * 100
+		needsCompetitionPercentages += need.completionPercentages()
 	}
 	return needsCompetitionPercentages
 }
@@ -75,9 +84,16 @@ func (h *HelpEvent) Response() HelpEventResponse {
 		CreationDate:          h.CreatedAt,
 		CompetitionDate:       h.CompletionTime,
 		Status:                h.Status,
+		Urgency:               h.Urgency,
 		ImageURL:              h.ImagePath,
 		AuthorInfo:            h.User.ToShortInfo(),
 		CompletionPercentages: h.CompletionPercentages,
+		MoneyProgress:         h.MoneyProgress,
+		Questionnaire:         h.Questionnaire,
+		Coordinates:           h.Location.Coordinates(),
+	}
+	if h.PublishAt.Valid {
+		helpEventResponse.PublishAt = &h.PublishAt.Time
 	}
 	comments := make([]CommentResponse, len(h.Comments))
 	for i, comment := range h.Comments {
@@ -95,6 +111,8 @@ func (h *HelpEvent) Response() HelpEventResponse {
 		}
 	}
 	helpEventResponse.Comments = comments
+	helpEventResponse.Updates = GenerateEventUpdateResponses(h.Updates)
+	helpEventResponse.CoOrganizers = GenerateCoOrganizerResponses(h.CoOrganizers)
 	tags := make([]TagResponse, len(h.Tags))
 	homeLocation := ""
 	if h.Location.Street != "" {
@@ -128,14 +146,7 @@ func (h *HelpEvent) Response() HelpEventResponse {
 		transactionNeeds := h.TransactionNeeds[ID(h.Transactions[i].ID)]
 		needs := make([]NeedResponse, len(transactionNeeds))
 		for j := range transactionNeeds {
-			needs[j] = NeedResponse{
-				ID:            transactionNeeds[j].ID,
-				Title:         transactionNeeds[j].Title,
-				Amount:        transactionNeeds[j].Amount,
-				ReceivedTotal: transactionNeeds[j].ReceivedTotal,
-				Received:      transactionNeeds[j].Received,
-				Unit:          transactionNeeds[j].Unit,
-			}
+			needs[j] = transactionNeeds[j].Response()
 		}
 
 		isApproved := h.Transactions[i].TransactionStatus == Completed
@@ -144,7 +155,7 @@ func (h *HelpEvent) Response() HelpEventResponse {
 		var completionPercentages float64
 		if allTransactions != 0 {
 			finishedTransactions := float64(len(lo.Filter(h.TransactionNeeds[ID(h.Transactions[i].ID)], func(need Need, index int) bool {
-				return need.Received >= need.Amount
+				return need.isFulfilled()
 			})))
 			completionPercentages = float64(finishedTransactions/allTransactions) * 100
 		}
@@ -165,21 +176,26 @@ func (h *HelpEvent) Response() HelpEventResponse {
 			CompetitionDate:       h.Transactions[i].CompetitionDate.Time.Format(time.RFC3339),
 			IsApproved:            isApproved,
 			CompletionPercentages: completionPercentages,
+			Answers:               h.Transactions[i].QuestionnaireAnswers,
+		}
+		if h.Transactions[i].DropPointID.Valid {
+			transactions[i].DropPointID = uint(h.Transactions[i].DropPointID.Int64)
+			transactions[i].ArrivalWindow = &TimeWindow{
+				Start: h.Transactions[i].ArrivalStart.Time,
+				End:   h.Transactions[i].ArrivalEnd.Time,
+			}
 		}
 	}
 	helpEventResponse.Transactions = transactions
 	needs := make([]NeedResponse, len(h.Needs))
 	for i := range h.Needs {
-		needs[i] = NeedResponse{
-			ID:            h.Needs[i].ID,
-			Title:         h.Needs[i].Title,
-			Amount:        h.Needs[i].Amount,
-			ReceivedTotal: h.Needs[i].ReceivedTotal,
-			Received:      h.Needs[i].Received,
-			Unit:          h.Needs[i].Unit,
-		}
+		needs[i] = h.Needs[i].Response()
 	}
 	helpEventResponse.Needs = needs
+	helpEventResponse.DropPoints = make([]DropPointResponse, len(h.DropPoints))
+	for i, point := range h.DropPoints {
+		helpEventResponse.DropPoints[i] = point.Response()
+	}
 	return helpEventResponse
 }
 
@@ -3,21 +3,24 @@ package models
 import "time"
 
 type HelpEventTransactionResponse struct {
-	TransactionID         uint              `json:"id"`
-	Needs                 []NeedResponse    `json:"needs"`
-	CompetitionDate       string            `json:"competitionDate"`
-	IsApproved            bool              `json:"isApproved"`
-	CompletionPercentages float64           `json:"completionPercentages"`
-	CreatorID             uint              `json:"receiverID"`
-	Receiver              UserShortInfo     `json:"receiver"`
-	CreationDate          time.Time         `json:"creationDate"`
-	EventID               uint              `json:"eventID"`
-	EventType             EventType         `json:"eventType"`
-	Responder             UserShortInfo     `json:"responder"`
-	Comment               string            `json:"comment"`
-	TransactionStatus     TransactionStatus `json:"transactionStatus"`
-	ResponderStatus       TransactionStatus `json:"responderStatus"`
-	ReportURL             string            `json:"reportURL"`
+	TransactionID         uint                 `json:"id"`
+	Needs                 []NeedResponse       `json:"needs"`
+	CompetitionDate       string               `json:"competitionDate"`
+	IsApproved            bool                 `json:"isApproved"`
+	CompletionPercentages float64              `json:"completionPercentages"`
+	CreatorID             uint                 `json:"receiverID"`
+	Receiver              UserShortInfo        `json:"receiver"`
+	CreationDate          time.Time            `json:"creationDate"`
+	EventID               uint                 `json:"eventID"`
+	EventType             EventType            `json:"eventType"`
+	Responder             UserShortInfo        `json:"responder"`
+	Comment               string               `json:"comment"`
+	TransactionStatus     TransactionStatus    `json:"transactionStatus"`
+	ResponderStatus       TransactionStatus    `json:"responderStatus"`
+	ReportURL             string               `json:"reportURL"`
+	Answers               QuestionnaireAnswers `json:"answers,omitempty"`
+	DropPointID           uint                 `json:"dropPointID,omitempty"`
+	ArrivalWindow         *TimeWindow          `json:"arrivalWindow,omitempty"`
 }
 
 type HelpEventTransaction struct {
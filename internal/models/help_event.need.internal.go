@@ -1,16 +1,173 @@
 package models
 
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+var (
+	ErrInvalidPledge     = errors.New("invalid pledge")
+	ErrPledgeExceedsNeed = errors.New("pledge exceeds the remaining need")
+)
+
+// Need is either a need of the help event or its copy in a transaction. A transaction need
+// with EventNeedID is a pledge, its amount is reserved in the event need until the transaction is finished.
+// Amounts of goods are in the unit of the need, amounts of money needs are whole minor units of the currency
+// kept in the Minor fields.
 type Need struct {
-	ID            uint    `gorm:"column:id"`
-	Title         string  `gorm:"column:title"`
-	Amount        float64 `gorm:"column:amount"`
-	Received      float64 `gorm:"column:received"`
-	ReceivedTotal float64 `gorm:"column:received_total"`
-	HelpEventID   uint    `gorm:"column:help_event_id"`
-	TransactionID *uint   `gorm:"column:transaction_id"`
-	Unit          Unit    `gorm:"column:unit"`
+	ID                 uint     `gorm:"column:id"`
+	Title              string   `gorm:"column:title"`
+	Type               NeedType `gorm:"column:type"`
+	Urgency            Urgency  `gorm:"column:urgency;default:normal"`
+	Amount             float64  `gorm:"column:amount"`
+	Received           float64  `gorm:"column:received"`
+	ReceivedTotal      float64  `gorm:"column:received_total"`
+	Reserved           float64  `gorm:"column:reserved"`
+	AmountMinor        int64    `gorm:"column:amount_minor"`
+	ReceivedMinor      int64    `gorm:"column:received_minor"`
+	ReceivedTotalMinor int64    `gorm:"column:received_total_minor"`
+	ReservedMinor      int64    `gorm:"column:reserved_minor"`
+	HelpEventID        uint     `gorm:"column:help_event_id"`
+	TransactionID      *uint    `gorm:"column:transaction_id"`
+	EventNeedID        *uint    `gorm:"column:event_need_id"`
+	Unit               Unit     `gorm:"column:unit"`
+	Currency           string   `gorm:"column:currency"`
+	// TransferReference identifies the responder's transfer of money, for example a bank payment id.
+	TransferReference string `gorm:"column:transfer_reference"`
 }
 
 func (Need) TableName() string {
 	return "need"
 }
+
+// Remaining is the amount of the event need nobody has brought or pledged yet.
+func (n Need) Remaining() float64 {
+	return math.Max(n.Amount-n.ReceivedTotal-n.Reserved, 0)
+}
+
+// RemainingMinor is the money of the event need nobody has sent or pledged yet.
+func (n Need) RemainingMinor() int64 {
+	remaining := n.AmountMinor - n.ReceivedTotalMinor - n.ReservedMinor
+	if remaining < 0 {
+		return 0
+	}
+
+	return remaining
+}
+
+// IsReceived reports whether the event need received its whole amount.
+func (n Need) IsReceived() bool {
+	if n.IsMoney() {
+		return n.ReceivedTotalMinor >= n.AmountMinor
+	}
+
+	return n.ReceivedTotal >= n.Amount
+}
+
+// isFulfilled reports whether the transaction need received its whole amount.
+func (n Need) isFulfilled() bool {
+	if n.IsMoney() {
+		return n.ReceivedMinor >= n.AmountMinor
+	}
+
+	return n.Received >= n.Amount
+}
+
+// completionPercentages is how much of the event need was received, money in whole percents.
+func (n Need) completionPercentages() float64 {
+	if n.IsMoney() {
+		if n.AmountMinor == 0 {
+			return 0
+		}
+		return float64(percentage(n.ReceivedTotalMinor, n.AmountMinor))
+	}
+	if n.Amount == 0 {
+		return 0
+	}
+
+	return n.ReceivedTotal / n.Amount * 100
+}
+
+func (n Need) IsMoney() bool {
+	return n.Type == MoneyNeed
+}
+
+// Validate checks the type of the event need, money needs should have a supported currency.
+func (n Need) Validate() error {
+	switch n.Type {
+	case GoodsNeed:
+		if n.Currency != "" {
+			return fmt.Errorf("%w: only money needs have a currency", ErrInvalidMoney)
+		}
+		return nil
+	case MoneyNeed:
+		if n.Unit != MoneyUnit {
+			return fmt.Errorf("%w: money needs are counted in %s", ErrInvalidUnit, MoneyUnit)
+		}
+		if n.Amount != 0 || n.AmountMinor < 0 {
+			return fmt.Errorf("%w: money needs are counted in whole minor units", ErrInvalidMoney)
+		}
+		return ValidateCurrency(n.Currency)
+	default:
+		return fmt.Errorf("unknown need type %q", n.Type)
+	}
+}
+
+// Pledge returns the transaction need reserving the amount of goods of the event need.
+func (n Need) Pledge(amount float64) Need {
+	pledge := n.pledge()
+	pledge.Amount = amount
+	return pledge
+}
+
+// PledgeMinor returns the transaction need reserving the minor units of the money need.
+func (n Need) PledgeMinor(amount int64) Need {
+	pledge := n.pledge()
+	pledge.AmountMinor = amount
+	return pledge
+}
+
+func (n Need) pledge() Need {
+	return Need{
+		Title:       n.Title,
+		Type:        n.Type,
+		Urgency:     n.Urgency,
+		HelpEventID: n.HelpEventID,
+		EventNeedID: &n.ID,
+		Unit:        n.Unit,
+		Currency:    n.Currency,
+	}
+}
+
+// Response writes the amounts of the need in its unit, money in minor units. Only event needs have
+// the remaining amount.
+func (n Need) Response() NeedResponse {
+	response := NeedResponse{
+		ID:                n.ID,
+		Title:             n.Title,
+		Type:              n.Type,
+		Urgency:           n.Urgency,
+		Amount:            goodsQuantity(n.Amount),
+		ReceivedTotal:     goodsQuantity(n.ReceivedTotal),
+		Received:          goodsQuantity(n.Received),
+		Reserved:          goodsQuantity(n.Reserved),
+		Unit:              n.Unit,
+		Currency:          n.Currency,
+		TransferReference: n.TransferReference,
+	}
+	if n.IsMoney() {
+		response.Amount = moneyQuantity(n.AmountMinor)
+		response.ReceivedTotal = moneyQuantity(n.ReceivedTotalMinor)
+		response.Received = moneyQuantity(n.ReceivedMinor)
+		response.Reserved = moneyQuantity(n.ReservedMinor)
+	}
+	if n.TransactionID == nil {
+		response.Remaining = goodsQuantity(n.Remaining())
+		if n.IsMoney() {
+			response.Remaining = moneyQuantity(n.RemainingMinor())
+		}
+	}
+
+	return response
+}
@@ -2,36 +2,81 @@ package models
 
 import (
 	"encoding/json"
+	"fmt"
 	"github.com/go-playground/validator/v10"
 	"time"
 )
 
+// maxGoodsAmount limits the amount of a need of goods or work.
+const maxGoodsAmount = 50
+
+// NeedRequestCreate is a need of goods or work in the unit or a money need in minor units of the currency,
+// for example 2000000 UAH for 20 000 hryvnias.
 type NeedRequestCreate struct {
-	Title  string  `json:"title" validate:"required"`
-	Amount float64 `json:"amount" validate:"required,gte=0,lte=50"`
-	Unit   Unit    `json:"unit" validate:"oneof=kilogram liter item work"`
+	Title    string   `json:"title" validate:"required"`
+	Type     NeedType `json:"type" validate:"oneof=goods money"`
+	Urgency  Urgency  `json:"urgency"`
+	Amount   float64  `json:"amount" validate:"required,gte=0"`
+	Unit     Unit     `json:"unit" validate:"required"`
+	Currency string   `json:"currency"`
 }
 
 func (n *NeedRequestCreate) Validate() error {
+	if n.Type == "" {
+		n.Type = GoodsNeed
+	}
+	if n.Type == MoneyNeed {
+		n.Unit = MoneyUnit
+	}
 	if n.Unit == "" {
 		n.Unit = Item
 	}
 
 	validate := validator.New()
+	err := validate.Struct(n)
+	if err != nil {
+		return err
+	}
+
+	if n.Type == MoneyNeed {
+		err = ValidateCurrency(n.Currency)
+		if err != nil {
+			return err
+		}
+		_, err = MinorUnits(n.Amount)
+		return err
+	}
+	if n.Currency != "" {
+		return fmt.Errorf("%w: only money needs have a currency", ErrInvalidMoney)
+	}
+	if n.Amount > maxGoodsAmount {
+		return fmt.Errorf("amount of %s should be at most %d", n.Title, maxGoodsAmount)
+	}
 
-	return validate.Struct(n)
+	return nil
 }
 
+// ToInternal moves the amount of a money need to minor units, the need should be validated first.
 func (n *NeedRequestCreate) ToInternal() Need {
-	return Need{
-		Title:  n.Title,
-		Amount: n.Amount,
-		Unit:   n.Unit,
+	need := Need{
+		Title:    n.Title,
+		Type:     n.Type,
+		Urgency:  n.Urgency,
+		Amount:   n.Amount,
+		Unit:     n.Unit,
+		Currency: n.Currency,
+	}
+	if need.IsMoney() {
+		need.AmountMinor = int64(n.Amount)
+		need.Amount = 0
 	}
+
+	return need
 }
 
 type Unit string
 
+// Units the help events had before the unit registry, the registry in the unit table can have more.
 const (
 	Kilogram = "kilogram"
 	Liter    = "liter"
@@ -39,20 +84,38 @@ const (
 	Work     = "work"
 )
 
+// NeedResponse has the amounts of goods in the unit of the need and the amounts of money needs in minor units.
 type NeedResponse struct {
-	ID            uint    `json:"id"`
-	Title         string  `json:"title"`
-	Amount        float64 `json:"amount"`
-	ReceivedTotal float64 `json:"receivedTotal"`
-	Received      float64 `json:"received"`
-	Unit          Unit    `json:"unit"`
+	ID                uint        `json:"id"`
+	Title             string      `json:"title"`
+	Type              NeedType    `json:"type"`
+	Urgency           Urgency     `json:"urgency"`
+	Amount            json.Number `json:"amount"`
+	ReceivedTotal     json.Number `json:"receivedTotal"`
+	Received          json.Number `json:"received"`
+	Reserved          json.Number `json:"reserved"`
+	Remaining         json.Number `json:"remaining"`
+	Unit              Unit        `json:"unit"`
+	Currency          string      `json:"currency,omitempty"`
+	TransferReference string      `json:"transferReference,omitempty"`
+}
+
+// NeedPledge is the amount of the event need a responder promises to bring.
+// The amount is in the need's unit when the unit is empty.
+type NeedPledge struct {
+	NeedID uint    `json:"needID"`
+	Amount float64 `json:"amount"`
+	Unit   Unit    `json:"unit"`
 }
 
+// NeedTransactionUpdateRequest reports the received amount of the transaction need in any unit of the same dimension.
 type NeedTransactionUpdateRequest struct {
 	ID       uint    `json:"id"`
 	Title    string  `json:"title"`
 	Received float64 `json:"received"`
 	Unit     Unit    `json:"unit"`
+	// Reference of the transfer when the need is money.
+	Reference string `json:"reference"`
 }
 
 type HelpEventResponse struct {
@@ -62,14 +125,22 @@ type HelpEventResponse struct {
 	CreationDate          time.Time                      `json:"creationDate"`
 	CompetitionDate       time.Time                      `json:"competitionDate"`
 	Status                EventStatus                    `json:"status"`
+	Urgency               Urgency                        `json:"urgency"`
 	EndDate               time.Time                      `json:"endDate"`
 	ImageURL              string                         `json:"imageURL"`
 	AuthorInfo            UserShortInfo                  `json:"authorInfo"`
 	Comments              []CommentResponse              `json:"comments"`
+	Updates               []EventUpdateResponse          `json:"updates"`
+	CoOrganizers          []CoOrganizerResponse          `json:"coOrganizers"`
 	Transactions          []HelpEventTransactionResponse `json:"transactions"`
 	Tags                  []TagResponse                  `json:"tags"`
 	Needs                 []NeedResponse                 `json:"needs"`
+	DropPoints            []DropPointResponse            `json:"dropPoints"`
 	CompletionPercentages float64                        `json:"completionPercentages"`
+	MoneyProgress         []MoneyProgress                `json:"moneyProgress,omitempty"`
+	PublishAt             *time.Time                     `json:"publishAt,omitempty"`
+	Questionnaire         Questionnaire                  `json:"questionnaire,omitempty"`
+	Coordinates           *GeoPoint                      `json:"coordinates,omitempty"`
 }
 
 func (p HelpEventResponse) Bytes() []byte {
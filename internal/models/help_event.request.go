@@ -2,6 +2,7 @@ package models
 
 import (
 	"bytes"
+	"database/sql"
 	"encoding/json"
 	"github.com/go-playground/validator/v10"
 	"io"
@@ -12,14 +13,22 @@ import (
 const defaultImagePath = "https://charity-platform.s3.amazonaws.com/images/volunteer-care-old-people-nurse-isolated-young-human-helping-senior-volunteers-service-helpful-person-nursing-elderly-decent-vector-set_53562-17770.avif"
 
 type HelpEventCreateRequest struct {
-	Title       string              `json:"title" validate:"required"`
-	Description string              `json:"description" validate:"required"`
-	EndDate     time.Time           `json:"endDate" validate:"required"`
-	Needs       []NeedRequestCreate `json:"needs" validate:"required"`
-	FilePath    string              `json:"imagePath"`
-	FileBytes   []byte              `json:"fileBytes"`
-	FileType    string              `json:"fileType"`
-	Tags        []TagRequestCreate  `json:"tags"`
+	Title         string              `json:"title" validate:"required"`
+	Description   string              `json:"description" validate:"required"`
+	EndDate       time.Time           `json:"endDate" validate:"required"`
+	Needs         []NeedRequestCreate `json:"needs" validate:"required"`
+	FilePath      string              `json:"imagePath"`
+	FileBytes     []byte              `json:"fileBytes"`
+	FileType      string              `json:"fileType"`
+	Tags          []TagRequestCreate  `json:"tags"`
+	Draft         bool                `json:"draft"`
+	PublishAt     *time.Time          `json:"publishAt"`
+	Questionnaire Questionnaire       `json:"questionnaire"`
+	Urgency       Urgency             `json:"urgency"`
+	// Coordinates of the event's place, they are looked up by the location's city when they are not set.
+	Coordinates *GeoPoint `json:"coordinates"`
+	// DropPoints are where responders bring the help or where it is picked up.
+	DropPoints []DropPointRequest `json:"dropPoints" validate:"dive"`
 }
 
 func validateFile(fl validator.FieldLevel) bool {
@@ -32,11 +41,8 @@ func validateFile(fl validator.FieldLevel) bool {
 }
 
 func (h *HelpEventCreateRequest) Validate() error {
-	for i, n := range h.Needs {
-		if n.Unit == "" {
-			h.Needs[i].Unit = Item
-		}
-		if err := n.Validate(); err != nil {
+	for i := range h.Needs {
+		if err := h.Needs[i].Validate(); err != nil {
 			return err
 		}
 	}
@@ -56,13 +62,21 @@ func (h *HelpEventCreateRequest) ToInternal(authorID uint) *HelpEvent {
 		needs[i] = n.ToInternal()
 	}
 	event := &HelpEvent{
-		ImagePath:   h.FilePath,
-		Title:       h.Title,
-		Description: h.Description,
-		Needs:       needs,
-		EndDate:     h.EndDate,
-		Status:      Active,
-		CreatedBy:   authorID,
+		ImagePath:     h.FilePath,
+		Title:         h.Title,
+		Description:   h.Description,
+		Needs:         needs,
+		EndDate:       h.EndDate,
+		Status:        Active,
+		CreatedBy:     authorID,
+		Questionnaire: h.Questionnaire,
+		Urgency:       h.Urgency,
+	}
+	if h.Draft || h.PublishAt != nil {
+		event.Status = InActive
+	}
+	if h.PublishAt != nil {
+		event.PublishAt = sql.NullTime{Time: *h.PublishAt, Valid: true}
 	}
 	_, err := url.ParseRequestURI(event.ImagePath)
 	if (len(h.FileBytes) == 0 || h.FileType == "") && err != nil {
@@ -91,9 +105,17 @@ func (h *HelpEventCreateRequest) ToInternal(authorID uint) *HelpEvent {
 			h.Tags = append(h.Tags[:i], h.Tags[i+1:]...)
 		}
 	}
+	if h.Coordinates != nil {
+		location.SetCoordinates(*h.Coordinates)
+		location.EventType = HelpEventType
+	}
 	event.Location = location
 
 	event.Tags = h.TagsInternal()
+	event.DropPoints = make([]DropPoint, len(h.DropPoints))
+	for i, point := range h.DropPoints {
+		event.DropPoints[i] = point.ToInternal()
+	}
 
 	return event
 }
@@ -163,6 +185,10 @@ type HelpEventRequestUpdate struct {
 	Status          EventStatus `json:"status"`
 	FileBytes       []byte      `json:"fileBytes"`
 	FileType        string      `json:"fileType"`
+	PublishAt       *time.Time  `json:"publishAt"`
+	Urgency         Urgency     `json:"urgency"`
+	// NeedUrgencies changes the urgencies of the event's needs.
+	NeedUrgencies []NeedUrgencyRequest `json:"needUrgencies"`
 }
 
 func UnmarshalHelpEventUpdate(r *io.ReadCloser) (HelpEventRequestUpdate, error) {
@@ -179,6 +205,13 @@ func (p *HelpEventRequestUpdate) Internal() HelpEvent {
 		Description:    p.Description,
 		Status:         p.Status,
 		CompletionTime: p.CompetitionDate,
+		Urgency:        p.Urgency,
+	}
+	for _, needUrgency := range p.NeedUrgencies {
+		event.Needs = append(event.Needs, Need{ID: needUrgency.NeedID, Urgency: needUrgency.Urgency})
+	}
+	if p.PublishAt != nil {
+		event.PublishAt = sql.NullTime{Time: *p.PublishAt, Valid: true}
 	}
 	if len(p.FileBytes) != 0 {
 		event.File = bytes.NewReader(p.FileBytes)
@@ -22,11 +22,12 @@ func (h *HelpEventTransactionUpdateRequest) needsToInternal(isEventCreator bool,
 	needs := make([]Need, len(h.Needs))
 	for i := range h.Needs {
 		need := Need{
-			ID:            h.Needs[i].ID,
-			Title:         h.Needs[i].Title,
-			TransactionID: &h.ID,
-			HelpEventID:   eventID,
-			Unit:          h.Needs[i].Unit,
+			ID:                h.Needs[i].ID,
+			Title:             h.Needs[i].Title,
+			TransactionID:     &h.ID,
+			HelpEventID:       eventID,
+			Unit:              h.Needs[i].Unit,
+			TransferReference: h.Needs[i].Reference,
 		}
 		need.Received = h.Needs[i].Received
 		needs[i] = need
@@ -3,16 +3,22 @@ package models
 import "encoding/json"
 
 type HelpSearchInternal struct {
-	Name             *string
-	Tags             *[]Tag
-	SortField        string
-	Order            *Order
-	SearcherID       *uint
+	Name       *string
+	Tags       *[]Tag
+	SortField  string
+	Order      *Order
+	SearcherID *uint
+	// CreatorID keeps the events of the member only.
+	CreatorID        *uint
 	State            []EventStatus
 	TakingPart       *bool
 	Location         *Address
 	Pagination       PaginationRequest
 	AllowTitleSearch *bool
+	Near             *GeoPoint
+	RadiusKm         float64
+	// Urgency keeps the events whose own urgency or the urgency of an unfinished need is in the list.
+	Urgency []Urgency
 }
 
 func (i HelpSearchInternal) GetTagsValues() []string {
@@ -0,0 +1,127 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/go-playground/validator/v10"
+	"io"
+	"time"
+)
+
+var ErrInvalidIntake = errors.New("invalid intake entry")
+
+type IntakeKind string
+
+const (
+	// IntakeReceipt is recorded when the author confirms what was received in a completed transaction.
+	IntakeReceipt IntakeKind = "receipt"
+	// IntakeCorrection is posted by the author to fix the received total, its quantity can be negative.
+	IntakeCorrection IntakeKind = "correction"
+	// IntakeReversal undoes another entry with the opposite quantity.
+	IntakeReversal IntakeKind = "reversal"
+)
+
+// IntakeEntry is an entry of the append-only ledger of what an event need received.
+// The received total of the need is the sum of the quantities of its entries, in the unit of the need.
+// Entries of money needs have their quantity in QuantityMinor as whole minor units of the currency.
+type IntakeEntry struct {
+	ID            uint       `gorm:"column:id"`
+	NeedID        uint       `gorm:"column:need_id"`
+	HelpEventID   uint       `gorm:"column:help_event_id"`
+	TransactionID *uint      `gorm:"column:transaction_id"`
+	Kind          IntakeKind `gorm:"column:kind"`
+	Quantity      float64    `gorm:"column:quantity"`
+	QuantityMinor int64      `gorm:"column:quantity_minor"`
+	Unit          Unit       `gorm:"column:unit"`
+	RecordedBy    uint       `gorm:"column:recorded_by"`
+	ReversesID    *uint      `gorm:"column:reverses_id"`
+	Comment       string     `gorm:"column:comment"`
+	CreationDate  time.Time  `gorm:"column:creation_date"`
+}
+
+func (IntakeEntry) TableName() string {
+	return "need_intake"
+}
+
+// IntakeCorrectionRequest adds the quantity to the received total of the need, a negative quantity decreases it.
+// The quantity can be in any unit of the need's dimension, it is in the unit of the need when the unit is empty.
+type IntakeCorrectionRequest struct {
+	NeedID        uint    `json:"needID" validate:"required"`
+	Quantity      float64 `json:"quantity" validate:"required"`
+	Unit          Unit    `json:"unit"`
+	TransactionID *uint   `json:"transactionID"`
+	Comment       string  `json:"comment" validate:"max=255"`
+}
+
+func UnmarshalIntakeCorrectionRequest(r *io.ReadCloser) (IntakeCorrectionRequest, error) {
+	correction := IntakeCorrectionRequest{}
+	err := json.NewDecoder(*r).Decode(&correction)
+	if err != nil {
+		return IntakeCorrectionRequest{}, err
+	}
+
+	return correction, validator.New().Struct(correction)
+}
+
+type IntakeReversalRequest struct {
+	Comment string `json:"comment" validate:"max=255"`
+}
+
+func UnmarshalIntakeReversalRequest(r *io.ReadCloser) (IntakeReversalRequest, error) {
+	reversal := IntakeReversalRequest{}
+	err := json.NewDecoder(*r).Decode(&reversal)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return IntakeReversalRequest{}, err
+	}
+
+	return reversal, validator.New().Struct(reversal)
+}
+
+// IntakeEntryResponse has the quantity in the unit of the need, money in minor units.
+type IntakeEntryResponse struct {
+	ID            uint        `json:"id"`
+	NeedID        uint        `json:"needID"`
+	TransactionID *uint       `json:"transactionID,omitempty"`
+	Kind          IntakeKind  `json:"kind"`
+	Quantity      json.Number `json:"quantity"`
+	Unit          Unit        `json:"unit"`
+	RecordedBy    uint        `json:"recordedBy"`
+	ReversesID    *uint       `json:"reversesID,omitempty"`
+	Comment       string      `json:"comment"`
+	CreationDate  time.Time   `json:"creationDate"`
+}
+
+type IntakeLedgerResponse struct {
+	Entries []IntakeEntryResponse `json:"entries"`
+}
+
+func (i IntakeLedgerResponse) Bytes() []byte {
+	bytes, _ := json.Marshal(i)
+	return bytes
+}
+
+func CreateIntakeLedgerResponse(entries []IntakeEntry) IntakeLedgerResponse {
+	response := IntakeLedgerResponse{
+		Entries: make([]IntakeEntryResponse, len(entries)),
+	}
+	for i, entry := range entries {
+		quantity := goodsQuantity(entry.Quantity)
+		if entry.QuantityMinor != 0 {
+			quantity = moneyQuantity(entry.QuantityMinor)
+		}
+		response.Entries[i] = IntakeEntryResponse{
+			ID:            entry.ID,
+			NeedID:        entry.NeedID,
+			TransactionID: entry.TransactionID,
+			Kind:          entry.Kind,
+			Quantity:      quantity,
+			Unit:          entry.Unit,
+			RecordedBy:    entry.RecordedBy,
+			ReversesID:    entry.ReversesID,
+			Comment:       entry.Comment,
+			CreationDate:  entry.CreationDate,
+		}
+	}
+
+	return response
+}
@@ -0,0 +1,34 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+var ErrJobRunning = errors.New("job is still running")
+
+// JobStatus describes the latest runs of a background job.
+// Skips counts runs skipped because another replica is the leader.
+type JobStatus struct {
+	Name           string     `json:"name"`
+	Schedule       string     `json:"schedule"`
+	Running        bool       `json:"running"`
+	LastStartedAt  *time.Time `json:"lastStartedAt,omitempty"`
+	LastFinishedAt *time.Time `json:"lastFinishedAt,omitempty"`
+	LastDuration   string     `json:"lastDuration,omitempty"`
+	LastError      string     `json:"lastError,omitempty"`
+	LastErrorAt    *time.Time `json:"lastErrorAt,omitempty"`
+	Runs           int        `json:"runs"`
+	Failures       int        `json:"failures"`
+	Skips          int        `json:"skips"`
+}
+
+type JobStatusesResponse struct {
+	Jobs []JobStatus `json:"jobs"`
+}
+
+func (j JobStatusesResponse) Bytes() []byte {
+	bytes, _ := json.Marshal(j)
+	return bytes
+}
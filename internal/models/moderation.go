@@ -0,0 +1,164 @@
+package models
+
+import (
+	"database/sql"
+	"encoding/json"
+	"io"
+	"time"
+)
+
+type ModerationDecision string
+
+const (
+	ModerationAllow  ModerationDecision = "allow"
+	ModerationFlag   ModerationDecision = "flag"
+	ModerationReject ModerationDecision = "reject"
+)
+
+type ModerationEntityType string
+
+const (
+	ModeratedComment       ModerationEntityType = "comment"
+	ModeratedProposalEvent ModerationEntityType = "proposal-event"
+	ModeratedHelpEvent     ModerationEntityType = "help"
+	ModeratedEventUpdate   ModerationEntityType = "event-update"
+)
+
+type ModerationStatus string
+
+const (
+	ModerationPending  ModerationStatus = "pending"
+	ModerationApproved ModerationStatus = "approved"
+	ModerationRemoved  ModerationStatus = "removed"
+)
+
+type BlockedWordLanguage string
+
+const (
+	Ukrainian BlockedWordLanguage = "uk"
+	English   BlockedWordLanguage = "en"
+)
+
+type ModerationResult struct {
+	Decision ModerationDecision
+	Reasons  []string
+}
+
+// Add records the reason and keeps the most severe decision.
+func (m *ModerationResult) Add(decision ModerationDecision, reason string) {
+	if decision == ModerationReject || m.Decision == ModerationAllow {
+		m.Decision = decision
+	}
+	m.Reasons = append(m.Reasons, reason)
+}
+
+// BlockedStemSuffix marks a blocked word as a stem matching every word it starts,
+// other blocked words match whole words only.
+const BlockedStemSuffix = "*"
+
+type BlockedWord struct {
+	ID           uint                `gorm:"column:id" json:"id"`
+	Word         string              `gorm:"column:word" json:"word"`
+	Language     BlockedWordLanguage `gorm:"column:language" json:"language"`
+	CreationDate time.Time           `gorm:"column:creation_date" json:"creationDate"`
+}
+
+func (BlockedWord) TableName() string {
+	return "moderation_blocked_word"
+}
+
+type ModerationItem struct {
+	ID           uint                 `gorm:"column:id"`
+	EntityID     uint                 `gorm:"column:entity_id"`
+	EntityType   ModerationEntityType `gorm:"column:entity_type"`
+	AuthorID     uint                 `gorm:"column:author_id"`
+	Content      string               `gorm:"column:content"`
+	Reason       string               `gorm:"column:reason"`
+	Status       ModerationStatus     `gorm:"column:status"`
+	CreationDate time.Time            `gorm:"column:creation_date"`
+	ResolvedBy   sql.NullInt64        `gorm:"column:resolved_by"`
+	ResolvedAt   sql.NullTime         `gorm:"column:resolved_at"`
+}
+
+func (ModerationItem) TableName() string {
+	return "moderation_item"
+}
+
+type ModerationItemResponse struct {
+	ID           uint                 `json:"id"`
+	EntityID     uint                 `json:"entityID"`
+	EntityType   ModerationEntityType `json:"entityType"`
+	AuthorID     uint                 `json:"authorID"`
+	Content      string               `json:"content"`
+	Reason       string               `json:"reason"`
+	Status       ModerationStatus     `json:"status"`
+	CreationDate time.Time            `json:"creationDate"`
+}
+
+type ModerationQueueResponse struct {
+	Items []ModerationItemResponse `json:"items"`
+}
+
+func (m ModerationQueueResponse) Bytes() []byte {
+	bytes, _ := json.Marshal(m)
+	return bytes
+}
+
+func CreateModerationQueueResponse(items []ModerationItem) ModerationQueueResponse {
+	response := ModerationQueueResponse{
+		Items: make([]ModerationItemResponse, len(items)),
+	}
+
+	for i, item := range items {
+		response.Items[i] = ModerationItemResponse{
+			ID:           item.ID,
+			EntityID:     item.EntityID,
+			EntityType:   item.EntityType,
+			AuthorID:     item.AuthorID,
+			Content:      item.Content,
+			Reason:       item.Reason,
+			Status:       item.Status,
+			CreationDate: item.CreationDate,
+		}
+	}
+
+	return response
+}
+
+type ModerationResolveRequest struct {
+	Approve bool `json:"approve"`
+}
+
+func UnmarshalModerationResolveRequest(r *io.ReadCloser) (ModerationResolveRequest, error) {
+	m := ModerationResolveRequest{}
+	err := json.NewDecoder(*r).Decode(&m)
+	return m, err
+}
+
+type BlockedWordCreateRequest struct {
+	Word     string              `json:"word"`
+	Language BlockedWordLanguage `json:"language"`
+}
+
+func (b BlockedWordCreateRequest) Internal() BlockedWord {
+	return BlockedWord{
+		Word:         b.Word,
+		Language:     b.Language,
+		CreationDate: time.Now(),
+	}
+}
+
+func UnmarshalBlockedWordCreateRequest(r *io.ReadCloser) (BlockedWordCreateRequest, error) {
+	b := BlockedWordCreateRequest{}
+	err := json.NewDecoder(*r).Decode(&b)
+	return b, err
+}
+
+type BlockedWordsResponse struct {
+	Words []BlockedWord `json:"words"`
+}
+
+func (b BlockedWordsResponse) Bytes() []byte {
+	bytes, _ := json.Marshal(b)
+	return bytes
+}
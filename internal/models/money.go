@@ -0,0 +1,131 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+	"sort"
+	"strconv"
+)
+
+var ErrInvalidMoney = errors.New("invalid money amount")
+
+type NeedType string
+
+const (
+	GoodsNeed NeedType = "goods"
+	MoneyNeed NeedType = "money"
+)
+
+// MoneyUnit is the unit of money needs, their amounts are whole numbers of the minor units of the currency.
+const MoneyUnit = "minor_unit"
+
+// currencyExponents maps the ISO 4217 codes of the supported currencies to the number of digits of their minor units.
+var currencyExponents = map[string]int{
+	"UAH": 2,
+	"USD": 2,
+	"EUR": 2,
+	"GBP": 2,
+	"PLN": 2,
+	"CHF": 2,
+	"CAD": 2,
+	"CZK": 2,
+	"SEK": 2,
+	"NOK": 2,
+	"DKK": 2,
+	"JPY": 0,
+}
+
+func ValidateCurrency(currency string) error {
+	if _, ok := currencyExponents[currency]; !ok {
+		return fmt.Errorf("%w: unsupported currency %q", ErrInvalidMoney, currency)
+	}
+
+	return nil
+}
+
+// MinorUnits converts the amount of money of a request to whole minor units of the currency.
+func MinorUnits(amount float64) (int64, error) {
+	if amount < 0 || amount != math.Trunc(amount) || amount >= math.MaxInt64 {
+		return 0, fmt.Errorf("%w: %v should be a whole number of minor units", ErrInvalidMoney, amount)
+	}
+
+	return int64(amount), nil
+}
+
+// MinorUnitsIn converts the amount reported for a money need, an amount without a unit is already in minor units.
+func MinorUnitsIn(amount float64, unit Unit) (int64, error) {
+	if unit != "" && unit != MoneyUnit {
+		return 0, fmt.Errorf("%w: money is counted in %s", ErrInvalidUnit, MoneyUnit)
+	}
+
+	return MinorUnits(amount)
+}
+
+// percentage is the received share of the positive amount in whole percents, rounded down.
+// The remainder is multiplied in 128 bits, so amounts near the int64 limit do not overflow.
+func percentage(received, amount int64) int64 {
+	whole := received / amount
+	if whole > math.MaxInt64/100 {
+		return math.MaxInt64
+	}
+	hi, lo := bits.Mul64(uint64(received%amount), 100)
+	fraction, _ := bits.Div64(hi, lo, uint64(amount))
+
+	return whole*100 + int64(fraction)
+}
+
+// goodsQuantity and moneyQuantity write the amounts of responses as JSON numbers, money stays exact.
+func goodsQuantity(amount float64) json.Number {
+	return json.Number(strconv.FormatFloat(amount, 'f', -1, 64))
+}
+
+func moneyQuantity(amount int64) json.Number {
+	return json.Number(strconv.FormatInt(amount, 10))
+}
+
+// MoneyTotal is an amount of money in minor units of the currency.
+type MoneyTotal struct {
+	Currency string `gorm:"column:currency" json:"currency"`
+	Amount   int64  `gorm:"column:amount" json:"amount"`
+}
+
+// MoneyProgress is how much of the money needs of an event in the currency was received.
+type MoneyProgress struct {
+	Currency              string `json:"currency"`
+	Amount                int64  `json:"amount"`
+	ReceivedTotal         int64  `json:"receivedTotal"`
+	CompletionPercentages int64  `json:"completionPercentages"`
+}
+
+// moneyProgress sums the money needs by currency, currencies are sorted by their codes.
+func moneyProgress(needs []Need) []MoneyProgress {
+	progress := make(map[string]*MoneyProgress)
+	for _, need := range needs {
+		if need.Type != MoneyNeed {
+			continue
+		}
+		currencyProgress, ok := progress[need.Currency]
+		if !ok {
+			currencyProgress = &MoneyProgress{Currency: need.Currency}
+			progress[need.Currency] = currencyProgress
+		}
+		currencyProgress.Amount += need.AmountMinor
+		currencyProgress.ReceivedTotal += need.ReceivedTotalMinor
+	}
+
+	result := make([]MoneyProgress, 0, len(progress))
+	for _, currencyProgress := range progress {
+		if currencyProgress.Amount != 0 {
+			currencyProgress.CompletionPercentages = percentage(currencyProgress.ReceivedTotal, currencyProgress.Amount)
+		}
+		result = append(result, *currencyProgress)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Currency < result[j].Currency
+	})
+
+	return result
+}
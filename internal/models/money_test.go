@@ -0,0 +1,60 @@
+package models_test
+
+import (
+	"Kurajj/internal/models"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestMoneyProgress(t *testing.T) {
+	event := models.HelpEvent{
+		Needs: []models.Need{
+			{Title: "Generator", Type: models.MoneyNeed, AmountMinor: 2000000, ReceivedTotalMinor: 500000, Currency: "UAH"},
+			{Title: "Fuel", Type: models.MoneyNeed, AmountMinor: 1000000, ReceivedTotalMinor: 1000000, Currency: "UAH"},
+			{Title: "Cables", Type: models.MoneyNeed, AmountMinor: 10000, ReceivedTotalMinor: 2500, Currency: "EUR"},
+			{Title: "Tanker", Type: models.MoneyNeed, AmountMinor: 1<<62 + 3, ReceivedTotalMinor: 1<<61 + 1,
+				Currency: "JPY"},
+			{Title: "Water", Type: models.GoodsNeed, Amount: 10, ReceivedTotal: 5, Unit: models.Liter},
+		},
+	}
+
+	event.CalculateCompletionPercentages()
+
+	assert.Equal(t, []models.MoneyProgress{
+		{Currency: "EUR", Amount: 10000, ReceivedTotal: 2500, CompletionPercentages: 25},
+		{Currency: "JPY", Amount: 1<<62 + 3, ReceivedTotal: 1<<61 + 1, CompletionPercentages: 49},
+		{Currency: "UAH", Amount: 3000000, ReceivedTotal: 1500000, CompletionPercentages: 50},
+	}, event.MoneyProgress)
+}
+
+func TestNeedRequestCreateValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		need    models.NeedRequestCreate
+		wantErr bool
+	}{
+		{name: "goods", need: models.NeedRequestCreate{Title: "Water", Amount: 10}},
+		{name: "too many goods", need: models.NeedRequestCreate{Title: "Water", Amount: 51}, wantErr: true},
+		{name: "goods with currency", need: models.NeedRequestCreate{Title: "Water", Amount: 10, Currency: "UAH"},
+			wantErr: true},
+		{name: "money", need: models.NeedRequestCreate{Title: "Generator", Type: models.MoneyNeed, Amount: 2000000,
+			Currency: "UAH"}},
+		{name: "unknown currency", need: models.NeedRequestCreate{Title: "Generator", Type: models.MoneyNeed,
+			Amount: 2000000, Currency: "XYZ"}, wantErr: true},
+		{name: "fraction of minor units", need: models.NeedRequestCreate{Title: "Generator", Type: models.MoneyNeed,
+			Amount: 100.5, Currency: "UAH"}, wantErr: true},
+		{name: "more minor units than int64 holds", need: models.NeedRequestCreate{Title: "Generator",
+			Type: models.MoneyNeed, Amount: 1 << 63, Currency: "UAH"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.need.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
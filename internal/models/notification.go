@@ -12,6 +12,7 @@ type TransactionNotification struct {
 	IsRead        bool              `gorm:"column:is_read"`
 	CreationTime  time.Time         `gorm:"column:creation_time"`
 	MemberID      uint              `gorm:"column:member_id"`
+	Summary       string            `gorm:"column:summary"`
 	EventTitle    string            `gorm:"-"`
 }
 
@@ -20,6 +21,23 @@ type TransactionAction string
 const (
 	Created TransactionAction = "created"
 	Updated TransactionAction = "updated"
+	// SlotOffered notifies a waitlisted member that a slot was reserved for them.
+	// Such notifications are not bound to any transaction.
+	SlotOffered TransactionAction = "slot_offered"
+	// SearchMatched notifies a member that a published event matches their saved search values.
+	SearchMatched TransactionAction = "search_matched"
+	// EventExpired tells the author that the event has ended and how its unfinished transactions were settled.
+	EventExpired TransactionAction = "event_expired"
+	// TimeSlotReminder reminds both parties of a transaction that its time slot starts soon.
+	TimeSlotReminder TransactionAction = "time_slot_reminder"
+	// UrgencyChanged notifies members whose saved search values match an active event that its urgency changed.
+	UrgencyChanged TransactionAction = "urgency_changed"
+	// EventUpdated notifies the event participants and followers that its author posted an update.
+	EventUpdated TransactionAction = "event_updated"
+	// CoOrganizerAdded tells the member that the event author made them a co-organizer.
+	CoOrganizerAdded TransactionAction = "co_organizer_added"
+	// EventDeleted tells the event responders and co-organizers that the author deleted the event.
+	EventDeleted TransactionAction = "event_deleted"
 )
 
 func (TransactionNotification) TableName() string {
@@ -49,6 +49,22 @@ func GenerateNotificationResponse(notification TransactionNotification) Notifica
 		text = fmt.Sprintf("%s transaction was created in %s.", notificationType, notification.EventTitle)
 	case Updated:
 		text = fmt.Sprintf("%s status changed to %s in %s event.", notificationType, notification.NewStatus, notification.EventTitle)
+	case SlotOffered:
+		text = fmt.Sprintf("A slot was freed for you in %s event. Confirm it to start a transaction.", notification.EventTitle)
+	case SearchMatched:
+		text = fmt.Sprintf("%s event matching your search was published.", notification.EventTitle)
+	case UrgencyChanged:
+		text = fmt.Sprintf("%s event matching your search: %s.", notification.EventTitle, notification.Summary)
+	case EventUpdated:
+		text = fmt.Sprintf("%s event has a new update: %s", notification.EventTitle, notification.Summary)
+	case CoOrganizerAdded:
+		text = fmt.Sprintf("You became a co-organizer of %s event as %s.", notification.EventTitle, notification.Summary)
+	case TimeSlotReminder:
+		text = fmt.Sprintf("Your time slot in %s event %s.", notification.EventTitle, notification.Summary)
+	case EventExpired:
+		text = fmt.Sprintf("%s event has ended. %s", notification.EventTitle, notification.Summary)
+	case EventDeleted:
+		text = fmt.Sprintf("%s event was deleted by its author. %s", notification.EventTitle, notification.Summary)
 	}
 	return NotificationResponse{
 		ID:         notification.ID,
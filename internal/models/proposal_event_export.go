@@ -22,14 +22,21 @@ func UnmarshalProposalEventCreate(r *io.ReadCloser) (ProposalEventRequestCreate,
 }
 
 type ProposalEventRequestCreate struct {
-	Title                 string       `json:"title"`
-	Description           string       `json:"description"`
-	EndDate               time.Time    `json:"endDate"`
-	MaxConcurrentRequests int          `json:"maxConcurrentRequests"`
-	FileBytes             []byte       `json:"fileBytes"`
-	FileType              string       `json:"fileType"`
-	FilePath              string       `json:"imagePath"`
-	Tags                  []TagRequest `json:"tags"`
+	Title                 string            `json:"title"`
+	Description           string            `json:"description"`
+	EndDate               time.Time         `json:"endDate"`
+	MaxConcurrentRequests int               `json:"maxConcurrentRequests"`
+	FileBytes             []byte            `json:"fileBytes"`
+	FileType              string            `json:"fileType"`
+	FilePath              string            `json:"imagePath"`
+	Tags                  []TagRequest      `json:"tags"`
+	RecurrenceRule        string            `json:"recurrenceRule"`
+	Draft                 bool              `json:"draft"`
+	PublishAt             *time.Time        `json:"publishAt"`
+	TimeSlots             []TimeSlotRequest `json:"timeSlots"`
+	Questionnaire         Questionnaire     `json:"questionnaire"`
+	// Coordinates of the event's place, they are looked up by the location's city when they are not set.
+	Coordinates *GeoPoint `json:"coordinates"`
 }
 
 func (p *ProposalEventRequestCreate) TagsInternal() []Tag {
@@ -72,6 +79,10 @@ func (p *ProposalEventRequestCreate) InternalValue(userID uint) ProposalEvent {
 			p.Tags = append(p.Tags[:i], p.Tags[i+1:]...)
 		}
 	}
+	if p.Coordinates != nil {
+		location.SetCoordinates(*p.Coordinates)
+		location.EventType = ProposalEventType
+	}
 
 	event := ProposalEvent{
 		AuthorID:              userID,
@@ -85,6 +96,19 @@ func (p *ProposalEventRequestCreate) InternalValue(userID uint) ProposalEvent {
 		MaxConcurrentRequests: uint(p.MaxConcurrentRequests),
 		RemainingHelps:        p.MaxConcurrentRequests,
 		Tags:                  p.TagsInternal(),
+		RecurrenceRule:        p.RecurrenceRule,
+		Occurrence:            1,
+		TimeSlots:             make([]TimeSlot, len(p.TimeSlots)),
+		Questionnaire:         p.Questionnaire,
+	}
+	for i, timeSlot := range p.TimeSlots {
+		event.TimeSlots[i] = timeSlot.Internal()
+	}
+	if p.Draft || p.PublishAt != nil {
+		event.Status = InActive
+	}
+	if p.PublishAt != nil {
+		event.PublishAt = sql.NullTime{Time: *p.PublishAt, Valid: true}
 	}
 
 	_, err := url.ParseRequestURI(event.ImagePath)
@@ -110,8 +134,16 @@ type ProposalEventGetResponse struct {
 	Image                 string                `json:"imageURL"`
 	User                  UserShortInfo         `json:"authorInfo"`
 	Comments              []CommentResponse     `json:"comments"`
+	Updates               []EventUpdateResponse `json:"updates"`
+	CoOrganizers          []CoOrganizerResponse `json:"coOrganizers"`
 	Transactions          []TransactionResponse `json:"transactions"`
 	Tags                  []TagResponse         `json:"tags"`
+	RecurrenceRule        string                `json:"recurrenceRule,omitempty"`
+	Occurrence            int                   `json:"occurrence"`
+	PublishAt             *time.Time            `json:"publishAt,omitempty"`
+	TimeSlots             []TimeSlotResponse    `json:"timeSlots,omitempty"`
+	Questionnaire         Questionnaire         `json:"questionnaire,omitempty"`
+	Coordinates           *GeoPoint             `json:"coordinates,omitempty"`
 }
 
 func (p ProposalEventGetResponse) Bytes() []byte {
@@ -152,6 +184,8 @@ type ProposalEventRequestUpdate struct {
 	FileBytes             []byte      `json:"fileBytes"`
 	FileType              string      `json:"fileType"`
 	MaxConcurrentRequests int         `json:"maxConcurrentRequests"`
+	RecurrenceRule        string      `json:"recurrenceRule"`
+	PublishAt             *time.Time  `json:"publishAt"`
 }
 
 func (p *ProposalEventRequestUpdate) Internal() ProposalEvent {
@@ -165,6 +199,10 @@ func (p *ProposalEventRequestUpdate) Internal() ProposalEvent {
 			Time: p.CompetitionDate,
 		},
 		MaxConcurrentRequests: uint(p.MaxConcurrentRequests),
+		RecurrenceRule:        p.RecurrenceRule,
+	}
+	if p.PublishAt != nil {
+		event.PublishAt = sql.NullTime{Time: *p.PublishAt, Valid: true}
 	}
 	if len(p.FileBytes) != 0 {
 		event.File = bytes.NewReader(p.FileBytes)
@@ -259,13 +297,18 @@ func GetProposalEvent(event ProposalEvent) ProposalEventGetResponse {
 			ReportURL:         t.ReportURL,
 			Creator:           t.Creator.ToShortInfo(),
 			Responder:         t.Responder.ToShortInfo(),
+			Occurrence:        t.Occurrence,
+			Answers:           t.QuestionnaireAnswers,
+		}
+		if t.TimeSlotID.Valid {
+			transaction.TimeSlotID = uint(t.TimeSlotID.Int64)
 		}
 		if t.CompetitionDate.Valid && !t.CompetitionDate.Time.IsZero() {
 			transaction.CompetitionDate = t.CompetitionDate.Time
 		}
 		transactions[i] = transaction
 	}
-	return ProposalEventGetResponse{
+	response := ProposalEventGetResponse{
 		ID:                    event.ID,
 		Title:                 event.Title,
 		Description:           event.Description,
@@ -280,10 +323,24 @@ func GetProposalEvent(event ProposalEvent) ProposalEventGetResponse {
 			ProfileImageURL: event.User.AvatarImagePath,
 			PhoneNumber:     Telephone(event.User.Telephone),
 		},
-		Image:        event.ImagePath,
-		Comments:     comments,
-		Transactions: transactions,
-		Tags:         tags,
-		Status:       event.Status,
+		Image:          event.ImagePath,
+		Comments:       comments,
+		Updates:        GenerateEventUpdateResponses(event.Updates),
+		CoOrganizers:   GenerateCoOrganizerResponses(event.CoOrganizers),
+		Transactions:   transactions,
+		Tags:           tags,
+		Status:         event.Status,
+		RecurrenceRule: event.RecurrenceRule,
+		Occurrence:     event.Occurrence,
+		Questionnaire:  event.Questionnaire,
+		Coordinates:    event.Location.Coordinates(),
+	}
+	if event.PublishAt.Valid {
+		response.PublishAt = &event.PublishAt.Time
 	}
+	for _, timeSlot := range event.TimeSlots {
+		response.TimeSlots = append(response.TimeSlots, timeSlot.Response())
+	}
+
+	return response
 }
@@ -17,32 +17,63 @@ var (
 )
 
 type ProposalEvent struct {
-	ID                    uint          `gorm:"primaryKey"`
-	Title                 string        `gorm:"column:title"`
-	Description           string        `gorm:"column:description"`
-	CreationDate          time.Time     `gorm:"column:creation_date"`
-	CompetitionDate       sql.NullTime  `gorm:"column:competition_date"`
-	AuthorID              uint          `gorm:"column:author_id"`
-	EndDate               time.Time     `gorm:"column:end_date"`
-	Status                EventStatus   `gorm:"column:status"`
-	MaxConcurrentRequests uint          `gorm:"column:max_concurrent_requests"`
-	RemainingHelps        int           `gorm:"column:remaining_helps"`
-	IsDeleted             bool          `gorm:"column:is_deleted"`
-	ImagePath             string        `gorm:"column:image_path"`
-	Banned                bool          `gorm:"column:is_banned"`
-	FileType              string        `gorm:"-"`
-	File                  io.Reader     `gorm:"-"`
-	Comments              []Comment     `gorm:"-"`
-	Transactions          []Transaction `gorm:"-"`
-	Tags                  []Tag         `gorm:"-"`
-	Location              Address       `gorm:"-"`
-	User                  User          `gorm:"-"`
+	ID                    uint               `gorm:"primaryKey"`
+	Title                 string             `gorm:"column:title"`
+	Description           string             `gorm:"column:description"`
+	CreationDate          time.Time          `gorm:"column:creation_date"`
+	CompetitionDate       sql.NullTime       `gorm:"column:competition_date"`
+	AuthorID              uint               `gorm:"column:author_id"`
+	EndDate               time.Time          `gorm:"column:end_date"`
+	Status                EventStatus        `gorm:"column:status"`
+	MaxConcurrentRequests uint               `gorm:"column:max_concurrent_requests"`
+	RemainingHelps        int                `gorm:"column:remaining_helps"`
+	IsDeleted             bool               `gorm:"column:is_deleted"`
+	ImagePath             string             `gorm:"column:image_path"`
+	Banned                bool               `gorm:"column:is_banned"`
+	RecurrenceRule        string             `gorm:"column:recurrence_rule"`
+	Occurrence            int                `gorm:"column:occurrence;default:1"`
+	PublishAt             sql.NullTime       `gorm:"column:publish_at"`
+	Questionnaire         Questionnaire      `gorm:"column:questionnaire"`
+	TimeSlots             []TimeSlot         `gorm:"-"`
+	FileType              string             `gorm:"-"`
+	File                  io.Reader          `gorm:"-"`
+	Comments              []Comment          `gorm:"-"`
+	Updates               []EventUpdate      `gorm:"-"`
+	CoOrganizers          []EventCoOrganizer `gorm:"-"`
+	Transactions          []Transaction      `gorm:"-"`
+	Tags                  []Tag              `gorm:"-"`
+	Location              Address            `gorm:"-"`
+	User                  User               `gorm:"-"`
 }
 
 func (p ProposalEvent) TableName() string {
 	return "propositional_event"
 }
 
+// NextOccurrence returns the number and the date of the first occurrence of a recurring event
+// that ends after the given time. The second value is false when the event is not recurring
+// or its rule has no more occurrences.
+func (p ProposalEvent) NextOccurrence(after time.Time) (int, time.Time, bool) {
+	if p.RecurrenceRule == "" {
+		return 0, time.Time{}, false
+	}
+	rule, err := ParseRecurrenceRule(p.RecurrenceRule)
+	if err != nil {
+		return 0, time.Time{}, false
+	}
+
+	occurrence, date := p.Occurrence, p.EndDate
+	for !date.After(after) {
+		next, ok := rule.Next(date, occurrence)
+		if !ok {
+			return 0, time.Time{}, false
+		}
+		occurrence, date = occurrence+1, next
+	}
+
+	return occurrence, date, true
+}
+
 func (p ProposalEvent) GetValuesToUpdate() map[string]any {
 	getProposalEventTag := func(f reflect.StructField, tagName string) string {
 		tag := strings.Split(f.Tag.Get(tagName), ":")
@@ -89,16 +120,22 @@ func (p ProposalEventsInternal) Serialize() ([]byte, error) {
 }
 
 type ProposalEventSearchInternal struct {
-	Name             *string
-	Tags             *[]Tag
-	SortField        string
-	Order            *Order
-	SearcherID       *uint
+	Name       *string
+	Tags       *[]Tag
+	SortField  string
+	Order      *Order
+	SearcherID *uint
+	// CreatorID keeps the events of the member only.
+	CreatorID        *uint
 	State            []EventStatus
 	TakingPart       *bool
 	Location         *Address
 	Pagination       PaginationRequest
 	AllowTitleSearch *bool
+	Near             *GeoPoint
+	RadiusKm         float64
+	// Urgency is only used by the help events search, proposal events have no urgency.
+	Urgency []Urgency
 }
 
 func (i ProposalEventSearchInternal) GetTagsValues() []string {
@@ -0,0 +1,151 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/samber/lo"
+	"math"
+)
+
+var (
+	ErrInvalidQuestionnaire = errors.New("invalid questionnaire")
+	ErrInvalidAnswers       = errors.New("answers do not match the questionnaire")
+)
+
+// maxQuestions limits the size of a questionnaire, it is meant to be a small form.
+const maxQuestions = 20
+
+type QuestionType string
+
+const (
+	TextQuestion   QuestionType = "text"
+	NumberQuestion QuestionType = "number"
+	ChoiceQuestion QuestionType = "choice"
+	YesNoQuestion  QuestionType = "yes_no"
+)
+
+type Question struct {
+	ID       string       `json:"id"`
+	Label    string       `json:"label"`
+	Type     QuestionType `json:"type"`
+	Required bool         `json:"required"`
+	Options  []string     `json:"options,omitempty"`
+}
+
+// Questionnaire is a form the author attaches to an event, responders answer it when they respond to the event.
+// It is stored as json.
+type Questionnaire []Question
+
+func (q Questionnaire) Validate() error {
+	if len(q) > maxQuestions {
+		return fmt.Errorf("%w: it can have at most %d questions", ErrInvalidQuestionnaire, maxQuestions)
+	}
+
+	ids := make(map[string]bool, len(q))
+	for _, question := range q {
+		if question.ID == "" || question.Label == "" {
+			return fmt.Errorf("%w: every question needs an id and a label", ErrInvalidQuestionnaire)
+		}
+		if ids[question.ID] {
+			return fmt.Errorf("%w: question id %s is used twice", ErrInvalidQuestionnaire, question.ID)
+		}
+		ids[question.ID] = true
+
+		switch question.Type {
+		case TextQuestion, NumberQuestion, YesNoQuestion:
+		case ChoiceQuestion:
+			if len(lo.Uniq(question.Options)) < 2 {
+				return fmt.Errorf("%w: question %s needs at least two different options",
+					ErrInvalidQuestionnaire, question.ID)
+			}
+		default:
+			return fmt.Errorf("%w: question %s has unknown type %s", ErrInvalidQuestionnaire, question.ID, question.Type)
+		}
+	}
+
+	return nil
+}
+
+// CheckAnswers returns ErrInvalidAnswers when a required question is not answered,
+// an answer has the wrong type or there is an answer to a question that is not in the questionnaire.
+func (q Questionnaire) CheckAnswers(answers QuestionnaireAnswers) error {
+	questions := lo.KeyBy(q, func(question Question) string {
+		return question.ID
+	})
+	for id := range answers {
+		if _, ok := questions[id]; !ok {
+			return fmt.Errorf("%w: there is no question %s", ErrInvalidAnswers, id)
+		}
+	}
+
+	for _, question := range q {
+		answer, ok := answers[question.ID]
+		if !ok || answer == nil || answer == "" {
+			if question.Required {
+				return fmt.Errorf("%w: question %s is required", ErrInvalidAnswers, question.ID)
+			}
+			continue
+		}
+
+		valid := false
+		switch question.Type {
+		case TextQuestion:
+			_, valid = answer.(string)
+		case NumberQuestion:
+			number, ok := answer.(float64)
+			valid = ok && !math.IsNaN(number) && !math.IsInf(number, 0)
+		case ChoiceQuestion:
+			choice, ok := answer.(string)
+			valid = ok && lo.Contains(question.Options, choice)
+		case YesNoQuestion:
+			_, valid = answer.(bool)
+		}
+		if !valid {
+			return fmt.Errorf("%w: answer to question %s should be %s", ErrInvalidAnswers, question.ID, question.Type)
+		}
+	}
+
+	return nil
+}
+
+func (q Questionnaire) Value() (driver.Value, error) {
+	if len(q) == 0 {
+		return nil, nil
+	}
+	bytes, err := json.Marshal(q)
+	return string(bytes), err
+}
+
+func (q *Questionnaire) Scan(value any) error {
+	return scanJSON(value, q)
+}
+
+// QuestionnaireAnswers maps question ids to the answers decoded from json: strings, numbers or booleans.
+type QuestionnaireAnswers map[string]any
+
+func (a QuestionnaireAnswers) Value() (driver.Value, error) {
+	if len(a) == 0 {
+		return nil, nil
+	}
+	bytes, err := json.Marshal(a)
+	return string(bytes), err
+}
+
+func (a *QuestionnaireAnswers) Scan(value any) error {
+	return scanJSON(value, a)
+}
+
+func scanJSON(value any, destination any) error {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []byte:
+		return json.Unmarshal(v, destination)
+	case string:
+		return json.Unmarshal([]byte(v), destination)
+	default:
+		return fmt.Errorf("unexpected json value of type %T", value)
+	}
+}
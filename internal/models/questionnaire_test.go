@@ -0,0 +1,67 @@
+package models_test
+
+import (
+	"Kurajj/internal/models"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var testQuestionnaire = models.Questionnaire{
+	{ID: "name", Label: "Your name", Type: models.TextQuestion, Required: true},
+	{ID: "people", Label: "How many people come", Type: models.NumberQuestion},
+	{ID: "size", Label: "Size", Type: models.ChoiceQuestion, Options: []string{"S", "M", "L"}},
+	{ID: "car", Label: "Do you have a car", Type: models.YesNoQuestion, Required: true},
+}
+
+func TestQuestionnaireValidate(t *testing.T) {
+	assert.NoError(t, testQuestionnaire.Validate())
+	assert.NoError(t, models.Questionnaire(nil).Validate())
+
+	for _, invalidQuestionnaire := range []models.Questionnaire{
+		{{ID: "", Label: "Name", Type: models.TextQuestion}},
+		{{ID: "name", Label: "", Type: models.TextQuestion}},
+		{{ID: "name", Label: "Name", Type: "date"}},
+		{{ID: "size", Label: "Size", Type: models.ChoiceQuestion, Options: []string{"S", "S"}}},
+		{
+			{ID: "name", Label: "Name", Type: models.TextQuestion},
+			{ID: "name", Label: "Surname", Type: models.TextQuestion},
+		},
+	} {
+		assert.ErrorIs(t, invalidQuestionnaire.Validate(), models.ErrInvalidQuestionnaire)
+	}
+}
+
+func TestQuestionnaireCheckAnswers(t *testing.T) {
+	answers := models.QuestionnaireAnswers{}
+	err := json.Unmarshal([]byte(`{"name": "Olha", "people": 2, "size": "M", "car": false}`), &answers)
+	assert.NoError(t, err)
+	assert.NoError(t, testQuestionnaire.CheckAnswers(answers))
+	assert.NoError(t, testQuestionnaire.CheckAnswers(models.QuestionnaireAnswers{"name": "Olha", "car": true}))
+	assert.NoError(t, models.Questionnaire(nil).CheckAnswers(nil))
+
+	for _, invalidAnswers := range []models.QuestionnaireAnswers{
+		{"car": true},
+		{"name": "", "car": true},
+		{"name": "Olha"},
+		{"name": "Olha", "car": "yes"},
+		{"name": "Olha", "car": true, "people": "two"},
+		{"name": "Olha", "car": true, "size": "XL"},
+		{"name": "Olha", "car": true, "age": 30.0},
+	} {
+		assert.ErrorIs(t, testQuestionnaire.CheckAnswers(invalidAnswers), models.ErrInvalidAnswers)
+	}
+}
+
+func TestQuestionnaireValueAndScan(t *testing.T) {
+	value, err := testQuestionnaire.Value()
+	assert.NoError(t, err)
+
+	questionnaire := models.Questionnaire{}
+	assert.NoError(t, questionnaire.Scan([]byte(value.(string))))
+	assert.Equal(t, testQuestionnaire, questionnaire)
+
+	value, err = models.QuestionnaireAnswers(nil).Value()
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+}
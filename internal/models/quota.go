@@ -0,0 +1,142 @@
+package models
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+var ErrQuotaExceeded = errors.New("quota exceeded")
+
+type QuotaTier string
+
+const (
+	DefaultQuotaTier              QuotaTier = "default"
+	VerifiedOrganizationQuotaTier QuotaTier = "verified_organization"
+	MemberOverrideQuotaTier       QuotaTier = "member"
+)
+
+// QuotaPolicy limits what a member can do. A limit equal to zero means there is no limit.
+// Policies of the member tier are admin overrides for a single member and take precedence over the other tiers.
+type QuotaPolicy struct {
+	ID                  uint          `gorm:"column:id"`
+	Tier                QuotaTier     `gorm:"column:tier"`
+	MemberID            sql.NullInt64 `gorm:"column:member_id"`
+	MaxActiveEvents     int           `gorm:"column:max_active_events"`
+	MaxPendingResponses int           `gorm:"column:max_pending_responses"`
+	MaxDailyComments    int           `gorm:"column:max_daily_comments"`
+	UpdatedAt           time.Time     `gorm:"column:updated_at"`
+}
+
+func (QuotaPolicy) TableName() string {
+	return "quota_policy"
+}
+
+type QuotaPolicyRequest struct {
+	MaxActiveEvents     int `json:"maxActiveEvents"`
+	MaxPendingResponses int `json:"maxPendingResponses"`
+	MaxDailyComments    int `json:"maxDailyComments"`
+}
+
+func UnmarshalQuotaPolicyRequest(r *io.ReadCloser) (QuotaPolicyRequest, error) {
+	q := QuotaPolicyRequest{}
+	err := json.NewDecoder(*r).Decode(&q)
+	return q, err
+}
+
+func (q QuotaPolicyRequest) Internal(tier QuotaTier, memberID uint) (QuotaPolicy, error) {
+	if q.MaxActiveEvents < 0 || q.MaxPendingResponses < 0 || q.MaxDailyComments < 0 {
+		return QuotaPolicy{}, fmt.Errorf("limits should not be negative")
+	}
+
+	policy := QuotaPolicy{
+		Tier:                tier,
+		MaxActiveEvents:     q.MaxActiveEvents,
+		MaxPendingResponses: q.MaxPendingResponses,
+		MaxDailyComments:    q.MaxDailyComments,
+		UpdatedAt:           time.Now(),
+	}
+	switch tier {
+	case DefaultQuotaTier, VerifiedOrganizationQuotaTier:
+	case MemberOverrideQuotaTier:
+		policy.MemberID = sql.NullInt64{Int64: int64(memberID), Valid: true}
+	default:
+		return QuotaPolicy{}, fmt.Errorf("tier should be one of %s, %s", DefaultQuotaTier, VerifiedOrganizationQuotaTier)
+	}
+
+	return policy, nil
+}
+
+type QuotaPolicyResponse struct {
+	ID                  uint      `json:"id"`
+	Tier                QuotaTier `json:"tier"`
+	MemberID            *uint     `json:"memberID,omitempty"`
+	MaxActiveEvents     int       `json:"maxActiveEvents"`
+	MaxPendingResponses int       `json:"maxPendingResponses"`
+	MaxDailyComments    int       `json:"maxDailyComments"`
+	UpdatedAt           time.Time `json:"updatedAt"`
+}
+
+func (q QuotaPolicy) Response() QuotaPolicyResponse {
+	response := QuotaPolicyResponse{
+		ID:                  q.ID,
+		Tier:                q.Tier,
+		MaxActiveEvents:     q.MaxActiveEvents,
+		MaxPendingResponses: q.MaxPendingResponses,
+		MaxDailyComments:    q.MaxDailyComments,
+		UpdatedAt:           q.UpdatedAt,
+	}
+	if q.MemberID.Valid {
+		memberID := uint(q.MemberID.Int64)
+		response.MemberID = &memberID
+	}
+
+	return response
+}
+
+type QuotaPoliciesResponse struct {
+	Policies []QuotaPolicyResponse `json:"policies"`
+}
+
+func (q QuotaPoliciesResponse) Bytes() []byte {
+	bytes, _ := json.Marshal(q)
+	return bytes
+}
+
+func CreateQuotaPoliciesResponse(policies []QuotaPolicy) QuotaPoliciesResponse {
+	response := QuotaPoliciesResponse{
+		Policies: make([]QuotaPolicyResponse, len(policies)),
+	}
+	for i, policy := range policies {
+		response.Policies[i] = policy.Response()
+	}
+
+	return response
+}
+
+type VerifiedOrganizationRequest struct {
+	Verified bool `json:"verified"`
+}
+
+func UnmarshalVerifiedOrganizationRequest(r *io.ReadCloser) (VerifiedOrganizationRequest, error) {
+	v := VerifiedOrganizationRequest{}
+	err := json.NewDecoder(*r).Decode(&v)
+	return v, err
+}
+
+// MemberQuota is the policy applied to the member together with the member's current usage.
+type MemberQuota struct {
+	Policy                QuotaPolicyResponse `json:"policy"`
+	ActiveProposalEvents  int                 `json:"activeProposalEvents"`
+	ActiveHelpEvents      int                 `json:"activeHelpEvents"`
+	PendingResponses      int                 `json:"pendingResponses"`
+	CommentsInLast24Hours int                 `json:"commentsInLast24Hours"`
+}
+
+func (m MemberQuota) Bytes() []byte {
+	bytes, _ := json.Marshal(m)
+	return bytes
+}
@@ -0,0 +1,117 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrInvalidRecurrenceRule = errors.New("invalid recurrence rule")
+
+type RecurrenceFrequency string
+
+const (
+	Daily   RecurrenceFrequency = "DAILY"
+	Weekly  RecurrenceFrequency = "WEEKLY"
+	Monthly RecurrenceFrequency = "MONTHLY"
+)
+
+const recurrenceUntilLayout = "20060102T150405Z"
+
+// RecurrenceRule is the supported subset of iCalendar RRULE, e.g. "FREQ=WEEKLY;INTERVAL=1;COUNT=10".
+// Only FREQ, INTERVAL and one of COUNT or UNTIL are allowed.
+type RecurrenceRule struct {
+	Frequency RecurrenceFrequency
+	Interval  int
+	Count     int
+	Until     time.Time
+}
+
+func ParseRecurrenceRule(rule string) (RecurrenceRule, error) {
+	recurrenceRule := RecurrenceRule{Interval: 1}
+	rule = strings.TrimPrefix(strings.TrimSpace(rule), "RRULE:")
+	for _, part := range strings.Split(rule, ";") {
+		if part == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return RecurrenceRule{}, fmt.Errorf("%w: %s is not a key=value pair", ErrInvalidRecurrenceRule, part)
+		}
+
+		var err error
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			recurrenceRule.Frequency = RecurrenceFrequency(strings.ToUpper(value))
+		case "INTERVAL":
+			recurrenceRule.Interval, err = strconv.Atoi(value)
+		case "COUNT":
+			recurrenceRule.Count, err = strconv.Atoi(value)
+		case "UNTIL":
+			recurrenceRule.Until, err = time.Parse(recurrenceUntilLayout, value)
+		default:
+			return RecurrenceRule{}, fmt.Errorf("%w: %s is not supported", ErrInvalidRecurrenceRule, key)
+		}
+		if err != nil {
+			return RecurrenceRule{}, fmt.Errorf("%w: %s", ErrInvalidRecurrenceRule, err)
+		}
+	}
+
+	switch recurrenceRule.Frequency {
+	case Daily, Weekly, Monthly:
+	default:
+		return RecurrenceRule{}, fmt.Errorf("%w: frequency should be one of %s, %s, %s",
+			ErrInvalidRecurrenceRule, Daily, Weekly, Monthly)
+	}
+	if recurrenceRule.Interval < 1 || recurrenceRule.Count < 0 {
+		return RecurrenceRule{}, fmt.Errorf("%w: interval and count should be positive", ErrInvalidRecurrenceRule)
+	}
+	if recurrenceRule.Count != 0 && !recurrenceRule.Until.IsZero() {
+		return RecurrenceRule{}, fmt.Errorf("%w: count and until cannot be used together", ErrInvalidRecurrenceRule)
+	}
+
+	return recurrenceRule, nil
+}
+
+func (r RecurrenceRule) String() string {
+	rule := fmt.Sprintf("FREQ=%s;INTERVAL=%d", r.Frequency, r.Interval)
+	if r.Count != 0 {
+		rule += fmt.Sprintf(";COUNT=%d", r.Count)
+	}
+	if !r.Until.IsZero() {
+		rule += fmt.Sprintf(";UNTIL=%s", r.Until.UTC().Format(recurrenceUntilLayout))
+	}
+
+	return rule
+}
+
+// Next returns the date of the occurrence that follows the given one.
+// Monthly occurrences skip months without the day of the current occurrence, as RRULE does.
+// The second value is false when the rule ends before the next occurrence.
+func (r RecurrenceRule) Next(current time.Time, occurrence int) (time.Time, bool) {
+	if r.Count != 0 && occurrence >= r.Count {
+		return time.Time{}, false
+	}
+
+	var next time.Time
+	switch r.Frequency {
+	case Daily:
+		next = current.AddDate(0, 0, r.Interval)
+	case Weekly:
+		next = current.AddDate(0, 0, 7*r.Interval)
+	case Monthly:
+		for step := 1; next.IsZero() || next.Day() != current.Day(); step++ {
+			next = current.AddDate(0, step*r.Interval, 0)
+		}
+	default:
+		return time.Time{}, false
+	}
+
+	if !r.Until.IsZero() && next.After(r.Until) {
+		return time.Time{}, false
+	}
+
+	return next, true
+}
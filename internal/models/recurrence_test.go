@@ -0,0 +1,63 @@
+package models_test
+
+import (
+	"Kurajj/internal/models"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestParseRecurrenceRule(t *testing.T) {
+	rule, err := models.ParseRecurrenceRule("RRULE:FREQ=MONTHLY;INTERVAL=2;UNTIL=20271231T000000Z")
+	assert.NoError(t, err)
+	assert.Equal(t, models.RecurrenceRule{
+		Frequency: models.Monthly,
+		Interval:  2,
+		Until:     time.Date(2027, 12, 31, 0, 0, 0, 0, time.UTC),
+	}, rule)
+
+	for _, invalidRule := range []string{
+		"FREQ=HOURLY",
+		"FREQ=DAILY;INTERVAL=0",
+		"FREQ=DAILY;COUNT=3;UNTIL=20271231T000000Z",
+		"FREQ=WEEKLY;BYDAY=SA",
+		"FREQ",
+	} {
+		_, err = models.ParseRecurrenceRule(invalidRule)
+		assert.ErrorIs(t, err, models.ErrInvalidRecurrenceRule, invalidRule)
+	}
+}
+
+func TestRecurrenceRuleNext(t *testing.T) {
+	rule := models.RecurrenceRule{Frequency: models.Monthly, Interval: 1}
+	next, ok := rule.Next(time.Date(2026, 1, 31, 9, 0, 0, 0, time.UTC), 1)
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2026, 3, 31, 9, 0, 0, 0, time.UTC), next)
+
+	rule = models.RecurrenceRule{Frequency: models.Weekly, Interval: 1, Count: 2}
+	next, ok = rule.Next(time.Date(2026, 10, 24, 9, 0, 0, 0, time.UTC), 1)
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2026, 10, 31, 9, 0, 0, 0, time.UTC), next)
+	_, ok = rule.Next(next, 2)
+	assert.False(t, ok)
+}
+
+func TestProposalEventNextOccurrence(t *testing.T) {
+	event := models.ProposalEvent{
+		EndDate:        time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC),
+		RecurrenceRule: "FREQ=DAILY;INTERVAL=1;UNTIL=20261005T000000Z",
+		Occurrence:     1,
+	}
+
+	occurrence, date, ok := event.NextOccurrence(time.Date(2026, 10, 3, 12, 0, 0, 0, time.UTC))
+	assert.True(t, ok)
+	assert.Equal(t, 4, occurrence)
+	assert.Equal(t, time.Date(2026, 10, 4, 9, 0, 0, 0, time.UTC), date)
+
+	_, _, ok = event.NextOccurrence(time.Date(2026, 10, 4, 12, 0, 0, 0, time.UTC))
+	assert.False(t, ok)
+
+	event.RecurrenceRule = ""
+	_, _, ok = event.NextOccurrence(event.EndDate)
+	assert.False(t, ok)
+}
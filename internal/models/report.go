@@ -0,0 +1,77 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"time"
+)
+
+var ErrInvalidReport = errors.New("invalid report")
+
+// Report is a file attached to a transaction by one of its participants, e.g. a photo of the delivered help.
+type Report struct {
+	ID                   uint      `gorm:"column:id"`
+	S3Path               string    `gorm:"column:s3_path"`
+	EventType            EventType `gorm:"column:event_type"`
+	TransactionID        uint      `gorm:"column:transaction_id"`
+	MemberID             uint      `gorm:"column:members_id"`
+	FileType             string    `gorm:"column:file_type"`
+	CreationDate         time.Time `gorm:"column:creation_date"`
+	TransactionCreatorID uint      `gorm:"->;column:transaction_creator_id"`
+}
+
+func (Report) TableName() string {
+	return "report"
+}
+
+type ReportCreateRequest struct {
+	FileBytes []byte `json:"fileBytes"`
+	FileType  string `json:"fileType"`
+	FilePath  string `json:"filePath"`
+}
+
+func UnmarshalReportCreateRequest(r *io.ReadCloser) (ReportCreateRequest, error) {
+	report := ReportCreateRequest{}
+	err := json.NewDecoder(*r).Decode(&report)
+	return report, err
+}
+
+type ReportResponse struct {
+	ID            uint      `json:"id"`
+	TransactionID uint      `json:"transactionID"`
+	EventType     EventType `json:"eventType"`
+	UploaderID    uint      `json:"uploaderID"`
+	FileType      string    `json:"fileType"`
+	URL           string    `json:"url"`
+	CreationDate  time.Time `json:"creationDate"`
+}
+
+type ReportsResponse struct {
+	Reports []ReportResponse `json:"reports"`
+}
+
+func (r ReportsResponse) Bytes() []byte {
+	bytes, _ := json.Marshal(r)
+	return bytes
+}
+
+func CreateReportsResponse(reports []Report) ReportsResponse {
+	response := ReportsResponse{
+		Reports: make([]ReportResponse, len(reports)),
+	}
+
+	for i, report := range reports {
+		response.Reports[i] = ReportResponse{
+			ID:            report.ID,
+			TransactionID: report.TransactionID,
+			EventType:     report.EventType,
+			UploaderID:    report.MemberID,
+			FileType:      report.FileType,
+			URL:           report.S3Path,
+			CreationDate:  report.CreationDate,
+		}
+	}
+
+	return response
+}
@@ -23,6 +23,11 @@ type AllEventsSearch struct {
 	PageNumber            int                 `json:"pageNumber"`
 	PageSize              int                 `json:"pageSize"`
 	AllowOnlyTitlesSearch bool                `json:"allowOnlyTitlesSearch"`
+	// Near is the center of the radius filter and of the distance sort.
+	Near     *models.GeoPoint `json:"near"`
+	RadiusKm float64          `json:"radiusKm"`
+	// Urgency filters help events by urgency.
+	Urgency []models.Urgency `json:"urgency"`
 }
 
 func (s AllEventsSearch) Internal() models.ProposalEventSearchInternal {
@@ -66,6 +71,9 @@ func (s AllEventsSearch) Internal() models.ProposalEventSearchInternal {
 		SortField:        s.SortField,
 		Location:         &location,
 		AllowTitleSearch: &s.AllowOnlyTitlesSearch,
+		Near:             s.Near,
+		RadiusKm:         s.RadiusKm,
+		Urgency:          s.Urgency,
 		Pagination: models.PaginationRequest{
 			PageSize:   s.PageSize,
 			PageNumber: s.PageNumber,
@@ -30,10 +30,24 @@ type ProposalEventStatistics struct {
 
 type HelpEventStatistics struct {
 	DefaultStatistics
+	// MoneyRaised sums the money received in the completed transactions by currency.
+	MoneyRaised []MoneyTotal `json:"moneyRaised"`
+}
+
+func (s HelpEventStatistics) Bytes() []byte {
+	bytes, _ := json.Marshal(s)
+	return bytes
 }
 
 type GlobalStatistics struct {
 	DefaultStatistics
+	// MoneyRaised sums the money received in the completed transactions by currency.
+	MoneyRaised []MoneyTotal `json:"moneyRaised"`
+}
+
+func (s GlobalStatistics) Bytes() []byte {
+	bytes, _ := json.Marshal(s)
+	return bytes
 }
 
 type Request struct {
@@ -2,9 +2,12 @@ package models
 
 import (
 	"encoding/json"
+	"errors"
 	"io"
 )
 
+var ErrInvalidTag = errors.New("invalid tag")
+
 func UnmarshalTagGroupCreateRequest(r *io.ReadCloser) (TagGroupRequestCreate, error) {
 	tags := TagGroupRequestCreate{}
 	err := json.NewDecoder(*r).Decode(&tags)
@@ -51,6 +54,30 @@ type Tags struct {
 	Tags []TagsResponse `json:"tags"`
 }
 
+func GenerateTagsResponse(tags []Tag) Tags {
+	tagsResponse := Tags{
+		Tags: make([]TagsResponse, len(tags)),
+	}
+	for i, t := range tags {
+		tagValues := make([]TagValueResponse, len(t.Values))
+		for j, tagValue := range t.Values {
+			tagValues[j] = TagValueResponse{
+				ID:    tagValue.ID,
+				Value: tagValue.Value,
+			}
+		}
+		tagsResponse.Tags[i] = TagsResponse{
+			ID:        t.ID,
+			Title:     t.Title,
+			EventID:   t.EventID,
+			EventType: t.EventType,
+			Values:    tagValues,
+		}
+	}
+
+	return tagsResponse
+}
+
 func (t Tags) Bytes() []byte {
 	bytes, _ := json.Marshal(t)
 	return bytes
@@ -0,0 +1,73 @@
+package models
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrInvalidTimeSlot = errors.New("invalid time slot")
+	ErrTimeSlotFull    = errors.New("there are no free places in this time slot")
+)
+
+// TimeSlot is a bookable period of a proposal event. Every responder books a single place of the slot.
+type TimeSlot struct {
+	ID        uint      `gorm:"column:id"`
+	EventID   uint      `gorm:"column:event_id"`
+	StartTime time.Time `gorm:"column:start_time"`
+	EndTime   time.Time `gorm:"column:end_time"`
+	Capacity  int       `gorm:"column:capacity"`
+	Booked    int       `gorm:"column:booked"`
+}
+
+func (TimeSlot) TableName() string {
+	return "proposal_event_time_slot"
+}
+
+func (t TimeSlot) Available() int {
+	if t.Booked >= t.Capacity {
+		return 0
+	}
+	return t.Capacity - t.Booked
+}
+
+type TimeSlotRequest struct {
+	StartTime time.Time `json:"startTime"`
+	EndTime   time.Time `json:"endTime"`
+	Capacity  int       `json:"capacity"`
+}
+
+func (t TimeSlotRequest) Internal() TimeSlot {
+	return TimeSlot{
+		StartTime: t.StartTime,
+		EndTime:   t.EndTime,
+		Capacity:  t.Capacity,
+	}
+}
+
+type TimeSlotResponse struct {
+	ID        uint      `json:"id"`
+	StartTime time.Time `json:"startTime"`
+	EndTime   time.Time `json:"endTime"`
+	Capacity  int       `json:"capacity"`
+	Available int       `json:"available"`
+}
+
+func (t TimeSlot) Response() TimeSlotResponse {
+	return TimeSlotResponse{
+		ID:        t.ID,
+		StartTime: t.StartTime,
+		EndTime:   t.EndTime,
+		Capacity:  t.Capacity,
+		Available: t.Available(),
+	}
+}
+
+// TimeSlotBooking is a booked place of a time slot which participants are reminded about.
+type TimeSlotBooking struct {
+	TransactionID uint      `gorm:"column:transaction_id"`
+	EventID       uint      `gorm:"column:event_id"`
+	ResponderID   uint      `gorm:"column:responder_id"`
+	AuthorID      uint      `gorm:"column:author_id"`
+	StartTime     time.Time `gorm:"column:start_time"`
+}
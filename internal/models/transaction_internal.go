@@ -8,20 +8,29 @@ import (
 )
 
 type Transaction struct {
-	ID                    uint              `gorm:"primaryKey"`
-	CreatorID             uint              `gorm:"column:creator_id"`
-	Creator               User              `gorm:"-"`
-	Responder             User              `gorm:"-"`
-	CompetitionDate       sql.NullTime      `gorm:"column:completion_date"`
-	EventID               uint              `gorm:"column:event_id"`
-	Comment               string            `gorm:"column:comment"`
-	CreationDate          time.Time         `gorm:"column:creation_date"`
-	EventType             EventType         `gorm:"column:event_type"`
-	TransactionStatus     TransactionStatus `gorm:"column:transaction_status"`
-	ResponderStatus       TransactionStatus `gorm:"column:responder_status"`
-	ReportURL             string            `gorm:"column:report_url"`
-	Needs                 []Need            `gorm:"-"`
-	CompletionPercentages int               `gorm:"-"`
+	ID                   uint                 `gorm:"primaryKey"`
+	CreatorID            uint                 `gorm:"column:creator_id"`
+	Creator              User                 `gorm:"-"`
+	Responder            User                 `gorm:"-"`
+	CompetitionDate      sql.NullTime         `gorm:"column:completion_date"`
+	EventID              uint                 `gorm:"column:event_id"`
+	Comment              string               `gorm:"column:comment"`
+	CreationDate         time.Time            `gorm:"column:creation_date"`
+	EventType            EventType            `gorm:"column:event_type"`
+	TransactionStatus    TransactionStatus    `gorm:"column:transaction_status"`
+	ResponderStatus      TransactionStatus    `gorm:"column:responder_status"`
+	ReportURL            string               `gorm:"column:report_url"`
+	SlotReleased         bool                 `gorm:"column:slot_released"`
+	Occurrence           int                  `gorm:"column:occurrence;default:1"`
+	TimeSlotID           sql.NullInt64        `gorm:"column:time_slot_id"`
+	TimeSlotReminded     bool                 `gorm:"column:time_slot_reminded"`
+	QuestionnaireAnswers QuestionnaireAnswers `gorm:"column:questionnaire_answers"`
+	// DropPointID is the help event point the responder chose to come to during the arrival window.
+	DropPointID           sql.NullInt64 `gorm:"column:drop_point_id"`
+	ArrivalStart          sql.NullTime  `gorm:"column:arrival_start"`
+	ArrivalEnd            sql.NullTime  `gorm:"column:arrival_end"`
+	Needs                 []Need        `gorm:"-"`
+	CompletionPercentages int           `gorm:"-"`
 }
 
 func (t *Transaction) UpdateStatus(transactionCreator bool, newStatus TransactionStatus) {
@@ -0,0 +1,111 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+)
+
+var ErrInvalidUnit = errors.New("invalid unit")
+
+type Dimension string
+
+const (
+	Mass   Dimension = "mass"
+	Volume Dimension = "volume"
+	Count  Dimension = "count"
+	Time   Dimension = "time"
+	Money  Dimension = "money"
+)
+
+// UnitDefinition is a unit of the registry. Factor converts an amount in the unit to the base unit of its dimension.
+type UnitDefinition struct {
+	Code      Unit      `gorm:"column:code;primaryKey" json:"code"`
+	Title     string    `gorm:"column:title" json:"title"`
+	Dimension Dimension `gorm:"column:dimension" json:"dimension"`
+	Factor    float64   `gorm:"column:factor" json:"factor"`
+	IsBase    bool      `gorm:"column:is_base" json:"isBase"`
+}
+
+func (UnitDefinition) TableName() string {
+	return "unit"
+}
+
+type UnitsResponse struct {
+	Units []UnitDefinition `json:"units"`
+}
+
+func (u UnitsResponse) Bytes() []byte {
+	bytes, _ := json.Marshal(u)
+	return bytes
+}
+
+// UnitRegistry converts amounts between the units of the same dimension.
+type UnitRegistry struct {
+	units map[Unit]UnitDefinition
+	bases map[Dimension]Unit
+}
+
+func NewUnitRegistry(units []UnitDefinition) UnitRegistry {
+	registry := UnitRegistry{
+		units: make(map[Unit]UnitDefinition, len(units)),
+		bases: make(map[Dimension]Unit),
+	}
+	for _, unit := range units {
+		registry.units[unit.Code] = unit
+		if unit.IsBase {
+			registry.bases[unit.Dimension] = unit.Code
+		}
+	}
+
+	return registry
+}
+
+func (r UnitRegistry) unit(code Unit) (UnitDefinition, error) {
+	unit, ok := r.units[code]
+	if !ok || unit.Factor <= 0 {
+		return UnitDefinition{}, fmt.Errorf("%w: unknown unit %s", ErrInvalidUnit, code)
+	}
+
+	return unit, nil
+}
+
+// ToBase converts the amount to the base unit of the unit's dimension.
+func (r UnitRegistry) ToBase(amount float64, code Unit) (float64, Unit, error) {
+	unit, err := r.unit(code)
+	if err != nil {
+		return 0, "", err
+	}
+	base, ok := r.bases[unit.Dimension]
+	if !ok {
+		return 0, "", fmt.Errorf("%w: %s has no base unit", ErrInvalidUnit, unit.Dimension)
+	}
+
+	return roundAmount(amount * unit.Factor), base, nil
+}
+
+// Convert converts the amount between the units, an amount without a unit is already in the target unit.
+func (r UnitRegistry) Convert(amount float64, from, to Unit) (float64, error) {
+	if from == "" || from == to {
+		return amount, nil
+	}
+	fromUnit, err := r.unit(from)
+	if err != nil {
+		return 0, err
+	}
+	toUnit, err := r.unit(to)
+	if err != nil {
+		return 0, err
+	}
+	if fromUnit.Dimension != toUnit.Dimension {
+		return 0, fmt.Errorf("%w: %s cannot be converted to %s", ErrInvalidUnit, from, to)
+	}
+
+	return roundAmount(amount * fromUnit.Factor / toUnit.Factor), nil
+}
+
+// roundAmount drops the floating point noise of the conversions, so converted amounts still add up to the need.
+func roundAmount(amount float64) float64 {
+	return math.Round(amount*1e6) / 1e6
+}
@@ -0,0 +1,55 @@
+package models_test
+
+import (
+	"Kurajj/internal/models"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var registry = models.NewUnitRegistry([]models.UnitDefinition{
+	{Code: models.Kilogram, Dimension: models.Mass, Factor: 1, IsBase: true},
+	{Code: "gram", Dimension: models.Mass, Factor: 0.001},
+	{Code: models.Item, Dimension: models.Count, Factor: 1, IsBase: true},
+	{Code: "dozen", Dimension: models.Count, Factor: 12},
+})
+
+func TestUnitRegistryToBase(t *testing.T) {
+	amount, unit, err := registry.ToBase(500, "gram")
+	assert.NoError(t, err)
+	assert.Equal(t, 0.5, amount)
+	assert.Equal(t, models.Unit(models.Kilogram), unit)
+
+	_, _, err = registry.ToBase(1, "bag")
+	assert.ErrorIs(t, err, models.ErrInvalidUnit)
+}
+
+func TestUnitRegistryConvert(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount float64
+		from   models.Unit
+		to     models.Unit
+		want   float64
+		err    error
+	}{
+		{name: "same unit", amount: 3, from: models.Item, to: models.Item, want: 3},
+		{name: "no unit", amount: 3, to: models.Item, want: 3},
+		{name: "to base", amount: 2, from: "dozen", to: models.Item, want: 24},
+		{name: "from base", amount: 6, from: models.Item, to: "dozen", want: 0.5},
+		{name: "rounds noise", amount: 300, from: "gram", to: models.Kilogram, want: 0.3},
+		{name: "other dimension", amount: 1, from: "gram", to: models.Item, err: models.ErrInvalidUnit},
+		{name: "unknown unit", amount: 1, from: "bag", to: models.Item, err: models.ErrInvalidUnit},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := registry.Convert(tt.amount, tt.from, tt.to)
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrInvalidUrgency = errors.New("invalid urgency")
+
+// Urgency of a help event or of its need, it is stored as the priority_rate enum.
+type Urgency string
+
+const (
+	NormalUrgency   Urgency = "normal"
+	FastUrgency     Urgency = "fast"
+	VeryFastUrgency Urgency = "very fast"
+)
+
+// UrgencySortField sorts help events by their urgency blended with the time left until their end date.
+const UrgencySortField = "urgency"
+
+// Validate accepts an empty urgency, it means the urgency is not set or not changed.
+func (u Urgency) Validate() error {
+	switch u {
+	case "", NormalUrgency, FastUrgency, VeryFastUrgency:
+		return nil
+	default:
+		return fmt.Errorf("%w: %q should be one of %s, %s, %s", ErrInvalidUrgency, u,
+			NormalUrgency, FastUrgency, VeryFastUrgency)
+	}
+}
+
+func ValidateUrgencies(urgencies []Urgency) error {
+	for _, urgency := range urgencies {
+		if urgency == "" {
+			return fmt.Errorf("%w: urgency is empty", ErrInvalidUrgency)
+		}
+		err := urgency.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// NeedUrgencyRequest changes the urgency of a need of the help event.
+type NeedUrgencyRequest struct {
+	NeedID  uint    `json:"needID"`
+	Urgency Urgency `json:"urgency"`
+}
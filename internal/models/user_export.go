@@ -98,6 +98,8 @@ type Address struct {
 	Country      string    `json:"-" gorm:"column:country"`
 	EventType    EventType `json:"-" gorm:"column:event_type"`
 	EventID      uint      `json:"-" gorm:"column:event_id"`
+	Latitude     *float64  `json:"latitude,omitempty" gorm:"column:latitude"`
+	Longitude    *float64  `json:"longitude,omitempty" gorm:"column:longitude"`
 }
 
 func (a Address) Values() string {
@@ -109,7 +111,7 @@ func (Address) TableName() string {
 }
 
 func (a Address) IsEmpty() bool {
-	return a.Region == "" && a.City == "" && a.District == "" && a.HomeLocation == ""
+	return a.Region == "" && a.City == "" && a.District == "" && a.HomeLocation == "" && !a.HasCoordinates()
 }
 
 func (a Address) String() string {
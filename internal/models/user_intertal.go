@@ -20,6 +20,7 @@ type User struct {
 	Telephone               string                    `gorm:"column:telephone"`
 	CompanyName             string                    `gorm:"column:company_name"`
 	IsAdmin                 bool                      `gorm:"column:is_admin"`
+	IsVerifiedOrganization  bool                      `gorm:"column:is_verified_organization"`
 	Password                string                    `gorm:"column:password"`
 	Address                 string                    `gorm:"column:address"`
 	IsDeleted               bool                      `gorm:"column:is_deleted"`
@@ -0,0 +1,96 @@
+package models
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+var ErrNoFreeSlots = errors.New("there are no free slots in this event")
+
+var ErrCapacityBelowTakenSlots = errors.New("capacity can't be lower than the number of taken slots")
+
+type WaitlistStatus string
+
+const (
+	WaitlistWaiting   WaitlistStatus = "waiting"
+	WaitlistPromoted  WaitlistStatus = "promoted"
+	WaitlistConfirmed WaitlistStatus = "confirmed"
+	WaitlistExpired   WaitlistStatus = "expired"
+	WaitlistLeft      WaitlistStatus = "left"
+)
+
+type WaitlistEntry struct {
+	ID              uint                 `gorm:"column:id"`
+	EventID         uint                 `gorm:"column:event_id"`
+	MemberID        uint                 `gorm:"column:member_id"`
+	Comment         string               `gorm:"column:comment"`
+	Status          WaitlistStatus       `gorm:"column:status"`
+	CreationDate    time.Time            `gorm:"column:creation_date"`
+	PromotedAt      sql.NullTime         `gorm:"column:promoted_at"`
+	ConfirmDeadline sql.NullTime         `gorm:"column:confirm_deadline"`
+	Answers         QuestionnaireAnswers `gorm:"column:questionnaire_answers"`
+	User            UserShortInfo        `gorm:"-"`
+}
+
+func (WaitlistEntry) TableName() string {
+	return "proposal_event_waitlist"
+}
+
+type WaitlistEntryResponse struct {
+	ID              uint           `json:"id"`
+	Position        int            `json:"position"`
+	Comment         string         `json:"comment"`
+	Status          WaitlistStatus `json:"status"`
+	CreationDate    time.Time      `json:"creationDate"`
+	ConfirmDeadline *time.Time     `json:"confirmDeadline,omitempty"`
+	User            UserShortInfo  `json:"user"`
+}
+
+type WaitlistResponse struct {
+	Entries []WaitlistEntryResponse `json:"entries"`
+}
+
+func (w WaitlistResponse) Bytes() []byte {
+	bytes, _ := json.Marshal(w)
+	return bytes
+}
+
+func CreateWaitlistResponse(entries []WaitlistEntry) WaitlistResponse {
+	response := WaitlistResponse{
+		Entries: make([]WaitlistEntryResponse, len(entries)),
+	}
+
+	for i, entry := range entries {
+		response.Entries[i] = entry.Response(i + 1)
+	}
+
+	return response
+}
+
+func (w WaitlistEntry) Response(position int) WaitlistEntryResponse {
+	response := WaitlistEntryResponse{
+		ID:           w.ID,
+		Position:     position,
+		Comment:      w.Comment,
+		Status:       w.Status,
+		CreationDate: w.CreationDate,
+		User:         w.User,
+	}
+	if w.ConfirmDeadline.Valid {
+		response.ConfirmDeadline = &w.ConfirmDeadline.Time
+	}
+
+	return response
+}
+
+type WaitlistJoinResponse struct {
+	ID       uint `json:"id"`
+	Position int  `json:"position"`
+}
+
+func (w WaitlistJoinResponse) Bytes() []byte {
+	bytes, _ := json.Marshal(w)
+	return bytes
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"io"
+	"net/url"
+	"strings"
 )
 
 type AWSConfig struct {
@@ -25,8 +27,27 @@ func NewFile(config AWSConfig) *AWSFile {
 	return &AWSFile{config: config}
 }
 
+// Get downloads the file by its key or by the URL returned from Upload.
 func (f *AWSFile) Get(ctx context.Context, identifier string) (io.Reader, error) {
-	return nil, nil
+	awsSession, err := f.connectToAWS()
+	if err != nil {
+		return nil, err
+	}
+
+	key := identifier
+	if fileURL, err := url.Parse(identifier); err == nil && fileURL.Host != "" {
+		key = strings.TrimPrefix(fileURL.Path, "/")
+	}
+
+	object, err := s3.New(awsSession).GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(f.config.BucketName),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return object.Body, nil
 }
 
 func (f *AWSFile) Upload(ctx context.Context, fileName string, fileData io.Reader) (string, error) {
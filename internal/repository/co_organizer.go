@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"Kurajj/internal/models"
+	"context"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func NewCoOrganizer(db *Connector) *CoOrganizer {
+	return &CoOrganizer{db}
+}
+
+type CoOrganizer struct {
+	*Connector
+}
+
+// AddCoOrganizer adds the co-organizer to the event, adding them again changes their role.
+func (c *CoOrganizer) AddCoOrganizer(ctx context.Context, coOrganizer models.EventCoOrganizer) error {
+	return c.DB.
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "event_id"}, {Name: "event_type"}, {Name: "member_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"role"}),
+		}).
+		Create(&coOrganizer).
+		WithContext(ctx).
+		Error
+}
+
+func (c *CoOrganizer) RemoveCoOrganizer(ctx context.Context, eventID uint, eventType models.EventType, memberID uint) error {
+	result := c.DB.
+		WithContext(ctx).
+		Where("event_id = ?", eventID).
+		Where("event_type = ?", eventType).
+		Where("member_id = ?", memberID).
+		Delete(&models.EventCoOrganizer{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return models.ErrNotFound
+	}
+
+	return nil
+}
+
+func (c *CoOrganizer) GetEventCoOrganizers(ctx context.Context, eventID uint, eventType models.EventType) ([]models.EventCoOrganizer, error) {
+	return getCoOrganizers(ctx, c.DB, eventID, eventType)
+}
+
+// getCoOrganizers returns the co-organizers of the event in the order they were added.
+func getCoOrganizers(ctx context.Context, db *gorm.DB, eventID uint, eventType models.EventType) ([]models.EventCoOrganizer, error) {
+	coOrganizers := make([]models.EventCoOrganizer, 0)
+	err := db.
+		Where("event_id = ?", eventID).
+		Where("event_type = ?", eventType).
+		Order("creation_date, member_id").
+		Find(&coOrganizers).
+		WithContext(ctx).
+		Error
+	return coOrganizers, err
+}
@@ -159,8 +159,6 @@ func (c *Complaint) BanEvent(ctx context.Context, eventID models.ID, eventType m
 	default:
 		return fmt.Errorf("no event type with %s name", eventType)
 	}
-
-	return nil
 }
 
 func (c *Complaint) banHelpEvent(ctx context.Context, eventID models.ID) error {
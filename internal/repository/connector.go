@@ -9,6 +9,9 @@ import (
 
 type Connector struct {
 	DB *gorm.DB
+	// postgis tells whether distances are computed with PostGIS. The earthdistance extension
+	// created by the migrations is used when PostGIS is not installed.
+	postgis bool
 }
 
 func NewConnector(config configs.DB) (*Connector, error) {
@@ -18,5 +21,14 @@ func NewConnector(config configs.DB) (*Connector, error) {
 		return nil, fmt.Errorf("could not connect to postgres err: %s\nconfig: %s", err, config)
 	}
 
-	return &Connector{DB: db}, nil
+	connector := &Connector{DB: db}
+	err = db.
+		Raw("SELECT EXISTS(SELECT 1 FROM pg_extension WHERE extname = 'postgis')").
+		Scan(&connector.postgis).
+		Error
+	if err != nil {
+		return nil, fmt.Errorf("could not check postgis extension err: %s", err)
+	}
+
+	return connector, nil
 }
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"Kurajj/internal/models"
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"time"
+)
+
+func NewDropPoint(db *Connector) *DropPoint {
+	return &DropPoint{db}
+}
+
+type DropPoint struct {
+	*Connector
+}
+
+func (d *DropPoint) CreateDropPoint(ctx context.Context, point models.DropPoint) (uint, error) {
+	err := d.DB.Create(&point).WithContext(ctx).Error
+	return point.ID, err
+}
+
+// DeleteDropPoint hides the point of the help event, transactions keep referring to it.
+func (d *DropPoint) DeleteDropPoint(ctx context.Context, eventID, id uint) error {
+	result := d.DB.
+		WithContext(ctx).
+		Model(&models.DropPoint{}).
+		Where("id = ?", id).
+		Where("help_event_id = ?", eventID).
+		Where("is_deleted = ?", false).
+		UpdateColumn("is_deleted", true)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return models.ErrNotFound
+	}
+
+	return nil
+}
+
+// GetDropPointArrivals returns responders of unfinished transactions whose arrival window at the point
+// starts within [from, to), the earliest first.
+func (d *DropPoint) GetDropPointArrivals(ctx context.Context, pointID uint, from, to time.Time) ([]models.DropPointArrival, error) {
+	arrivals := make([]models.DropPointArrival, 0)
+	err := d.DB.
+		Table("transaction").
+		Select("transaction.id AS transaction_id, transaction.creator_id AS responder_id, "+
+			"transaction.arrival_start, transaction.arrival_end, transaction.transaction_status").
+		Where("transaction.event_type = ?", models.HelpEventType).
+		Where("transaction.drop_point_id = ?", pointID).
+		Not("transaction.transaction_status IN (?)", models.FinishedTransactionStatuses).
+		Where("transaction.arrival_start >= ?", from).
+		Where("transaction.arrival_start < ?", to).
+		Order("transaction.arrival_start, transaction.id").
+		Scan(&arrivals).
+		WithContext(ctx).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	for i, arrival := range arrivals {
+		responder := models.User{}
+		err = d.DB.Where("id = ?", arrival.ResponderID).First(&responder).WithContext(ctx).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+		arrivals[i].Responder = responder
+		needs := make([]models.Need, 0)
+		err = d.DB.Where("transaction_id = ?", arrival.TransactionID).Find(&needs).WithContext(ctx).Error
+		if err != nil {
+			return nil, err
+		}
+		arrivals[i].Needs = needs
+	}
+
+	return arrivals, nil
+}
+
+// getDropPoints returns the points of the help event which are not deleted.
+func getDropPoints(ctx context.Context, db *gorm.DB, eventID uint) ([]models.DropPoint, error) {
+	points := make([]models.DropPoint, 0)
+	err := db.
+		Where("help_event_id = ?", eventID).
+		Where("is_deleted = ?", false).
+		Order("id").
+		Find(&points).
+		WithContext(ctx).
+		Error
+	return points, err
+}
@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"Kurajj/internal/models"
+	"context"
+	"errors"
+	"gorm.io/gorm"
+)
+
+func NewEventTemplate(db *Connector) *EventTemplate {
+	return &EventTemplate{db}
+}
+
+type EventTemplate struct {
+	*Connector
+}
+
+func (e *EventTemplate) CreateEventTemplate(ctx context.Context, template models.EventTemplate) (uint, error) {
+	err := e.DB.Create(&template).WithContext(ctx).Error
+	return template.ID, err
+}
+
+func (e *EventTemplate) GetMemberEventTemplates(ctx context.Context, memberID uint) ([]models.EventTemplate, error) {
+	templates := make([]models.EventTemplate, 0)
+	err := e.DB.
+		Where("member_id = ?", memberID).
+		Order("name, id").
+		Find(&templates).
+		WithContext(ctx).
+		Error
+	return templates, err
+}
+
+func (e *EventTemplate) GetEventTemplateByID(ctx context.Context, id uint) (models.EventTemplate, error) {
+	template := models.EventTemplate{}
+	err := e.DB.
+		Where("id = ?", id).
+		First(&template).
+		WithContext(ctx).
+		Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return models.EventTemplate{}, models.ErrNotFound
+	}
+
+	return template, err
+}
+
+func (e *EventTemplate) DeleteEventTemplate(ctx context.Context, id uint) error {
+	return e.DB.
+		Where("id = ?", id).
+		Delete(&models.EventTemplate{}).
+		WithContext(ctx).
+		Error
+}
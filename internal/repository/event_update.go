@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"Kurajj/internal/models"
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func NewEventUpdate(db *Connector) *EventUpdate {
+	return &EventUpdate{db}
+}
+
+type EventUpdate struct {
+	*Connector
+}
+
+func (e *EventUpdate) CreateEventUpdate(ctx context.Context, update models.EventUpdate) (uint, error) {
+	err := e.DB.Create(&update).WithContext(ctx).Error
+	return update.ID, err
+}
+
+func (e *EventUpdate) GetEventUpdates(ctx context.Context, eventID uint, eventType models.EventType) ([]models.EventUpdate, error) {
+	return getEventUpdates(ctx, e.DB, eventID, eventType)
+}
+
+func (e *EventUpdate) GetEventUpdateByID(ctx context.Context, id uint) (models.EventUpdate, error) {
+	update := models.EventUpdate{}
+	err := e.DB.Where("id = ?", id).First(&update).WithContext(ctx).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return models.EventUpdate{}, models.ErrNotFound
+	}
+
+	return update, err
+}
+
+func (e *EventUpdate) DeleteEventUpdate(ctx context.Context, id uint) error {
+	return e.DB.Where("id = ?", id).Delete(&models.EventUpdate{}).WithContext(ctx).Error
+}
+
+// FollowEvent subscribes the member to the event updates, following the event twice changes nothing.
+func (e *EventUpdate) FollowEvent(ctx context.Context, follower models.EventFollower) error {
+	return e.DB.
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&follower).
+		WithContext(ctx).
+		Error
+}
+
+func (e *EventUpdate) UnfollowEvent(ctx context.Context, eventID uint, eventType models.EventType, memberID uint) error {
+	return e.DB.
+		Where("event_id = ?", eventID).
+		Where("event_type = ?", eventType).
+		Where("member_id = ?", memberID).
+		Delete(&models.EventFollower{}).
+		WithContext(ctx).
+		Error
+}
+
+func (e *EventUpdate) GetEventFollowers(ctx context.Context, eventID uint, eventType models.EventType) ([]uint, error) {
+	memberIDs := make([]uint, 0)
+	err := e.DB.
+		Model(&models.EventFollower{}).
+		Where("event_id = ?", eventID).
+		Where("event_type = ?", eventType).
+		Order("creation_date, member_id").
+		Pluck("member_id", &memberIDs).
+		WithContext(ctx).
+		Error
+	return memberIDs, err
+}
+
+// getEventUpdates returns the updates of the event, the oldest first.
+func getEventUpdates(ctx context.Context, db *gorm.DB, eventID uint, eventType models.EventType) ([]models.EventUpdate, error) {
+	updates := make([]models.EventUpdate, 0)
+	err := db.
+		Where("event_id = ?", eventID).
+		Where("event_type = ?", eventType).
+		Order("creation_date, id").
+		Find(&updates).
+		WithContext(ctx).
+		Error
+	return updates, err
+}
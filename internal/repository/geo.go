@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"Kurajj/internal/models"
+	"fmt"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"strings"
+)
+
+// distance returns the distance in meters between the location row and the point.
+func (c *Connector) distance(point models.GeoPoint) clause.Expr {
+	if c.postgis {
+		return clause.Expr{
+			SQL:  "ST_Distance(ST_MakePoint(location.longitude, location.latitude)::geography, ST_MakePoint(?, ?)::geography)",
+			Vars: []any{point.Longitude, point.Latitude},
+		}
+	}
+
+	return clause.Expr{
+		SQL:  "earth_distance(ll_to_earth(location.latitude, location.longitude), ll_to_earth(?, ?))",
+		Vars: []any{point.Latitude, point.Longitude},
+	}
+}
+
+// within keeps the location rows which are at most radius meters away from the point.
+func (c *Connector) within(point models.GeoPoint, radius float64) clause.Expr {
+	if c.postgis {
+		return clause.Expr{
+			SQL:  "ST_DWithin(ST_MakePoint(location.longitude, location.latitude)::geography, ST_MakePoint(?, ?)::geography, ?)",
+			Vars: []any{point.Longitude, point.Latitude, radius},
+		}
+	}
+
+	// earth_box is a cheap square check which can use the index, it is refined by the exact distance.
+	distance := c.distance(point)
+	return clause.Expr{
+		SQL:  "earth_box(ll_to_earth(?, ?), ?) @> ll_to_earth(location.latitude, location.longitude) AND " + distance.SQL + " <= ?",
+		Vars: append([]any{point.Latitude, point.Longitude, radius}, append(distance.Vars, radius)...),
+	}
+}
+
+// eventsWithin returns a subquery of ids of events of the type which are at most radiusKm away from the point.
+func (c *Connector) eventsWithin(eventType models.EventType, point models.GeoPoint, radiusKm float64) *gorm.DB {
+	return c.DB.Table("location").Select("event_id").
+		Where("event_type = ? AND latitude IS NOT NULL AND longitude IS NOT NULL", eventType).
+		Where(c.within(point, radiusKm*1000))
+}
+
+// orderByDistance sorts events of the table by the distance of their location from the point,
+// events without coordinates go last.
+func (c *Connector) orderByDistance(eventType models.EventType, table string,
+	point models.GeoPoint, order models.Order) clause.OrderBy {
+	distance := c.distance(point)
+	return clause.OrderBy{
+		Expression: clause.Expr{
+			SQL: fmt.Sprintf("(SELECT %s FROM location WHERE location.event_type = ? AND location.event_id = %s.id LIMIT 1) %s NULLS LAST",
+				distance.SQL, table, strings.ToUpper(string(order))),
+			Vars:               append(distance.Vars, eventType),
+			WithoutParentheses: true,
+		},
+	}
+}
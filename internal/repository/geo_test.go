@@ -0,0 +1,64 @@
+package repository_test
+
+import (
+	"Kurajj/internal/models"
+	"Kurajj/internal/repository"
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestProposalEventsRadiusSearch(t *testing.T) {
+	connector := newTestConnector(t)
+	proposalEventRepository := repository.NewProposalEvent(repository.AWSConfig{}, connector)
+	ctx := context.Background()
+
+	member := models.User{}
+	err := connector.DB.Raw("INSERT INTO members (full_name) VALUES (?) RETURNING id", "geo test").
+		Scan(&member.ID).Error
+	require.NoError(t, err)
+
+	eventIDs := map[string]uint{}
+	for _, city := range []string{"Lviv", "Kyiv"} {
+		var id uint
+		err = connector.DB.Raw(`INSERT INTO propositional_event (title, status, max_concurrent_requests, remaining_helps, author_id, is_deleted)
+			VALUES (?, ?, 1, 1, ?, false) RETURNING id`, "geo test "+city, models.Active, member.ID).
+			Scan(&id).Error
+		require.NoError(t, err)
+		location := models.Address{City: city, EventType: models.ProposalEventType, EventID: id}
+		require.NoError(t, location.Locate())
+		require.NoError(t, connector.DB.Create(&location).Error)
+		eventIDs[city] = id
+	}
+
+	t.Cleanup(func() {
+		for _, id := range eventIDs {
+			connector.DB.Exec("DELETE FROM location WHERE event_id = ? AND event_type = ?", id, models.ProposalEventType)
+			connector.DB.Exec("DELETE FROM propositional_event WHERE id = ?", id)
+		}
+		connector.DB.Exec("DELETE FROM members WHERE id = ?", member.ID)
+	})
+
+	name := "geo test"
+	lviv := models.GeoPoint{Latitude: 49.84, Longitude: 24.03}
+	events, err := proposalEventRepository.GetProposalEventsWithSearchAndSort(ctx, models.ProposalEventSearchInternal{
+		Name:     &name,
+		Near:     &lviv,
+		RadiusKm: 50,
+	})
+	require.NoError(t, err)
+	require.Len(t, events.Events, 1)
+	assert.Equal(t, eventIDs["Lviv"], events.Events[0].ID)
+
+	kyiv := models.GeoPoint{Latitude: 50.45, Longitude: 30.52}
+	events, err = proposalEventRepository.GetProposalEventsWithSearchAndSort(ctx, models.ProposalEventSearchInternal{
+		Name:      &name,
+		Near:      &kyiv,
+		SortField: models.DistanceSortField,
+	})
+	require.NoError(t, err)
+	require.Len(t, events.Events, 2)
+	assert.Equal(t, eventIDs["Kyiv"], events.Events[0].ID)
+	assert.Equal(t, eventIDs["Lviv"], events.Events[1].ID)
+}
@@ -24,7 +24,37 @@ type HelpEvent struct {
 
 func (h *HelpEvent) GetAllHelpEvents(ctx context.Context) ([]models.HelpEvent, error) {
 	events := make([]models.HelpEvent, 0)
-	err := h.DB.Find(&events).WithContext(ctx).Error
+	err := h.DB.Where("is_deleted = ?", false).Find(&events).WithContext(ctx).Error
+	return events, err
+}
+
+// GetExpiredHelpEvents returns up to limit active events with id greater than afterID
+// whose end date is not later than now. Only the event rows are loaded.
+func (h *HelpEvent) GetExpiredHelpEvents(ctx context.Context, now time.Time, afterID models.ID, limit int) ([]models.HelpEvent, error) {
+	events := make([]models.HelpEvent, 0)
+	err := h.DB.
+		Where("status = ?", models.Active).
+		Where("is_deleted = ?", false).
+		Where("end_date <= ?", now).
+		Where("id > ?", afterID).
+		Order("id").
+		Limit(limit).
+		Find(&events).
+		WithContext(ctx).
+		Error
+	return events, err
+}
+
+// GetScheduledHelpEvents returns drafts whose publish date is not later than now.
+func (h *HelpEvent) GetScheduledHelpEvents(ctx context.Context, now time.Time) ([]models.HelpEvent, error) {
+	events := make([]models.HelpEvent, 0)
+	err := h.DB.
+		Where("status = ?", models.InActive).
+		Where("is_deleted = ?", false).
+		Where("publish_at <= ?", now).
+		Find(&events).
+		WithContext(ctx).
+		Error
 	return events, err
 }
 
@@ -35,6 +65,7 @@ func (h *HelpEvent) GetHelpEventStatistics(ctx context.Context, creatorID uint,
 		Where("creator_id IN (?)", creatorID).
 		Where("creation_date >= ? AND creation_date <= ?",
 			from, to).
+		Not("event_id IN (?)", h.DB.Model(&models.HelpEvent{}).Select("id").Where("is_deleted = ?", true)).
 		Find(&transactions).
 		WithContext(ctx).
 		Error
@@ -47,20 +78,55 @@ func (h *HelpEvent) GetHelpEventStatistics(ctx context.Context, creatorID uint,
 	return transactions, err
 }
 
+// GetMoneyRaised sums the money received in the completed help transactions created in the period by currency.
+// Transactions of all members are summed when creatorID is zero.
+func (h *HelpEvent) GetMoneyRaised(ctx context.Context, creatorID uint, from, to time.Time) ([]models.MoneyTotal, error) {
+	totals := make([]models.MoneyTotal, 0)
+	query := h.DB.WithContext(ctx).
+		Table("need").
+		Select("need.currency, SUM(need.received_minor)::bigint AS amount").
+		Joins("JOIN transaction ON transaction.id = need.transaction_id").
+		Joins("JOIN help_event ON help_event.id = transaction.event_id").
+		Where("help_event.is_deleted = ?", false).
+		Where("need.type = ?", models.MoneyNeed).
+		Where("transaction.event_type = ? AND transaction.transaction_status = ?",
+			models.HelpEventType, models.Completed).
+		Where("transaction.creation_date >= ? AND transaction.creation_date <= ?", from, to)
+	if creatorID != 0 {
+		query = query.Where("transaction.creator_id = ?", creatorID)
+	}
+
+	err := query.
+		Group("need.currency").
+		Order("need.currency").
+		Scan(&totals).
+		Error
+	return totals, err
+}
+
 func (h *HelpEvent) GetTransactionNeeds(ctx context.Context, transactionID models.ID) ([]models.Need, error) {
 	needs := make([]models.Need, 0)
 	err := h.DB.Where("transaction_id = ?", transactionID).Find(&needs).WithContext(ctx).Error
 	return needs, err
 }
 
-func (h *HelpEvent) GetHelpEventsWithSearchAndSort(ctx context.Context,
-	searchValues models.HelpSearchInternal) (models.HelpEventPagination, error) {
+// GetHelpEventsWithSearchAndSort finds the published help events, the urgency order counts the days left from now.
+func (h *HelpEvent) GetHelpEventsWithSearchAndSort(ctx context.Context, searchValues models.HelpSearchInternal,
+	now time.Time) (models.HelpEventPagination, error) {
 	db := h.DB.Session(&gorm.Session{})
 	events := make([]models.HelpEvent, 0)
 	searchValues = h.removeEmptySearchValues(searchValues)
 	query := db.
-		Order(fmt.Sprintf("help_event.%s %s", searchValues.SortField, strings.ToUpper(string(*searchValues.Order)))).
-		Where("status IN (?)", searchValues.State)
+		Where("status IN (?)", searchValues.State).
+		Where("help_event.is_deleted = ?", false).
+		Not("help_event.status = ?", models.InActive)
+	if searchValues.SortField == models.DistanceSortField {
+		query = query.Order(h.Connector.orderByDistance(models.HelpEventType, "help_event", *searchValues.Near, *searchValues.Order))
+	} else if searchValues.SortField == models.UrgencySortField {
+		query = query.Order(orderByUrgency(*searchValues.Order, now))
+	} else {
+		query = query.Order(fmt.Sprintf("help_event.%s %s", searchValues.SortField, strings.ToUpper(string(*searchValues.Order))))
+	}
 	query = query.Debug()
 
 	if searchValues.Name != nil && *searchValues.Name != "" {
@@ -69,12 +135,16 @@ func (h *HelpEvent) GetHelpEventsWithSearchAndSort(ctx context.Context,
 	if searchValues.SearcherID != nil {
 		query = query.Not("help_event.created_by = ?", searchValues.SearcherID)
 	}
+	if searchValues.CreatorID != nil {
+		query = query.Where("help_event.created_by = ?", *searchValues.CreatorID)
+	}
 
 	if searchValues.TakingPart != nil && searchValues.SearcherID != nil {
 		if *searchValues.TakingPart {
-			query = query.Joins("LEFT JOIN transaction ON help_event.id = transaction.event_id").
-				Where("transaction.creator_id = ?", searchValues.SearcherID).
-				Distinct()
+			subQuery := db.Table("transaction").Select("event_id").
+				Where("creator_id = ? AND event_type = ?", searchValues.SearcherID, models.HelpEventType)
+
+			query = query.Where("help_event.id IN (?)", subQuery)
 		}
 	}
 
@@ -114,6 +184,14 @@ func (h *HelpEvent) GetHelpEventsWithSearchAndSort(ctx context.Context,
 		query = query.Where("help_event.id IN (?)", subQuery)
 	}
 
+	if searchValues.Near != nil && searchValues.RadiusKm > 0 {
+		query = query.Where("help_event.id IN (?)",
+			h.Connector.eventsWithin(models.HelpEventType, *searchValues.Near, searchValues.RadiusKm))
+	}
+	if len(searchValues.Urgency) != 0 {
+		query = query.Where(urgencyIn(searchValues.Urgency))
+	}
+
 	pagination, err := h.calculatePagination(ctx, searchValues, query)
 	if err != nil {
 		zlog.Log.Error(err, "could not calculate pagination value")
@@ -160,6 +238,7 @@ func (h *HelpEvent) removeEmptySearchValues(searchValues models.HelpSearchIntern
 	}
 
 	newSearchValues.SearcherID = searchValues.SearcherID
+	newSearchValues.CreatorID = searchValues.CreatorID
 
 	if searchValues.TakingPart != nil {
 		newSearchValues.TakingPart = searchValues.TakingPart
@@ -179,6 +258,12 @@ func (h *HelpEvent) removeEmptySearchValues(searchValues models.HelpSearchIntern
 	} else {
 		newSearchValues.Location = searchValues.Location
 	}
+	newSearchValues.Near = searchValues.Near
+	newSearchValues.RadiusKm = searchValues.RadiusKm
+	newSearchValues.Urgency = searchValues.Urgency
+	if searchValues.Near == nil && newSearchValues.SortField == models.DistanceSortField {
+		newSearchValues.SortField = defaultSortField
+	}
 	if searchValues.Order == nil || *searchValues.Order == "" {
 		newSearchValues.Order = &models.AscendingOrder
 	} else {
@@ -208,7 +293,7 @@ func (h *HelpEvent) removeEmptySearchValues(searchValues models.HelpSearchIntern
 
 func (h *HelpEvent) GetUserHelpEvents(ctx context.Context, userID models.ID) ([]models.HelpEvent, error) {
 	helpEvents := make([]models.HelpEvent, 0)
-	err := h.DB.Where("created_by = ?", userID).Find(&helpEvents).WithContext(ctx).Error
+	err := h.DB.Where("created_by = ?", userID).Where("is_deleted = ?", false).Find(&helpEvents).WithContext(ctx).Error
 	if err != nil {
 		return nil, err
 	}
@@ -269,6 +354,57 @@ func (h *HelpEvent) UpdateHelpEvent(ctx context.Context, event models.HelpEvent)
 	return err
 }
 
+// DeleteHelpEvent marks the event done and deleted with its comments and cancels its unfinished transactions.
+// Pledges of the canceled transactions are left for the caller to release.
+func (h *HelpEvent) DeleteHelpEvent(ctx context.Context, id uint) error {
+	tx := h.DB.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return tx.Error
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+		}
+	}()
+
+	result := tx.
+		Model(&models.HelpEvent{}).
+		Where("id = ?", id).
+		Where("is_deleted = ?", false).
+		UpdateColumns(map[string]any{"is_deleted": true, "status": models.Done, "completion_time": time.Now()})
+	if result.Error != nil {
+		tx.Rollback()
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		tx.Rollback()
+		return models.ErrNotFound
+	}
+	err := tx.
+		Model(&models.Transaction{}).
+		Where("event_id = ?", id).
+		Where("event_type = ?", models.HelpEventType).
+		Not("transaction_status IN (?)", models.FinishedTransactionStatuses).
+		Update("transaction_status", models.Canceled).
+		Error
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	err = tx.
+		Model(&models.Comment{}).
+		Where("event_id = ?", id).
+		Where("event_type = ?", models.HelpEventType).
+		Update("is_deleted", true).
+		Error
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
 func (h *HelpEvent) saveFile(ctx context.Context, event *models.HelpEvent) error {
 	if event.File != nil {
 		oldEvent := models.HelpEvent{}
@@ -319,6 +455,10 @@ func (h *HelpEvent) GetHelpEventByTransactionID(ctx context.Context, transaction
 
 	event := models.HelpEvent{}
 	err = h.DB.First(&event, "id = ?", transaction.EventID).WithContext(ctx).Error
+	if err != nil {
+		return models.HelpEvent{}, err
+	}
+	event.CoOrganizers, err = getCoOrganizers(ctx, h.DB, event.ID, models.HelpEventType)
 	return event, err
 }
 
@@ -336,7 +476,7 @@ func (h *HelpEvent) GetEventByID(ctx context.Context, id models.ID) (models.Help
 	event := models.HelpEvent{
 		TransactionNeeds: map[models.ID][]models.Need{},
 	}
-	err := h.DB.First(&event, id).WithContext(ctx).Error
+	err := h.DB.Where("is_deleted = ?", false).First(&event, id).WithContext(ctx).Error
 	if err != nil {
 		return models.HelpEvent{}, err
 	}
@@ -372,9 +512,21 @@ func (h *HelpEvent) insertHelpEventMissingData(ctx context.Context, event *model
 		return err
 	}
 	event.Comments = comments
+	updates, err := getEventUpdates(ctx, h.DB, event.ID, models.HelpEventType)
+	if err != nil {
+		return err
+	}
+	event.Updates = updates
+	coOrganizers, err := getCoOrganizers(ctx, h.DB, event.ID, models.HelpEventType)
 	if err != nil {
 		return err
 	}
+	event.CoOrganizers = coOrganizers
+	dropPoints, err := getDropPoints(ctx, h.DB, event.ID)
+	if err != nil {
+		return err
+	}
+	event.DropPoints = dropPoints
 	user := models.User{}
 	err = h.DB.First(&user, "id = ?", event.CreatedBy).WithContext(ctx).Error
 	event.User = user
@@ -507,9 +659,24 @@ func (h *HelpEvent) CreateEvent(ctx context.Context, event *models.HelpEvent) (u
 		}
 	}
 
+	if len(event.DropPoints) != 0 {
+		for i := range event.DropPoints {
+			event.DropPoints[i].HelpEventID = event.ID
+		}
+		if err := tx.Create(&event.DropPoints).WithContext(ctx).Error; err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+	}
+
 	if !event.Location.IsEmpty() {
 		event.Location.EventID = event.ID
-		err := tx.
+		err := event.Location.Locate()
+		if err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+		err = tx.
 			Create(&event.Location).
 			WithContext(ctx).
 			Error
@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"Kurajj/internal/models"
+	"context"
+	"github.com/samber/lo"
+)
+
+func NewIntake(db *Connector) *Intake {
+	return &Intake{db}
+}
+
+type Intake struct {
+	*Connector
+}
+
+// RecordIntake appends the entries to the ledger and derives the received totals of their needs from it
+// in one DB transaction.
+func (i *Intake) RecordIntake(ctx context.Context, entries ...models.IntakeEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	tx := i.DB.WithContext(ctx).Begin()
+	err := tx.Create(&entries).Error
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	needIDs := lo.Uniq(lo.Map(entries, func(entry models.IntakeEntry, _ int) uint {
+		return entry.NeedID
+	}))
+	err = tx.Exec(`UPDATE need SET received_total = COALESCE(
+			(SELECT SUM(need_intake.quantity) FROM need_intake WHERE need_intake.need_id = need.id), 0),
+			received_total_minor = COALESCE(
+			(SELECT SUM(need_intake.quantity_minor) FROM need_intake WHERE need_intake.need_id = need.id), 0)
+		WHERE need.id IN (?)`, needIDs).Error
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+// GetEventIntake returns the ledger of all needs of the help event, the oldest entries first.
+func (i *Intake) GetEventIntake(ctx context.Context, eventID uint) ([]models.IntakeEntry, error) {
+	entries := make([]models.IntakeEntry, 0)
+	err := i.DB.
+		Where("help_event_id = ?", eventID).
+		Order("creation_date, id").
+		Find(&entries).
+		WithContext(ctx).
+		Error
+	return entries, err
+}
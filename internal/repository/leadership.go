@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+)
+
+// jobsLeaderLock names the advisory lock held by the replica which runs background jobs.
+const jobsLeaderLock = "kurajj-background-jobs"
+
+func NewLeadership(db *Connector) *Leadership {
+	return &Leadership{Connector: db}
+}
+
+// Leadership elects the single replica which runs background jobs.
+// The leader holds a session level advisory lock on a dedicated connection. The lock is kept
+// while the connection is alive and postgres releases it when the leader dies, so another
+// replica takes it over on its next try.
+type Leadership struct {
+	*Connector
+	mu   sync.Mutex
+	conn *sql.Conn
+}
+
+// IsLeader reports whether this replica is the leader and tries to become one when it is not.
+func (l *Leadership) IsLeader(ctx context.Context) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.conn != nil {
+		if err := l.conn.PingContext(ctx); err == nil {
+			return true, nil
+		}
+		l.conn.Close()
+		l.conn = nil
+	}
+
+	db, err := l.DB.DB()
+	if err != nil {
+		return false, err
+	}
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	var acquired bool
+	err = conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock(hashtext($1))", jobsLeaderLock).Scan(&acquired)
+	if err != nil || !acquired {
+		conn.Close()
+		return false, err
+	}
+
+	l.conn = conn
+	return true, nil
+}
+
+// ResignLeadership releases the lock, so another replica can become the leader.
+func (l *Leadership) ResignLeadership(ctx context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.conn == nil {
+		return nil
+	}
+	_, err := l.conn.ExecContext(ctx, "SELECT pg_advisory_unlock(hashtext($1))", jobsLeaderLock)
+	closeErr := l.conn.Close()
+	l.conn = nil
+	if err != nil {
+		return err
+	}
+
+	return closeErr
+}
@@ -0,0 +1,44 @@
+package repository_test
+
+import (
+	"Kurajj/internal/repository"
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestLeadershipFailover(t *testing.T) {
+	ctx := context.Background()
+	leader := repository.NewLeadership(newTestConnector(t))
+	follower := repository.NewLeadership(newTestConnector(t))
+	t.Cleanup(func() {
+		leader.ResignLeadership(ctx)
+		follower.ResignLeadership(ctx)
+	})
+
+	isLeader, err := leader.IsLeader(ctx)
+	require.NoError(t, err)
+	assert.True(t, isLeader)
+
+	isLeader, err = follower.IsLeader(ctx)
+	require.NoError(t, err)
+	assert.False(t, isLeader)
+
+	isLeader, err = leader.IsLeader(ctx)
+	require.NoError(t, err)
+	assert.True(t, isLeader, "the leader should keep its lock between runs")
+
+	// A dying replica loses its connection, postgres releases the lock with it.
+	err = follower.DB.Exec(`SELECT pg_terminate_backend(pid) FROM pg_locks
+		WHERE locktype = 'advisory' AND granted AND objid = (hashtext(?)::bigint & 4294967295)::oid`,
+		"kurajj-background-jobs").Error
+	require.NoError(t, err)
+
+	isLeader, err = follower.IsLeader(ctx)
+	require.NoError(t, err)
+	assert.True(t, isLeader, "the follower should take over after the leader dies")
+
+	isLeader, _ = leader.IsLeader(ctx)
+	assert.False(t, isLeader)
+}
@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"Kurajj/internal/models"
+	"context"
+	"errors"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+	"time"
+)
+
+func NewModeration(db *Connector) *Moderation {
+	return &Moderation{db}
+}
+
+type Moderation struct {
+	*Connector
+}
+
+func (m *Moderation) CreateModerationItem(ctx context.Context, item models.ModerationItem) (uint, error) {
+	err := m.DB.Create(&item).WithContext(ctx).Error
+	return item.ID, err
+}
+
+func (m *Moderation) GetModerationItems(ctx context.Context, status models.ModerationStatus) ([]models.ModerationItem, error) {
+	items := make([]models.ModerationItem, 0)
+	err := m.DB.
+		Where("status = ?", status).
+		Order("creation_date").
+		Find(&items).
+		WithContext(ctx).
+		Error
+	return items, err
+}
+
+func (m *Moderation) GetModerationItemByID(ctx context.Context, id uint) (models.ModerationItem, error) {
+	item := models.ModerationItem{}
+	err := m.DB.
+		Where("id = ?", id).
+		First(&item).
+		WithContext(ctx).
+		Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return models.ModerationItem{}, models.ErrNotFound
+	}
+
+	return item, err
+}
+
+func (m *Moderation) UpdateModerationItem(ctx context.Context, id uint, toUpdate map[string]any) error {
+	return m.DB.
+		Model(&models.ModerationItem{}).
+		Select(lo.Keys(toUpdate)).
+		Where("id = ?", id).
+		Updates(toUpdate).
+		WithContext(ctx).
+		Error
+}
+
+func (m *Moderation) GetBlockedWords(ctx context.Context) ([]models.BlockedWord, error) {
+	words := make([]models.BlockedWord, 0)
+	err := m.DB.
+		Order("language").
+		Order("word").
+		Find(&words).
+		WithContext(ctx).
+		Error
+	return words, err
+}
+
+func (m *Moderation) CreateBlockedWord(ctx context.Context, word models.BlockedWord) (uint, error) {
+	err := m.DB.Create(&word).WithContext(ctx).Error
+	return word.ID, err
+}
+
+func (m *Moderation) DeleteBlockedWord(ctx context.Context, id uint) error {
+	return m.DB.
+		Where("id = ?", id).
+		Delete(&models.BlockedWord{}).
+		WithContext(ctx).
+		Error
+}
+
+// GetUserRecentTexts returns every comment, event title and event description
+// the user has written since the given time.
+func (m *Moderation) GetUserRecentTexts(ctx context.Context, userID uint, from time.Time) ([]string, error) {
+	texts := make([]string, 0)
+	err := m.DB.Raw(`
+		SELECT text FROM comment
+		WHERE user_id = @user AND creation_date >= @from AND is_deleted IS NOT TRUE
+		UNION ALL
+		SELECT unnest(ARRAY [title, description]) FROM propositional_event
+		WHERE author_id = @user AND creation_date >= @from
+		UNION ALL
+		SELECT unnest(ARRAY [title, description]) FROM help_event
+		WHERE created_by = @user AND creation_date >= @from
+		UNION ALL
+		SELECT text FROM event_update
+		WHERE author_id = @user AND creation_date >= @from`,
+		map[string]any{
+			"user": userID,
+			"from": from,
+		}).
+		Scan(&texts).
+		WithContext(ctx).
+		Error
+	return texts, err
+}
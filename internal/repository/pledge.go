@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"Kurajj/internal/models"
+	"context"
+	"fmt"
+	"gorm.io/gorm"
+)
+
+// CreateTransactionWithPledges creates the transaction with its pledges and reserves the pledged amounts
+// of the event needs in one DB transaction. models.ErrPledgeExceedsNeed is returned when a need
+// has less left than pledged.
+func (h *HelpEvent) CreateTransactionWithPledges(ctx context.Context, transaction models.Transaction,
+	pledges []models.Need) (uint, error) {
+	tx := h.DB.WithContext(ctx).Begin()
+	err := tx.Create(&transaction).Error
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	for _, pledge := range pledges {
+		query := tx.
+			Model(&models.Need{}).
+			Where("id = ?", pledge.EventNeedID).
+			Where("help_event_id = ? AND transaction_id IS NULL", transaction.EventID)
+		var result *gorm.DB
+		if pledge.IsMoney() {
+			result = query.
+				Where("amount_minor - received_total_minor - reserved_minor >= ?", pledge.AmountMinor).
+				UpdateColumn("reserved_minor", gorm.Expr("reserved_minor + ?", pledge.AmountMinor))
+		} else {
+			result = query.
+				Where("amount - received_total - reserved >= ?", pledge.Amount).
+				UpdateColumn("reserved", gorm.Expr("reserved + ?", pledge.Amount))
+		}
+		if result.Error != nil {
+			tx.Rollback()
+			return 0, result.Error
+		}
+		if result.RowsAffected == 0 {
+			tx.Rollback()
+			return 0, fmt.Errorf("%w: %s", models.ErrPledgeExceedsNeed, pledge.Title)
+		}
+
+		pledge.TransactionID = &transaction.ID
+		err = tx.Create(&pledge).Error
+		if err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+	}
+
+	return transaction.ID, tx.Commit().Error
+}
+
+// ReleaseTransactionPledges gives the amounts pledged in the transaction back to the event needs.
+// Pledges are released only once per transaction, the following calls return false.
+func (h *HelpEvent) ReleaseTransactionPledges(ctx context.Context, transactionID uint) (bool, error) {
+	tx := h.DB.WithContext(ctx).Begin()
+	result := tx.
+		Model(&models.Transaction{}).
+		Where("id = ?", transactionID).
+		Where("event_type = ?", models.HelpEventType).
+		Where("slot_released = ?", false).
+		UpdateColumn("slot_released", true)
+	if result.Error != nil {
+		tx.Rollback()
+		return false, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return false, tx.Commit().Error
+	}
+
+	err := tx.Exec(`UPDATE need SET reserved = GREATEST(need.reserved - pledge.amount, 0),
+			reserved_minor = GREATEST(need.reserved_minor - pledge.amount_minor, 0)
+		FROM need AS pledge
+		WHERE pledge.transaction_id = ? AND pledge.event_need_id = need.id`, transactionID).Error
+	if err != nil {
+		tx.Rollback()
+		return false, err
+	}
+
+	return true, tx.Commit().Error
+}
+
+// ConfirmNeedsReceived sets the amounts the event author actually received for the transaction's needs.
+func (h *HelpEvent) ConfirmNeedsReceived(ctx context.Context, transactionID uint, needs []models.Need) error {
+	tx := h.DB.WithContext(ctx).Begin()
+	for _, need := range needs {
+		result := tx.
+			Model(&models.Need{}).
+			Where("id = ? AND transaction_id = ?", need.ID, transactionID).
+			UpdateColumns(map[string]any{"received": need.Received, "received_minor": need.ReceivedMinor})
+		if result.Error != nil {
+			tx.Rollback()
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			tx.Rollback()
+			return fmt.Errorf("%w: need %d is not in the transaction", models.ErrInvalidPledge, need.ID)
+		}
+	}
+
+	return tx.Commit().Error
+}
@@ -0,0 +1,10 @@
+BEGIN;
+
+DROP INDEX IF EXISTS comment_user_creation_date_idx;
+DROP TABLE IF EXISTS moderation_item;
+DROP TABLE IF EXISTS moderation_blocked_word;
+DROP TYPE IF EXISTS blocked_word_language;
+DROP TYPE IF EXISTS moderation_entity;
+DROP TYPE IF EXISTS moderation_status;
+
+END;
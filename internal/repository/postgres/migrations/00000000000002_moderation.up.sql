@@ -0,0 +1,73 @@
+BEGIN;
+
+DO
+$$
+    BEGIN
+        IF NOT EXISTS(SELECT 1 FROM pg_type WHERE typname = 'moderation_status') THEN
+            CREATE TYPE moderation_status AS ENUM
+                (
+                    'pending', 'approved', 'removed'
+                    );
+        END IF;
+
+        IF NOT EXISTS(SELECT 1 FROM pg_type WHERE typname = 'moderation_entity') THEN
+            CREATE TYPE moderation_entity AS ENUM
+                (
+                    'comment', 'proposal-event', 'help'
+                    );
+        END IF;
+
+        IF NOT EXISTS(SELECT 1 FROM pg_type WHERE typname = 'blocked_word_language') THEN
+            CREATE TYPE blocked_word_language AS ENUM
+                (
+                    'uk', 'en'
+                    );
+        END IF;
+    END
+$$;
+
+CREATE TABLE IF NOT EXISTS moderation_blocked_word
+(
+    id            bigserial PRIMARY KEY,
+    word          varchar                             NOT NULL,
+    language      blocked_word_language               NOT NULL,
+    creation_date timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    UNIQUE (word, language)
+);
+
+CREATE TABLE IF NOT EXISTS moderation_item
+(
+    id            bigserial PRIMARY KEY,
+    entity_id     bigint                                         NOT NULL,
+    entity_type   moderation_entity                              NOT NULL,
+    author_id     bigint,
+    content       varchar,
+    reason        varchar,
+    status        moderation_status DEFAULT 'pending'            NOT NULL,
+    creation_date timestamp         DEFAULT CURRENT_TIMESTAMP    NOT NULL,
+    resolved_by   bigint,
+    resolved_at   timestamp,
+    CONSTRAINT author_fk FOREIGN KEY (author_id) REFERENCES members (id)
+        ON DELETE SET NULL,
+    CONSTRAINT resolved_by_fk FOREIGN KEY (resolved_by) REFERENCES members (id)
+        ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS moderation_item_status_idx ON moderation_item (status, creation_date);
+
+CREATE INDEX IF NOT EXISTS comment_user_creation_date_idx ON comment (user_id, creation_date);
+
+INSERT INTO moderation_blocked_word (word, language)
+VALUES ('fuck', 'en'),
+       ('shit', 'en'),
+       ('bitch', 'en'),
+       ('asshole', 'en'),
+       ('хуй', 'uk'),
+       ('хуя', 'uk'),
+       ('пизд', 'uk'),
+       ('бляд', 'uk'),
+       ('сука', 'uk'),
+       ('йоб', 'uk')
+ON CONFLICT DO NOTHING;
+
+END;
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS proposal_event_waitlist;
+DROP TYPE IF EXISTS waitlist_status;
+
+END;
@@ -0,0 +1,39 @@
+BEGIN;
+
+DO
+$$
+    BEGIN
+        IF NOT EXISTS(SELECT 1 FROM pg_type WHERE typname = 'waitlist_status') THEN
+            CREATE TYPE waitlist_status AS ENUM
+                (
+                    'waiting', 'promoted', 'confirmed', 'expired', 'left'
+                    );
+        END IF;
+    END
+$$;
+
+CREATE TABLE IF NOT EXISTS proposal_event_waitlist
+(
+    id               bigserial PRIMARY KEY,
+    event_id         bigint                                     NOT NULL,
+    member_id        bigint                                     NOT NULL,
+    comment          varchar(255),
+    status           waitlist_status DEFAULT 'waiting'          NOT NULL,
+    creation_date    timestamp       DEFAULT CURRENT_TIMESTAMP  NOT NULL,
+    promoted_at      timestamp,
+    confirm_deadline timestamp,
+    CONSTRAINT event_fk FOREIGN KEY (event_id) REFERENCES propositional_event (id)
+        ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT member_fk FOREIGN KEY (member_id) REFERENCES members (id)
+        ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+-- a member can wait only once per event at a time
+CREATE UNIQUE INDEX IF NOT EXISTS proposal_event_waitlist_active_member_idx
+    ON proposal_event_waitlist (event_id, member_id)
+    WHERE status IN ('waiting', 'promoted');
+
+CREATE INDEX IF NOT EXISTS proposal_event_waitlist_order_idx
+    ON proposal_event_waitlist (event_id, status, creation_date, id);
+
+END;
@@ -0,0 +1,8 @@
+BEGIN;
+
+ALTER TABLE propositional_event
+    DROP CONSTRAINT IF EXISTS remaining_helps_non_negative;
+ALTER TABLE transaction
+    DROP COLUMN IF EXISTS slot_released;
+
+END;
@@ -0,0 +1,22 @@
+BEGIN;
+
+ALTER TABLE transaction
+    ADD COLUMN IF NOT EXISTS slot_released BOOLEAN DEFAULT false NOT NULL;
+
+UPDATE transaction
+SET slot_released = true
+WHERE event_type = 'proposal-event'
+  AND transaction_status IN ('completed', 'canceled', 'aborted');
+
+UPDATE propositional_event
+SET remaining_helps = 0
+WHERE remaining_helps < 0;
+
+UPDATE propositional_event
+SET remaining_helps = max_concurrent_requests
+WHERE remaining_helps > max_concurrent_requests;
+
+ALTER TABLE propositional_event
+    ADD CONSTRAINT remaining_helps_non_negative CHECK (remaining_helps >= 0);
+
+END;
@@ -0,0 +1,9 @@
+BEGIN;
+
+ALTER TABLE transaction
+    DROP COLUMN IF EXISTS occurrence;
+ALTER TABLE propositional_event
+    DROP COLUMN IF EXISTS recurrence_rule,
+    DROP COLUMN IF EXISTS occurrence;
+
+END;
@@ -0,0 +1,10 @@
+BEGIN;
+
+ALTER TABLE propositional_event
+    ADD COLUMN IF NOT EXISTS recurrence_rule varchar,
+    ADD COLUMN IF NOT EXISTS occurrence      integer DEFAULT 1 NOT NULL;
+
+ALTER TABLE transaction
+    ADD COLUMN IF NOT EXISTS occurrence integer DEFAULT 1 NOT NULL;
+
+END;
@@ -0,0 +1,11 @@
+BEGIN;
+
+DROP INDEX IF EXISTS propositional_event_scheduled_idx;
+DROP INDEX IF EXISTS help_event_scheduled_idx;
+
+ALTER TABLE propositional_event
+    DROP COLUMN IF EXISTS publish_at;
+ALTER TABLE help_event
+    DROP COLUMN IF EXISTS publish_at;
+
+END;
@@ -0,0 +1,13 @@
+BEGIN;
+
+ALTER TABLE propositional_event
+    ADD COLUMN IF NOT EXISTS publish_at timestamp;
+ALTER TABLE help_event
+    ADD COLUMN IF NOT EXISTS publish_at timestamp;
+
+CREATE INDEX IF NOT EXISTS propositional_event_scheduled_idx
+    ON propositional_event (publish_at) WHERE status = 'inactive';
+CREATE INDEX IF NOT EXISTS help_event_scheduled_idx
+    ON help_event (publish_at) WHERE status = 'inactive';
+
+END;
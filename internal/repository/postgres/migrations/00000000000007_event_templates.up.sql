@@ -0,0 +1,17 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS event_template
+(
+    id            bigserial PRIMARY KEY,
+    member_id     bigint                              NOT NULL,
+    name          varchar                             NOT NULL,
+    event_type    event                               NOT NULL,
+    payload       text                                NOT NULL,
+    creation_date timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    CONSTRAINT member_fk FOREIGN KEY (member_id) REFERENCES members (id)
+        ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS event_template_member_idx ON event_template (member_id);
+
+END;
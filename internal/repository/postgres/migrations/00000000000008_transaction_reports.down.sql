@@ -0,0 +1,10 @@
+BEGIN;
+
+DROP INDEX IF EXISTS report_transaction_idx;
+
+ALTER TABLE report
+    DROP CONSTRAINT IF EXISTS transaction_fk;
+ALTER TABLE report
+    DROP COLUMN IF EXISTS file_type;
+
+END;
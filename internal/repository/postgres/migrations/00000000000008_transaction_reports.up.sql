@@ -0,0 +1,24 @@
+BEGIN;
+
+ALTER TABLE report
+    ADD COLUMN IF NOT EXISTS file_type varchar;
+
+DELETE
+FROM report
+WHERE transaction_id IS NOT NULL
+  AND transaction_id NOT IN (SELECT id FROM transaction);
+
+ALTER TABLE report
+    ADD CONSTRAINT transaction_fk FOREIGN KEY (transaction_id) REFERENCES transaction (id)
+        ON DELETE CASCADE ON UPDATE CASCADE;
+
+CREATE INDEX IF NOT EXISTS report_transaction_idx ON report (transaction_id);
+
+-- Reports were stored as a single url per transaction before.
+INSERT INTO report (s3_path, event_type, transaction_id, members_id, creation_date)
+SELECT report_url, event_type, id, creator_id, COALESCE(completion_date, creation_date)
+FROM transaction
+WHERE report_url IS NOT NULL
+  AND report_url <> '';
+
+END;
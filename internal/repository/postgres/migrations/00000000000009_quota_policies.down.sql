@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP TABLE IF EXISTS quota_policy;
+DROP TYPE IF EXISTS quota_tier;
+ALTER TABLE members
+    DROP COLUMN IF EXISTS is_verified_organization;
+
+END;
@@ -0,0 +1,39 @@
+BEGIN;
+
+DO
+$$
+    BEGIN
+        IF NOT EXISTS(SELECT 1 FROM pg_type WHERE typname = 'quota_tier') THEN
+            CREATE TYPE quota_tier AS ENUM
+                (
+                    'default', 'verified_organization', 'member'
+                    );
+        END IF;
+    END
+$$;
+
+ALTER TABLE members
+    ADD COLUMN IF NOT EXISTS is_verified_organization boolean DEFAULT false NOT NULL;
+
+CREATE TABLE IF NOT EXISTS quota_policy
+(
+    id                    bigserial PRIMARY KEY,
+    tier                  quota_tier                          NOT NULL,
+    member_id             bigint,
+    max_active_events     integer                             NOT NULL,
+    max_pending_responses integer                             NOT NULL,
+    max_daily_comments    integer                             NOT NULL,
+    updated_at            timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    CONSTRAINT member_fk FOREIGN KEY (member_id) REFERENCES members (id)
+        ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS quota_policy_tier_idx ON quota_policy (tier) WHERE member_id IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS quota_policy_member_idx ON quota_policy (member_id) WHERE member_id IS NOT NULL;
+
+INSERT INTO quota_policy (tier, max_active_events, max_pending_responses, max_daily_comments)
+VALUES ('default', 5, 10, 50),
+       ('verified_organization', 50, 100, 500)
+ON CONFLICT DO NOTHING;
+
+END;
@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE notification
+    DROP COLUMN IF EXISTS summary;
+
+END;
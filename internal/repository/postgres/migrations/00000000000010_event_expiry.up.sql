@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE notification
+    ADD COLUMN IF NOT EXISTS summary varchar DEFAULT '' NOT NULL;
+
+END;
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP INDEX IF EXISTS help_event_end_date_idx;
+DROP INDEX IF EXISTS propositional_event_end_date_idx;
+
+END;
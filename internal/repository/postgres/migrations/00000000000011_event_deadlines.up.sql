@@ -0,0 +1,8 @@
+BEGIN;
+
+CREATE INDEX IF NOT EXISTS propositional_event_end_date_idx
+    ON propositional_event (end_date) WHERE status = 'active';
+CREATE INDEX IF NOT EXISTS help_event_end_date_idx
+    ON help_event (end_date) WHERE status = 'active';
+
+END;
@@ -0,0 +1,10 @@
+BEGIN;
+
+DROP INDEX IF EXISTS transaction_time_slot_member_idx;
+ALTER TABLE transaction
+    DROP CONSTRAINT IF EXISTS time_slot_fk,
+    DROP COLUMN IF EXISTS time_slot_reminded,
+    DROP COLUMN IF EXISTS time_slot_id;
+DROP TABLE IF EXISTS proposal_event_time_slot;
+
+END;
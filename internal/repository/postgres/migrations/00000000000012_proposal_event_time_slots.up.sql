@@ -0,0 +1,30 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS proposal_event_time_slot
+(
+    id         bigserial PRIMARY KEY,
+    event_id   bigint            NOT NULL,
+    start_time timestamp         NOT NULL,
+    end_time   timestamp         NOT NULL,
+    capacity   integer           NOT NULL,
+    booked     integer DEFAULT 0 NOT NULL,
+    CONSTRAINT event_fk FOREIGN KEY (event_id) REFERENCES propositional_event (id)
+        ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT time_slot_period CHECK (start_time < end_time),
+    CONSTRAINT time_slot_capacity CHECK (capacity > 0 AND booked >= 0 AND booked <= capacity)
+);
+
+CREATE INDEX IF NOT EXISTS proposal_event_time_slot_event_idx ON proposal_event_time_slot (event_id);
+CREATE INDEX IF NOT EXISTS proposal_event_time_slot_start_idx ON proposal_event_time_slot (start_time);
+
+ALTER TABLE transaction
+    ADD COLUMN IF NOT EXISTS time_slot_id       bigint,
+    ADD COLUMN IF NOT EXISTS time_slot_reminded boolean DEFAULT false NOT NULL,
+    ADD CONSTRAINT time_slot_fk FOREIGN KEY (time_slot_id) REFERENCES proposal_event_time_slot (id)
+        ON DELETE SET NULL ON UPDATE CASCADE;
+
+-- A member holds at most one place of a time slot until the place is released.
+CREATE UNIQUE INDEX IF NOT EXISTS transaction_time_slot_member_idx
+    ON transaction (time_slot_id, creator_id) WHERE time_slot_id IS NOT NULL AND NOT slot_released;
+
+END;
@@ -0,0 +1,12 @@
+BEGIN;
+
+ALTER TABLE proposal_event_waitlist
+    DROP COLUMN IF EXISTS questionnaire_answers;
+ALTER TABLE transaction
+    DROP COLUMN IF EXISTS questionnaire_answers;
+ALTER TABLE help_event
+    DROP COLUMN IF EXISTS questionnaire;
+ALTER TABLE propositional_event
+    DROP COLUMN IF EXISTS questionnaire;
+
+END;
@@ -0,0 +1,12 @@
+BEGIN;
+
+ALTER TABLE propositional_event
+    ADD COLUMN IF NOT EXISTS questionnaire jsonb;
+ALTER TABLE help_event
+    ADD COLUMN IF NOT EXISTS questionnaire jsonb;
+ALTER TABLE transaction
+    ADD COLUMN IF NOT EXISTS questionnaire_answers jsonb;
+ALTER TABLE proposal_event_waitlist
+    ADD COLUMN IF NOT EXISTS questionnaire_answers jsonb;
+
+END;
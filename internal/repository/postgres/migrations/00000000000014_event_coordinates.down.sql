@@ -0,0 +1,12 @@
+BEGIN;
+
+DROP INDEX IF EXISTS location_geography_idx;
+DROP INDEX IF EXISTS location_earth_idx;
+ALTER TABLE location
+    DROP CONSTRAINT IF EXISTS location_coordinates_check,
+    DROP COLUMN IF EXISTS longitude,
+    DROP COLUMN IF EXISTS latitude;
+DROP EXTENSION IF EXISTS earthdistance;
+DROP EXTENSION IF EXISTS cube;
+
+END;
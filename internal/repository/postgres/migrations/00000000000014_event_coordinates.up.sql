@@ -0,0 +1,30 @@
+BEGIN;
+
+CREATE EXTENSION IF NOT EXISTS cube;
+CREATE EXTENSION IF NOT EXISTS earthdistance;
+
+ALTER TABLE location
+    ADD COLUMN IF NOT EXISTS latitude double precision,
+    ADD COLUMN IF NOT EXISTS longitude double precision,
+    ADD CONSTRAINT location_coordinates_check CHECK (
+        (latitude IS NULL) = (longitude IS NULL) AND
+        latitude BETWEEN -90 AND 90 AND
+        longitude BETWEEN -180 AND 180);
+
+CREATE INDEX IF NOT EXISTS location_earth_idx
+    ON location USING gist (ll_to_earth(latitude, longitude))
+    WHERE latitude IS NOT NULL;
+
+-- Radius searches use PostGIS instead of earthdistance when it is installed.
+DO
+$$
+    BEGIN
+        IF EXISTS(SELECT 1 FROM pg_extension WHERE extname = 'postgis') THEN
+            CREATE INDEX IF NOT EXISTS location_geography_idx
+                ON location USING gist ((ST_MakePoint(longitude, latitude)::geography))
+                WHERE latitude IS NOT NULL;
+        END IF;
+    END
+$$;
+
+END;
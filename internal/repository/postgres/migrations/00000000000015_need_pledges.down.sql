@@ -0,0 +1,10 @@
+BEGIN;
+
+DROP INDEX IF EXISTS need_event_need_idx;
+ALTER TABLE need
+    DROP CONSTRAINT IF EXISTS event_need_fk,
+    DROP CONSTRAINT IF EXISTS need_reserved_check,
+    DROP COLUMN IF EXISTS event_need_id,
+    DROP COLUMN IF EXISTS reserved;
+
+END;
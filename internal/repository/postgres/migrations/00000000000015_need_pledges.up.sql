@@ -0,0 +1,12 @@
+BEGIN;
+
+ALTER TABLE need
+    ADD COLUMN IF NOT EXISTS reserved      integer DEFAULT 0 NOT NULL,
+    ADD COLUMN IF NOT EXISTS event_need_id bigint,
+    ADD CONSTRAINT need_reserved_check CHECK (reserved >= 0),
+    ADD CONSTRAINT event_need_fk FOREIGN KEY (event_need_id) REFERENCES need (id)
+        ON DELETE SET NULL ON UPDATE CASCADE;
+
+CREATE INDEX IF NOT EXISTS need_event_need_idx ON need (event_need_id) WHERE event_need_id IS NOT NULL;
+
+END;
@@ -0,0 +1,26 @@
+BEGIN;
+
+DO
+$$
+    BEGIN
+        IF NOT EXISTS(SELECT 1 FROM pg_type WHERE typname = 'need_unit') THEN
+            CREATE TYPE need_unit AS ENUM
+                ('kilogram', 'liter', 'item', 'work');
+        END IF;
+    END
+$$;
+
+ALTER TABLE need DROP CONSTRAINT IF EXISTS unit_fk;
+UPDATE need
+SET unit = CASE WHEN unit = 'hour' THEN 'work' ELSE 'item' END
+WHERE unit NOT IN ('kilogram', 'liter', 'item', 'work');
+ALTER TABLE need
+    ALTER COLUMN unit TYPE need_unit USING unit::need_unit,
+    ALTER COLUMN amount TYPE integer USING round(amount),
+    ALTER COLUMN received TYPE integer USING round(received),
+    ALTER COLUMN received_total TYPE integer USING round(received_total),
+    ALTER COLUMN reserved TYPE integer USING round(reserved);
+
+DROP TABLE IF EXISTS unit;
+
+END;
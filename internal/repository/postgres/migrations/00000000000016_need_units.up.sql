@@ -0,0 +1,42 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS unit
+(
+    code      varchar PRIMARY KEY,
+    title     varchar          NOT NULL,
+    dimension varchar          NOT NULL,
+    factor    double precision NOT NULL,
+    is_base   boolean          NOT NULL DEFAULT false,
+    CONSTRAINT unit_dimension_check CHECK (dimension IN ('mass', 'volume', 'count', 'time')),
+    CONSTRAINT unit_factor_check CHECK (factor > 0),
+    CONSTRAINT unit_base_factor_check CHECK (NOT is_base OR factor = 1)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS unit_base_idx ON unit (dimension) WHERE is_base;
+
+-- Factors convert to the base unit of the dimension: kilogram, liter, item and hour. Work is counted in hours.
+INSERT INTO unit (code, title, dimension, factor, is_base)
+VALUES ('kilogram', 'kilogram', 'mass', 1, true),
+       ('gram', 'gram', 'mass', 0.001, false),
+       ('tonne', 'tonne', 'mass', 1000, false),
+       ('liter', 'liter', 'volume', 1, true),
+       ('milliliter', 'milliliter', 'volume', 0.001, false),
+       ('item', 'item', 'count', 1, true),
+       ('pair', 'pair', 'count', 2, false),
+       ('dozen', 'box of 12', 'count', 12, false),
+       ('hour', 'hour', 'time', 1, true),
+       ('work', 'work', 'time', 1, false)
+ON CONFLICT (code) DO NOTHING;
+
+-- Amounts of needs are kept in the base units, so they can be fractions.
+ALTER TABLE need
+    ALTER COLUMN unit TYPE varchar USING unit::text,
+    ALTER COLUMN amount TYPE double precision,
+    ALTER COLUMN received TYPE double precision,
+    ALTER COLUMN received_total TYPE double precision,
+    ALTER COLUMN reserved TYPE double precision,
+    ADD CONSTRAINT unit_fk FOREIGN KEY (unit) REFERENCES unit (code) ON UPDATE CASCADE;
+
+DROP TYPE IF EXISTS need_unit;
+
+END;
@@ -0,0 +1,21 @@
+BEGIN;
+
+DROP INDEX IF EXISTS need_money_idx;
+DELETE
+FROM need
+WHERE type = 'money';
+ALTER TABLE need
+    DROP CONSTRAINT IF EXISTS need_money_check,
+    DROP CONSTRAINT IF EXISTS need_type_check,
+    DROP COLUMN IF EXISTS transfer_reference,
+    DROP COLUMN IF EXISTS currency,
+    DROP COLUMN IF EXISTS type;
+
+DELETE
+FROM unit
+WHERE code = 'minor_unit';
+ALTER TABLE unit
+    DROP CONSTRAINT IF EXISTS unit_dimension_check,
+    ADD CONSTRAINT unit_dimension_check CHECK (dimension IN ('mass', 'volume', 'count', 'time'));
+
+END;
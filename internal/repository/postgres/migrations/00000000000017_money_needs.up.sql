@@ -0,0 +1,23 @@
+BEGIN;
+
+ALTER TABLE unit
+    DROP CONSTRAINT IF EXISTS unit_dimension_check,
+    ADD CONSTRAINT unit_dimension_check CHECK (dimension IN ('mass', 'volume', 'count', 'time', 'money'));
+
+-- Money needs have no conversions, their amounts are whole numbers of minor units of the need's currency.
+INSERT INTO unit (code, title, dimension, factor, is_base)
+VALUES ('minor_unit', 'minor units of the currency', 'money', 1, true)
+ON CONFLICT (code) DO NOTHING;
+
+ALTER TABLE need
+    ADD COLUMN IF NOT EXISTS type               varchar    NOT NULL DEFAULT 'goods',
+    ADD COLUMN IF NOT EXISTS currency           varchar(3) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS transfer_reference varchar    NOT NULL DEFAULT '',
+    ADD CONSTRAINT need_type_check CHECK (type IN ('goods', 'money')),
+    ADD CONSTRAINT need_money_check CHECK (
+        (type = 'money') = (currency <> '') AND
+        (type = 'money') = (unit = 'minor_unit'));
+
+CREATE INDEX IF NOT EXISTS need_money_idx ON need (transaction_id, currency) WHERE type = 'money';
+
+END;
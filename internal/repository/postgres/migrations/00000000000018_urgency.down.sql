@@ -0,0 +1,10 @@
+BEGIN;
+
+DROP INDEX IF EXISTS need_urgency_idx;
+DROP INDEX IF EXISTS help_event_urgency_idx;
+ALTER TABLE need
+    DROP COLUMN IF EXISTS urgency;
+ALTER TABLE help_event
+    DROP COLUMN IF EXISTS urgency;
+
+END;
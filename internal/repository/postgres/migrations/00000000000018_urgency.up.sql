@@ -0,0 +1,11 @@
+BEGIN;
+
+ALTER TABLE help_event
+    ADD COLUMN IF NOT EXISTS urgency priority_rate NOT NULL DEFAULT 'normal';
+ALTER TABLE need
+    ADD COLUMN IF NOT EXISTS urgency priority_rate NOT NULL DEFAULT 'normal';
+
+CREATE INDEX IF NOT EXISTS help_event_urgency_idx ON help_event (urgency, end_date);
+CREATE INDEX IF NOT EXISTS need_urgency_idx ON need (help_event_id, urgency) WHERE transaction_id IS NULL;
+
+END;
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS event_follower;
+DROP TABLE IF EXISTS event_update;
+
+END;
@@ -0,0 +1,30 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS event_update
+(
+    id            bigserial PRIMARY KEY,
+    event_id      bigint                              NOT NULL,
+    event_type    event                               NOT NULL,
+    author_id     bigint                              NOT NULL,
+    text          varchar(2000) DEFAULT ''            NOT NULL,
+    image_paths   jsonb,
+    creation_date timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    CONSTRAINT author_fk FOREIGN KEY (author_id) REFERENCES members (id)
+        ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS event_update_event_idx
+    ON event_update (event_id, event_type, creation_date, id);
+
+CREATE TABLE IF NOT EXISTS event_follower
+(
+    event_id      bigint                              NOT NULL,
+    event_type    event                               NOT NULL,
+    member_id     bigint                              NOT NULL,
+    creation_date timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    PRIMARY KEY (event_id, event_type, member_id),
+    CONSTRAINT member_fk FOREIGN KEY (member_id) REFERENCES members (id)
+        ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+END;
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS need_intake;
+DROP FUNCTION IF EXISTS need_intake_append_only();
+DROP TYPE IF EXISTS intake_kind;
+
+END;
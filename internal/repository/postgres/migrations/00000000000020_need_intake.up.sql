@@ -0,0 +1,72 @@
+BEGIN;
+
+DO
+$$
+    BEGIN
+        IF NOT EXISTS(SELECT 1 FROM pg_type WHERE typname = 'intake_kind') THEN
+            CREATE TYPE intake_kind AS ENUM
+                (
+                    'receipt', 'correction', 'reversal'
+                    );
+        END IF;
+    END
+$$;
+
+CREATE TABLE IF NOT EXISTS need_intake
+(
+    id             bigserial PRIMARY KEY,
+    need_id        bigint                              NOT NULL,
+    help_event_id  bigint                              NOT NULL,
+    transaction_id bigint,
+    kind           intake_kind                         NOT NULL,
+    quantity       double precision                    NOT NULL,
+    unit           varchar                             NOT NULL,
+    recorded_by    bigint                              NOT NULL,
+    reverses_id    bigint,
+    comment        varchar(255) DEFAULT ''             NOT NULL,
+    creation_date  timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    CONSTRAINT need_fk FOREIGN KEY (need_id) REFERENCES need (id)
+        ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT help_event_fk FOREIGN KEY (help_event_id) REFERENCES help_event (id)
+        ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT unit_fk FOREIGN KEY (unit) REFERENCES unit (code),
+    CONSTRAINT recorded_by_fk FOREIGN KEY (recorded_by) REFERENCES members (id),
+    CONSTRAINT reverses_fk FOREIGN KEY (reverses_id) REFERENCES need_intake (id) ON DELETE CASCADE,
+    CONSTRAINT need_intake_quantity_check CHECK (quantity <> 0),
+    CONSTRAINT need_intake_reversal_check CHECK ((kind = 'reversal') = (reverses_id IS NOT NULL))
+);
+
+-- an entry can be reversed only once
+CREATE UNIQUE INDEX IF NOT EXISTS need_intake_reverses_idx ON need_intake (reverses_id) WHERE reverses_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS need_intake_event_idx ON need_intake (help_event_id, creation_date, id);
+CREATE INDEX IF NOT EXISTS need_intake_need_idx ON need_intake (need_id);
+
+-- the ledger is append-only, entries are removed only together with their needs
+CREATE OR REPLACE FUNCTION need_intake_append_only()
+    RETURNS TRIGGER AS
+$$
+BEGIN
+    IF TG_OP = 'DELETE' AND pg_trigger_depth() > 1 THEN
+        RETURN OLD;
+    END IF;
+
+    RAISE EXCEPTION 'need_intake is append-only, post a correction or a reversal instead';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE TRIGGER need_intake_append_only_trigger
+    BEFORE UPDATE OR DELETE
+    ON need_intake
+    FOR EACH ROW
+EXECUTE FUNCTION need_intake_append_only();
+
+-- received totals recorded before the ledger become its opening entries
+INSERT INTO need_intake (need_id, help_event_id, kind, quantity, unit, recorded_by, comment)
+SELECT need.id, need.help_event_id, 'correction', need.received_total, need.unit, help_event.created_by,
+       'opening balance'
+FROM need
+         JOIN help_event ON help_event.id = need.help_event_id
+WHERE need.transaction_id IS NULL
+  AND need.received_total <> 0;
+
+END;
@@ -0,0 +1,12 @@
+BEGIN;
+
+DROP INDEX IF EXISTS transaction_drop_point_arrival_idx;
+ALTER TABLE transaction
+    DROP CONSTRAINT IF EXISTS drop_point_fk,
+    DROP COLUMN IF EXISTS drop_point_id,
+    DROP COLUMN IF EXISTS arrival_start,
+    DROP COLUMN IF EXISTS arrival_end;
+DROP TABLE IF EXISTS help_event_drop_point;
+DROP TYPE IF EXISTS drop_point_kind;
+
+END;
@@ -0,0 +1,49 @@
+BEGIN;
+
+DO
+$$
+    BEGIN
+        IF NOT EXISTS(SELECT 1 FROM pg_type WHERE typname = 'drop_point_kind') THEN
+            CREATE TYPE drop_point_kind AS ENUM
+                (
+                    'drop_off', 'pickup'
+                    );
+        END IF;
+    END
+$$;
+
+CREATE TABLE IF NOT EXISTS help_event_drop_point
+(
+    id            bigserial PRIMARY KEY,
+    help_event_id bigint                  NOT NULL,
+    kind          drop_point_kind         NOT NULL,
+    title         varchar(255) DEFAULT '' NOT NULL,
+    area          varchar      DEFAULT '' NOT NULL,
+    city          varchar                 NOT NULL,
+    district      varchar      DEFAULT '' NOT NULL,
+    street        varchar                 NOT NULL,
+    home          varchar      DEFAULT '' NOT NULL,
+    latitude      double precision,
+    longitude     double precision,
+    opening_hours jsonb,
+    contact       varchar(255) DEFAULT '' NOT NULL,
+    is_deleted    boolean      DEFAULT false NOT NULL,
+    CONSTRAINT help_event_fk FOREIGN KEY (help_event_id) REFERENCES help_event (id)
+        ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS help_event_drop_point_event_idx
+    ON help_event_drop_point (help_event_id);
+
+ALTER TABLE transaction
+    ADD COLUMN IF NOT EXISTS drop_point_id bigint,
+    ADD COLUMN IF NOT EXISTS arrival_start timestamp,
+    ADD COLUMN IF NOT EXISTS arrival_end   timestamp,
+    ADD CONSTRAINT drop_point_fk FOREIGN KEY (drop_point_id) REFERENCES help_event_drop_point (id)
+        ON DELETE SET NULL ON UPDATE CASCADE;
+
+CREATE INDEX IF NOT EXISTS transaction_drop_point_arrival_idx
+    ON transaction (drop_point_id, arrival_start)
+    WHERE drop_point_id IS NOT NULL;
+
+END;
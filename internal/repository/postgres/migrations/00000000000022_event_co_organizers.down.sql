@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS event_co_organizer;
+DROP TYPE IF EXISTS co_organizer_role;
+
+END;
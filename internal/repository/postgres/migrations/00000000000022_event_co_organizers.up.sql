@@ -0,0 +1,33 @@
+BEGIN;
+
+DO
+$$
+    BEGIN
+        IF NOT EXISTS(SELECT 1 FROM pg_type WHERE typname = 'co_organizer_role') THEN
+            CREATE TYPE co_organizer_role AS ENUM
+                (
+                    'editor', 'transaction_manager'
+                    );
+        END IF;
+    END
+$$;
+
+CREATE TABLE IF NOT EXISTS event_co_organizer
+(
+    event_id      bigint                              NOT NULL,
+    event_type    event                               NOT NULL,
+    member_id     bigint                              NOT NULL,
+    role          co_organizer_role                   NOT NULL,
+    invited_by    bigint                              NOT NULL,
+    creation_date timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    PRIMARY KEY (event_id, event_type, member_id),
+    CONSTRAINT member_fk FOREIGN KEY (member_id) REFERENCES members (id)
+        ON DELETE CASCADE ON UPDATE CASCADE,
+    CONSTRAINT invited_by_fk FOREIGN KEY (invited_by) REFERENCES members (id)
+        ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS event_co_organizer_member_idx
+    ON event_co_organizer (member_id);
+
+END;
@@ -0,0 +1,7 @@
+BEGIN;
+
+UPDATE moderation_blocked_word
+SET word = rtrim(word, '*')
+WHERE word IN ('fuck*', 'хуй*', 'пизд*', 'бляд*', 'йоб*');
+
+END;
@@ -0,0 +1,34 @@
+BEGIN;
+
+ALTER TABLE need_intake
+    DISABLE TRIGGER need_intake_append_only_trigger;
+
+UPDATE need_intake
+SET quantity = quantity_minor
+WHERE quantity_minor <> 0;
+
+ALTER TABLE need_intake
+    ENABLE TRIGGER need_intake_append_only_trigger;
+
+ALTER TABLE need_intake
+    DROP CONSTRAINT IF EXISTS need_intake_quantity_check,
+    ADD CONSTRAINT need_intake_quantity_check CHECK (quantity <> 0),
+    DROP COLUMN IF EXISTS quantity_minor;
+
+ALTER TABLE need
+    DROP CONSTRAINT IF EXISTS need_minor_check;
+
+UPDATE need
+SET amount         = amount_minor,
+    received       = received_minor,
+    received_total = received_total_minor,
+    reserved       = reserved_minor
+WHERE type = 'money';
+
+ALTER TABLE need
+    DROP COLUMN IF EXISTS amount_minor,
+    DROP COLUMN IF EXISTS received_minor,
+    DROP COLUMN IF EXISTS received_total_minor,
+    DROP COLUMN IF EXISTS reserved_minor;
+
+END;
@@ -0,0 +1,48 @@
+BEGIN;
+
+-- money needs are counted in whole minor units of the currency, the double precision amounts are left to goods
+ALTER TABLE need
+    ADD COLUMN IF NOT EXISTS amount_minor         bigint DEFAULT 0 NOT NULL,
+    ADD COLUMN IF NOT EXISTS received_minor       bigint DEFAULT 0 NOT NULL,
+    ADD COLUMN IF NOT EXISTS received_total_minor bigint DEFAULT 0 NOT NULL,
+    ADD COLUMN IF NOT EXISTS reserved_minor       bigint DEFAULT 0 NOT NULL;
+
+UPDATE need
+SET amount_minor         = round(amount),
+    received_minor       = round(received),
+    received_total_minor = round(received_total),
+    reserved_minor       = round(reserved),
+    amount               = 0,
+    received             = 0,
+    received_total       = 0,
+    reserved             = 0
+WHERE type = 'money';
+
+ALTER TABLE need
+    ADD CONSTRAINT need_minor_check CHECK (
+        amount_minor >= 0 AND received_minor >= 0 AND reserved_minor >= 0 AND
+        (type = 'money' OR (amount_minor = 0 AND received_minor = 0 AND received_total_minor = 0 AND reserved_minor = 0)) AND
+        (type <> 'money' OR (amount = 0 AND received = 0 AND received_total = 0 AND reserved = 0)));
+
+ALTER TABLE need_intake
+    ADD COLUMN IF NOT EXISTS quantity_minor bigint DEFAULT 0 NOT NULL;
+
+-- the ledger is append-only, its money entries are moved to minor units once here
+ALTER TABLE need_intake
+    DISABLE TRIGGER need_intake_append_only_trigger;
+
+UPDATE need_intake
+SET quantity_minor = round(need_intake.quantity),
+    quantity       = 0
+FROM need
+WHERE need.id = need_intake.need_id
+  AND need.type = 'money';
+
+ALTER TABLE need_intake
+    ENABLE TRIGGER need_intake_append_only_trigger;
+
+ALTER TABLE need_intake
+    DROP CONSTRAINT IF EXISTS need_intake_quantity_check,
+    ADD CONSTRAINT need_intake_quantity_check CHECK ((quantity = 0) <> (quantity_minor = 0));
+
+END;
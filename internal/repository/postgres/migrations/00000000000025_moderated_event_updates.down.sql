@@ -0,0 +1,8 @@
+BEGIN;
+
+-- enum values cannot be dropped, the items of event updates are removed instead
+DELETE
+FROM moderation_item
+WHERE entity_type::text = 'event-update';
+
+END;
@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TYPE moderation_entity ADD VALUE IF NOT EXISTS 'event-update';
+
+END;
@@ -10,6 +10,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/samber/lo"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"math"
 	"strings"
 	"time"
@@ -24,24 +25,303 @@ type ProposalEvent struct {
 	Filer
 }
 
-func (p *ProposalEvent) UpdateRemainingHelps(ctx context.Context, eventID models.ID, increase bool, number int) error {
-	oldEvent, err := p.GetEvent(ctx, uint(eventID))
+// updateRemainingHelps changes free slots of the event with a single conditional UPDATE,
+// so concurrent calls never lose each other's changes. Slots are never taken below zero,
+// models.ErrNoFreeSlots is returned instead.
+func updateRemainingHelps(db *gorm.DB, eventID models.ID, increase bool, number int) error {
+	query := db.
+		Model(&models.ProposalEvent{}).
+		Where("id = ?", eventID)
+	remainingHelps := gorm.Expr("LEAST(remaining_helps + ?, max_concurrent_requests)", number)
+	if !increase {
+		query = query.Where("remaining_helps >= ?", number)
+		remainingHelps = gorm.Expr("remaining_helps - ?", number)
+	}
+
+	result := query.UpdateColumn("remaining_helps", remainingHelps)
+	if result.Error != nil {
+		return result.Error
+	}
+	if !increase && result.RowsAffected == 0 {
+		return models.ErrNoFreeSlots
+	}
+
+	return nil
+}
+
+// CreateTransactionWithSlot takes a free slot of the proposal event and creates the transaction
+// in one DB transaction. models.ErrNoFreeSlots is returned when the last slot was already taken.
+// A place of the transaction's time slot is booked as well, models.ErrTimeSlotFull is returned
+// when the time slot has no free places.
+func (p *ProposalEvent) CreateTransactionWithSlot(ctx context.Context, transaction models.Transaction) (uint, error) {
+	tx := p.DBConnector.DB.WithContext(ctx).Begin()
+	err := updateRemainingHelps(tx, models.ID(transaction.EventID), false, 1)
 	if err != nil {
-		return err
+		tx.Rollback()
+		return 0, err
 	}
-	if increase {
-		oldEvent.RemainingHelps += number
-	} else {
-		oldEvent.RemainingHelps -= number
+
+	if transaction.TimeSlotID.Valid {
+		err = bookTimeSlot(tx, transaction.EventID, uint(transaction.TimeSlotID.Int64))
+		if err != nil {
+			tx.Rollback()
+			return 0, err
+		}
 	}
-	err = p.DBConnector.DB.
+
+	err = tx.
+		Model(&models.ProposalEvent{}).
+		Select("occurrence").
+		Where("id = ?", transaction.EventID).
+		Scan(&transaction.Occurrence).
+		Error
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	err = tx.Create(&transaction).Error
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	return transaction.ID, tx.Commit().Error
+}
+
+// ReleaseTransactionSlot gives the slot taken by the transaction back to its proposal event.
+// A slot is given back only once per transaction, the following calls return false.
+// Transactions of past occurrences of a recurring event do not give slots to the current one.
+func (p *ProposalEvent) ReleaseTransactionSlot(ctx context.Context, transactionID uint) (bool, error) {
+	tx := p.DBConnector.DB.WithContext(ctx).Begin()
+	_, released, err := releaseTransactionSlot(tx, transactionID)
+	if err != nil {
+		tx.Rollback()
+		return false, err
+	}
+
+	return released, tx.Commit().Error
+}
+
+// ReleaseTransactionSlotToWaitlist gives the slot taken by the transaction back to its proposal event
+// and offers a free slot to the first waiting member in one DB transaction, so a direct responder
+// can't take the slot before the waitlist. The slot is given back only once per transaction.
+// The promoted entry is returned, models.ErrNotFound means nobody was promoted.
+func (p *ProposalEvent) ReleaseTransactionSlotToWaitlist(ctx context.Context, transactionID uint,
+	promotedAt, confirmDeadline time.Time) (models.WaitlistEntry, error) {
+	tx := p.DBConnector.DB.WithContext(ctx).Begin()
+	eventID, released, err := releaseTransactionSlot(tx, transactionID)
+	if err != nil {
+		tx.Rollback()
+		return models.WaitlistEntry{}, err
+	}
+	if !released {
+		return models.WaitlistEntry{}, commitNotPromoted(tx)
+	}
+
+	return promoteWaitlistHead(tx, eventID, promotedAt, confirmDeadline)
+}
+
+// ReleaseSlot gives a slot reserved for a waitlisted member back to the event and offers a free slot
+// to the next waiting member in one DB transaction, so a direct responder can't take it first.
+// The promoted entry is returned, models.ErrNotFound means nobody was promoted.
+func (p *ProposalEvent) ReleaseSlot(ctx context.Context, eventID uint,
+	promotedAt, confirmDeadline time.Time) (models.WaitlistEntry, error) {
+	tx := p.DBConnector.DB.WithContext(ctx).Begin()
+	err := updateRemainingHelps(tx, models.ID(eventID), true, 1)
+	if err != nil {
+		tx.Rollback()
+		return models.WaitlistEntry{}, err
+	}
+
+	return promoteWaitlistHead(tx, eventID, promotedAt, confirmDeadline)
+}
+
+// ChangeCapacity sets the maximum of concurrent requests of the event and moves its free slots
+// by the difference with a single conditional UPDATE, so slots taken meanwhile are kept.
+// models.ErrCapacityBelowTakenSlots is returned when more slots are taken than the new capacity.
+func (p *ProposalEvent) ChangeCapacity(ctx context.Context, eventID uint, capacity uint) error {
+	result := p.DBConnector.DB.
+		WithContext(ctx).
+		Model(&models.ProposalEvent{}).
 		Where("id = ?", eventID).
+		Where("remaining_helps + ? - max_concurrent_requests >= 0", capacity).
+		UpdateColumns(map[string]any{
+			"remaining_helps":         gorm.Expr("remaining_helps + ? - max_concurrent_requests", capacity),
+			"max_concurrent_requests": capacity,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return models.ErrCapacityBelowTakenSlots
+	}
+
+	return nil
+}
+
+// releaseTransactionSlot marks the transaction's slot released and gives it back to the event,
+// it returns the event id and whether a slot of the current occurrence was given back.
+func releaseTransactionSlot(tx *gorm.DB, transactionID uint) (uint, bool, error) {
+	result := tx.
+		Model(&models.Transaction{}).
+		Where("id = ?", transactionID).
+		Where("event_type = ?", models.ProposalEventType).
+		Where("slot_released = ?", false).
+		UpdateColumn("slot_released", true)
+	if result.Error != nil {
+		return 0, false, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return 0, false, nil
+	}
+
+	transaction := models.Transaction{}
+	err := tx.Where("id = ?", transactionID).First(&transaction).Error
+	if err != nil {
+		return 0, false, err
+	}
+
+	if transaction.TimeSlotID.Valid {
+		err = releaseTimeSlot(tx, uint(transaction.TimeSlotID.Int64))
+		if err != nil {
+			return 0, false, err
+		}
+	}
+
+	result = tx.
+		Model(&models.ProposalEvent{}).
+		Where("id = ?", transaction.EventID).
+		Where("occurrence = ?", transaction.Occurrence).
+		UpdateColumn("remaining_helps", gorm.Expr("LEAST(remaining_helps + 1, max_concurrent_requests)"))
+	if result.Error != nil {
+		return 0, false, result.Error
+	}
+
+	return transaction.EventID, result.RowsAffected != 0, nil
+}
+
+// promoteWaitlistHead reserves a free slot for the first waiting member and commits tx.
+// The slot stays reserved until the member confirms it, leaves or the confirm deadline is over.
+func promoteWaitlistHead(tx *gorm.DB, eventID uint, promotedAt, confirmDeadline time.Time) (models.WaitlistEntry, error) {
+	head := models.WaitlistEntry{}
+	err := tx.
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("event_id = ?", eventID).
+		Where("status = ?", models.WaitlistWaiting).
+		Order("creation_date, id").
+		First(&head).
+		Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return models.WaitlistEntry{}, commitNotPromoted(tx)
+	}
+	if err != nil {
+		tx.Rollback()
+		return models.WaitlistEntry{}, err
+	}
+
+	err = updateRemainingHelps(tx, models.ID(eventID), false, 1)
+	if errors.Is(err, models.ErrNoFreeSlots) {
+		return models.WaitlistEntry{}, commitNotPromoted(tx)
+	}
+	if err != nil {
+		tx.Rollback()
+		return models.WaitlistEntry{}, err
+	}
+
+	head.Status = models.WaitlistPromoted
+	head.PromotedAt = sql.NullTime{Time: promotedAt, Valid: true}
+	head.ConfirmDeadline = sql.NullTime{Time: confirmDeadline, Valid: true}
+	err = tx.
+		Model(&models.WaitlistEntry{}).
+		Where("id = ?", head.ID).
+		UpdateColumns(map[string]any{
+			"status":           head.Status,
+			"promoted_at":      head.PromotedAt,
+			"confirm_deadline": head.ConfirmDeadline,
+		}).
+		Error
+	if err != nil {
+		tx.Rollback()
+		return models.WaitlistEntry{}, err
+	}
+
+	return head, tx.Commit().Error
+}
+
+// commitNotPromoted commits tx in which nobody was promoted from the waitlist.
+func commitNotPromoted(tx *gorm.DB) error {
+	err := tx.Commit().Error
+	if err != nil {
+		return err
+	}
+
+	return models.ErrNotFound
+}
+
+// StartNextOccurrence moves a recurring proposal event to its next occurrence with all slots free.
+// Members waiting for a slot in the finished occurrence are dropped from the waitlist.
+func (p *ProposalEvent) StartNextOccurrence(ctx context.Context, eventID uint, occurrence int, endDate time.Time) error {
+	tx := p.DBConnector.DB.WithContext(ctx).Begin()
+	result := tx.
 		Model(&models.ProposalEvent{}).
-		Select("RemainingHelps").
-		Updates(&oldEvent).
+		Where("id = ?", eventID).
+		Where("occurrence < ?", occurrence).
+		UpdateColumns(map[string]any{
+			"occurrence":      occurrence,
+			"end_date":        endDate,
+			"remaining_helps": gorm.Expr("max_concurrent_requests"),
+		})
+	if result.Error != nil {
+		tx.Rollback()
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return tx.Commit().Error
+	}
+
+	err := tx.
+		Model(&models.WaitlistEntry{}).
+		Where("event_id = ?", eventID).
+		Where("status IN (?)", []models.WaitlistStatus{models.WaitlistWaiting, models.WaitlistPromoted}).
+		Update("status", models.WaitlistExpired).
+		Error
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+// GetExpiredProposalEvents returns up to limit active events with id greater than afterID
+// whose end date is not later than now. Only the event rows are loaded.
+func (p *ProposalEvent) GetExpiredProposalEvents(ctx context.Context, now time.Time, afterID uint, limit int) ([]models.ProposalEvent, error) {
+	events := make([]models.ProposalEvent, 0)
+	err := p.DBConnector.DB.
+		Where("status = ?", models.Active).
+		Where("is_deleted = ?", false).
+		Where("end_date <= ?", now).
+		Where("id > ?", afterID).
+		Order("id").
+		Limit(limit).
+		Find(&events).
 		WithContext(ctx).
 		Error
-	return err
+	return events, err
+}
+
+// GetScheduledProposalEvents returns drafts whose publish date is not later than now.
+func (p *ProposalEvent) GetScheduledProposalEvents(ctx context.Context, now time.Time) ([]models.ProposalEvent, error) {
+	events := make([]models.ProposalEvent, 0)
+	err := p.DBConnector.DB.
+		Where("status = ?", models.InActive).
+		Where("is_deleted = ?", false).
+		Where("publish_at <= ?", now).
+		Find(&events).
+		WithContext(ctx).
+		Error
+	return events, err
 }
 
 func (p *ProposalEvent) GetProposalEventStatistics(ctx context.Context, creatorID uint, from, to time.Time) ([]models.Transaction, error) {
@@ -134,8 +414,13 @@ func (p *ProposalEvent) GetProposalEventsWithSearchAndSort(ctx context.Context,
 	events := []models.ProposalEvent{}
 	searchValues = p.removeEmptySearchValues(searchValues)
 	query := db.
-		Order(fmt.Sprintf("propositional_event.%s %s", searchValues.SortField, strings.ToUpper(string(*searchValues.Order)))).
-		Where("status IN (?)", searchValues.State)
+		Where("status IN (?)", searchValues.State).
+		Not("propositional_event.status = ?", models.InActive)
+	if searchValues.SortField == models.DistanceSortField {
+		query = query.Order(p.DBConnector.orderByDistance(models.ProposalEventType, "propositional_event", *searchValues.Near, *searchValues.Order))
+	} else {
+		query = query.Order(fmt.Sprintf("propositional_event.%s %s", searchValues.SortField, strings.ToUpper(string(*searchValues.Order))))
+	}
 	query = query.Debug()
 
 	if searchValues.Name != nil && *searchValues.Name != "" {
@@ -144,12 +429,16 @@ func (p *ProposalEvent) GetProposalEventsWithSearchAndSort(ctx context.Context,
 	if searchValues.SearcherID != nil {
 		query = query.Not("propositional_event.author_id = ?", searchValues.SearcherID)
 	}
+	if searchValues.CreatorID != nil {
+		query = query.Where("propositional_event.author_id = ?", *searchValues.CreatorID)
+	}
 
 	if searchValues.TakingPart != nil && searchValues.SearcherID != nil {
 		if *searchValues.TakingPart {
-			query = query.Joins("LEFT JOIN transaction ON propositional_event.id = transaction.event_id").
-				Where("transaction.creator_id = ?", searchValues.SearcherID).
-				Distinct()
+			subQuery := db.Table("transaction").Select("event_id").
+				Where("creator_id = ? AND event_type = ?", searchValues.SearcherID, models.ProposalEventType)
+
+			query = query.Where("propositional_event.id IN (?)", subQuery)
 		}
 	}
 
@@ -189,6 +478,11 @@ func (p *ProposalEvent) GetProposalEventsWithSearchAndSort(ctx context.Context,
 		query = query.Where("propositional_event.id IN (?)", subQuery)
 	}
 
+	if searchValues.Near != nil && searchValues.RadiusKm > 0 {
+		query = query.Where("propositional_event.id IN (?)",
+			p.DBConnector.eventsWithin(models.ProposalEventType, *searchValues.Near, searchValues.RadiusKm))
+	}
+
 	pagination, err := p.calculatePagination(ctx, searchValues, query)
 	if err != nil {
 		zlog.Log.Error(err, "could not calculate pagination value")
@@ -241,6 +535,7 @@ func (p *ProposalEvent) removeEmptySearchValues(searchValues models.ProposalEven
 	}
 
 	newSearchValues.SearcherID = searchValues.SearcherID
+	newSearchValues.CreatorID = searchValues.CreatorID
 
 	if searchValues.TakingPart != nil {
 		newSearchValues.TakingPart = searchValues.TakingPart
@@ -260,6 +555,11 @@ func (p *ProposalEvent) removeEmptySearchValues(searchValues models.ProposalEven
 	} else {
 		newSearchValues.Location = searchValues.Location
 	}
+	newSearchValues.Near = searchValues.Near
+	newSearchValues.RadiusKm = searchValues.RadiusKm
+	if searchValues.Near == nil && newSearchValues.SortField == models.DistanceSortField {
+		newSearchValues.SortField = defaultSortField
+	}
 	if searchValues.Order == nil || *searchValues.Order == "" {
 		newSearchValues.Order = &models.AscendingOrder
 	} else {
@@ -315,6 +615,11 @@ func (p *ProposalEvent) CreateProposalEvent(ctx context.Context, event models.Pr
 	}
 	if !event.Location.IsEmpty() {
 		event.Location.EventID = event.ID
+		err = event.Location.Locate()
+		if err != nil {
+			tx.Rollback()
+			return 0, err
+		}
 		err = tx.
 			Create(&event.Location).
 			WithContext(ctx).
@@ -343,6 +648,14 @@ func (p *ProposalEvent) CreateProposalEvent(ctx context.Context, event models.Pr
 			}
 		}
 	}
+	for _, timeSlot := range event.TimeSlots {
+		timeSlot.EventID = event.ID
+		err = tx.Create(&timeSlot).WithContext(ctx).Error
+		if err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+	}
 
 	return event.ID, tx.Commit().Error
 }
@@ -380,7 +693,7 @@ func (p *ProposalEvent) GetEvents(ctx context.Context) ([]models.ProposalEvent,
 	events := []models.ProposalEvent{}
 	resp := p.DBConnector.DB.
 		Where("is_deleted = ?", false).
-		Not("status = ?", models.Blocked).
+		Not("status IN (?)", []models.EventStatus{models.Blocked, models.InActive}).
 		Find(&events).
 		WithContext(ctx)
 
@@ -487,8 +800,8 @@ func (p *ProposalEvent) DeleteEvent(ctx context.Context, id uint) error {
 		Model(&models.Transaction{}).
 		Where("event_id = ?", id).
 		Where("event_type = ?", models.ProposalEventType).
-		Not("status IN (?)", []models.TransactionStatus{models.Completed, models.Interrupted, models.Canceled}).
-		Update("status", models.Canceled).
+		Not("transaction_status IN (?)", models.FinishedTransactionStatuses).
+		Update("transaction_status", models.Canceled).
 		WithContext(ctx).
 		Error
 	if err != nil {
@@ -647,6 +960,16 @@ func (p *ProposalEvent) updateMissingEventData(ctx context.Context, proposalEven
 		return models.ProposalEvent{}, err
 	}
 	proposalEvent.Comments = comments
+	updates, err := getEventUpdates(ctx, p.DBConnector.DB, proposalEvent.ID, models.ProposalEventType)
+	if err != nil {
+		return models.ProposalEvent{}, err
+	}
+	proposalEvent.Updates = updates
+	coOrganizers, err := getCoOrganizers(ctx, p.DBConnector.DB, proposalEvent.ID, models.ProposalEventType)
+	if err != nil {
+		return models.ProposalEvent{}, err
+	}
+	proposalEvent.CoOrganizers = coOrganizers
 	transactions, err := p.getProposalEventTransactions(ctx, proposalEvent.ID)
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 		return models.ProposalEvent{}, err
@@ -679,6 +1002,18 @@ func (p *ProposalEvent) updateMissingEventData(ctx context.Context, proposalEven
 	}
 	proposalEvent.Tags = tags
 
+	timeSlots := []models.TimeSlot{}
+	err = p.DBConnector.DB.
+		Where("event_id = ?", proposalEvent.ID).
+		Order("start_time").
+		Find(&timeSlots).
+		WithContext(ctx).
+		Error
+	if err != nil {
+		return models.ProposalEvent{}, err
+	}
+	proposalEvent.TimeSlots = timeSlots
+
 	for i, tag := range proposalEvent.Tags {
 		tagValues := []models.TagValue{}
 		err = p.DBConnector.DB.Where("tag_id = ?", tag.ID).
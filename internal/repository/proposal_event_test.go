@@ -0,0 +1,202 @@
+package repository_test
+
+import (
+	"Kurajj/configs"
+	"Kurajj/internal/models"
+	"Kurajj/internal/repository"
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testDBConfigEnv points to a db config file of a migrated postgres database,
+// tests that need a real database are skipped without it.
+const testDBConfigEnv = "KURAJJ_TEST_DB_CONFIG"
+
+func newTestConnector(t *testing.T) *repository.Connector {
+	configPath := os.Getenv(testDBConfigEnv)
+	if configPath == "" {
+		t.Skipf("%s is not set", testDBConfigEnv)
+	}
+
+	config, err := configs.NewDBConfigFromFile(configPath)
+	require.NoError(t, err)
+	connector, err := repository.NewConnector(config)
+	require.NoError(t, err)
+
+	return connector
+}
+
+func TestCreateTransactionWithSlotConcurrently(t *testing.T) {
+	connector := newTestConnector(t)
+	proposalEventRepository := repository.NewProposalEvent(repository.AWSConfig{}, connector)
+	ctx := context.Background()
+
+	const (
+		slots      = 5
+		responders = 50
+	)
+
+	member := models.User{}
+	err := connector.DB.Raw("INSERT INTO members (full_name) VALUES (?) RETURNING id", "slot test").
+		Scan(&member.ID).Error
+	require.NoError(t, err)
+
+	event := models.ProposalEvent{}
+	err = connector.DB.Raw(`INSERT INTO propositional_event (title, status, max_concurrent_requests, remaining_helps, author_id, is_deleted)
+		VALUES (?, ?, ?, ?, ?, false) RETURNING id`, "slot test", models.Active, slots, slots, member.ID).
+		Scan(&event.ID).Error
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		connector.DB.Exec("DELETE FROM transaction WHERE event_id = ? AND event_type = ?", event.ID, models.ProposalEventType)
+		connector.DB.Exec("DELETE FROM propositional_event WHERE id = ?", event.ID)
+		connector.DB.Exec("DELETE FROM members WHERE id = ?", member.ID)
+	})
+
+	var (
+		wg             sync.WaitGroup
+		mu             sync.Mutex
+		transactionIDs []uint
+		noFreeSlots    int
+	)
+	for i := 0; i < responders; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			id, err := proposalEventRepository.CreateTransactionWithSlot(ctx, models.Transaction{
+				CreatorID:         member.ID,
+				EventID:           event.ID,
+				EventType:         models.ProposalEventType,
+				CreationDate:      time.Now(),
+				TransactionStatus: models.Waiting,
+				ResponderStatus:   models.NotStarted,
+			})
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err == nil {
+				transactionIDs = append(transactionIDs, id)
+				return
+			}
+			assert.ErrorIs(t, err, models.ErrNoFreeSlots)
+			noFreeSlots++
+		}()
+	}
+	wg.Wait()
+
+	assert.Len(t, transactionIDs, slots)
+	assert.Equal(t, responders-slots, noFreeSlots)
+
+	remainingHelps := -1
+	err = connector.DB.Raw("SELECT remaining_helps FROM propositional_event WHERE id = ?", event.ID).
+		Scan(&remainingHelps).Error
+	require.NoError(t, err)
+	assert.Equal(t, 0, remainingHelps)
+
+	released := make([]bool, 2)
+	for i := range released {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			ok, err := proposalEventRepository.ReleaseTransactionSlot(ctx, transactionIDs[0])
+			assert.NoError(t, err)
+			released[i] = ok
+		}(i)
+	}
+	wg.Wait()
+
+	assert.ElementsMatch(t, []bool{true, false}, released)
+
+	err = connector.DB.Raw("SELECT remaining_helps FROM propositional_event WHERE id = ?", event.ID).
+		Scan(&remainingHelps).Error
+	require.NoError(t, err)
+	assert.Equal(t, 1, remainingHelps)
+}
+
+func TestChangeCapacityKeepsTakenSlots(t *testing.T) {
+	connector := newTestConnector(t)
+	proposalEventRepository := repository.NewProposalEvent(repository.AWSConfig{}, connector)
+	waitlistRepository := repository.NewWaitlist(connector)
+	ctx := context.Background()
+
+	member := models.User{}
+	err := connector.DB.Raw("INSERT INTO members (full_name) VALUES (?) RETURNING id", "capacity test").
+		Scan(&member.ID).Error
+	require.NoError(t, err)
+
+	event := models.ProposalEvent{}
+	err = connector.DB.Raw(`INSERT INTO propositional_event (title, status, max_concurrent_requests, remaining_helps, author_id, is_deleted)
+		VALUES (?, ?, ?, ?, ?, false) RETURNING id`, "capacity test", models.Active, 3, 3, member.ID).
+		Scan(&event.ID).Error
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		connector.DB.Exec("DELETE FROM proposal_event_waitlist WHERE event_id = ?", event.ID)
+		connector.DB.Exec("DELETE FROM transaction WHERE event_id = ? AND event_type = ?", event.ID, models.ProposalEventType)
+		connector.DB.Exec("DELETE FROM propositional_event WHERE id = ?", event.ID)
+		connector.DB.Exec("DELETE FROM members WHERE id = ?", member.ID)
+	})
+
+	transactionIDs := make([]uint, 0, 3)
+	for i := 0; i < 3; i++ {
+		id, err := proposalEventRepository.CreateTransactionWithSlot(ctx, models.Transaction{
+			CreatorID:         member.ID,
+			EventID:           event.ID,
+			EventType:         models.ProposalEventType,
+			CreationDate:      time.Now(),
+			TransactionStatus: models.Waiting,
+			ResponderStatus:   models.NotStarted,
+		})
+		require.NoError(t, err)
+		transactionIDs = append(transactionIDs, id)
+	}
+
+	err = proposalEventRepository.ChangeCapacity(ctx, event.ID, 2)
+	assert.ErrorIs(t, err, models.ErrCapacityBelowTakenSlots)
+
+	err = proposalEventRepository.ChangeCapacity(ctx, event.ID, 4)
+	require.NoError(t, err)
+
+	remainingHelps := -1
+	err = connector.DB.Raw("SELECT remaining_helps FROM propositional_event WHERE id = ?", event.ID).
+		Scan(&remainingHelps).Error
+	require.NoError(t, err)
+	assert.Equal(t, 1, remainingHelps)
+
+	_, err = proposalEventRepository.CreateTransactionWithSlot(ctx, models.Transaction{
+		CreatorID:         member.ID,
+		EventID:           event.ID,
+		EventType:         models.ProposalEventType,
+		CreationDate:      time.Now(),
+		TransactionStatus: models.Waiting,
+		ResponderStatus:   models.NotStarted,
+	})
+	require.NoError(t, err)
+
+	entryID, err := waitlistRepository.AddToWaitlist(ctx, models.WaitlistEntry{
+		EventID:      event.ID,
+		MemberID:     member.ID,
+		Status:       models.WaitlistWaiting,
+		CreationDate: time.Now(),
+	})
+	require.NoError(t, err)
+
+	now := time.Now()
+	head, err := proposalEventRepository.ReleaseTransactionSlotToWaitlist(ctx, transactionIDs[0], now, now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, entryID, head.ID)
+	assert.Equal(t, models.WaitlistPromoted, head.Status)
+
+	err = connector.DB.Raw("SELECT remaining_helps FROM propositional_event WHERE id = ?", event.ID).
+		Scan(&remainingHelps).Error
+	require.NoError(t, err)
+	assert.Equal(t, 0, remainingHelps)
+
+	_, err = proposalEventRepository.ReleaseTransactionSlotToWaitlist(ctx, transactionIDs[0], now, now.Add(time.Hour))
+	assert.ErrorIs(t, err, models.ErrNotFound)
+}
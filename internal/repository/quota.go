@@ -0,0 +1,167 @@
+package repository
+
+import (
+	"Kurajj/internal/models"
+	"context"
+	"errors"
+	"fmt"
+	"gorm.io/gorm"
+	"time"
+)
+
+func NewQuota(db *Connector) *Quota {
+	return &Quota{db}
+}
+
+type Quota struct {
+	*Connector
+}
+
+func (q *Quota) GetQuotaPolicies(ctx context.Context) ([]models.QuotaPolicy, error) {
+	policies := make([]models.QuotaPolicy, 0)
+	err := q.DB.
+		Order("tier, member_id").
+		Find(&policies).
+		WithContext(ctx).
+		Error
+	return policies, err
+}
+
+// GetMemberQuotaPolicies returns the member's override and the policies of the tiers the member belongs to.
+func (q *Quota) GetMemberQuotaPolicies(ctx context.Context, memberID uint) ([]models.QuotaPolicy, error) {
+	member := models.User{}
+	err := q.DB.
+		Select("id", "is_verified_organization").
+		Where("id = ?", memberID).
+		First(&member).
+		WithContext(ctx).
+		Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, models.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	tiers := []models.QuotaTier{models.DefaultQuotaTier}
+	if member.IsVerifiedOrganization {
+		tiers = append(tiers, models.VerifiedOrganizationQuotaTier)
+	}
+
+	policies := make([]models.QuotaPolicy, 0)
+	err = q.DB.
+		Where("member_id = ?", memberID).
+		Or("member_id IS NULL AND tier IN (?)", tiers).
+		Find(&policies).
+		WithContext(ctx).
+		Error
+	return policies, err
+}
+
+// SaveQuotaPolicy replaces the policy of the tier, or the override of the member for the member tier.
+func (q *Quota) SaveQuotaPolicy(ctx context.Context, policy models.QuotaPolicy) (uint, error) {
+	tx := q.DB.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return 0, tx.Error
+	}
+
+	existing := tx.Model(&models.QuotaPolicy{}).Where("tier = ?", policy.Tier)
+	if policy.MemberID.Valid {
+		existing = existing.Where("member_id = ?", policy.MemberID.Int64)
+	} else {
+		existing = existing.Where("member_id IS NULL")
+	}
+	var id uint
+	err := existing.Select("id").Limit(1).Scan(&id).Error
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	policy.ID = id
+	err = tx.Save(&policy).Error
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	return policy.ID, tx.Commit().Error
+}
+
+func (q *Quota) DeleteMemberQuotaPolicy(ctx context.Context, memberID uint) error {
+	return q.DB.
+		Where("tier = ?", models.MemberOverrideQuotaTier).
+		Where("member_id = ?", memberID).
+		Delete(&models.QuotaPolicy{}).
+		WithContext(ctx).
+		Error
+}
+
+func (q *Quota) SetVerifiedOrganization(ctx context.Context, memberID uint, verified bool) error {
+	resp := q.DB.
+		WithContext(ctx).
+		Model(&models.User{}).
+		Where("id = ?", memberID).
+		Update("is_verified_organization", verified)
+	if resp.Error != nil {
+		return resp.Error
+	}
+	if resp.RowsAffected == 0 {
+		return models.ErrNotFound
+	}
+
+	return nil
+}
+
+func (q *Quota) CountActiveEvents(ctx context.Context, memberID uint, eventType models.EventType) (int, error) {
+	var count int64
+	var err error
+	switch eventType {
+	case models.ProposalEventType:
+		err = q.DB.
+			Model(&models.ProposalEvent{}).
+			Where("author_id = ?", memberID).
+			Where("status = ?", models.Active).
+			Where("is_deleted = ?", false).
+			Count(&count).
+			WithContext(ctx).
+			Error
+	case models.HelpEventType:
+		err = q.DB.
+			Model(&models.HelpEvent{}).
+			Where("created_by = ?", memberID).
+			Where("status = ?", models.Active).
+			Where("is_deleted = ?", false).
+			Count(&count).
+			WithContext(ctx).
+			Error
+	default:
+		return 0, fmt.Errorf("unexpected event type %s", eventType)
+	}
+
+	return int(count), err
+}
+
+// CountPendingResponses counts the member's responses to events which were not accepted yet.
+func (q *Quota) CountPendingResponses(ctx context.Context, memberID uint) (int, error) {
+	var count int64
+	err := q.DB.
+		Model(&models.Transaction{}).
+		Where("creator_id = ?", memberID).
+		Where("transaction_status = ?", models.Waiting).
+		Count(&count).
+		WithContext(ctx).
+		Error
+	return int(count), err
+}
+
+func (q *Quota) CountCommentsSince(ctx context.Context, memberID uint, from time.Time) (int, error) {
+	var count int64
+	err := q.DB.
+		Model(&models.Comment{}).
+		Where("user_id = ?", memberID).
+		Where("creation_date >= ?", from).
+		Count(&count).
+		WithContext(ctx).
+		Error
+	return int(count), err
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"Kurajj/internal/models"
+	"context"
+	"errors"
+	"gorm.io/gorm"
+)
+
+func NewReport(db *Connector) *Report {
+	return &Report{db}
+}
+
+type Report struct {
+	*Connector
+}
+
+func (r *Report) CreateReport(ctx context.Context, report models.Report) (uint, error) {
+	err := r.DB.Create(&report).WithContext(ctx).Error
+	return report.ID, err
+}
+
+// GetEventReports returns reports of all transactions of the event, the oldest first.
+func (r *Report) GetEventReports(ctx context.Context, eventID uint, eventType models.EventType) ([]models.Report, error) {
+	reports := make([]models.Report, 0)
+	err := r.reportsWithTransaction().
+		Where("transaction.event_id = ?", eventID).
+		Where("report.event_type = ?", eventType).
+		Order("report.creation_date, report.id").
+		Find(&reports).
+		WithContext(ctx).
+		Error
+	return reports, err
+}
+
+func (r *Report) GetReportByID(ctx context.Context, id uint) (models.Report, error) {
+	report := models.Report{}
+	err := r.reportsWithTransaction().
+		Where("report.id = ?", id).
+		First(&report).
+		WithContext(ctx).
+		Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return models.Report{}, models.ErrNotFound
+	}
+
+	return report, err
+}
+
+func (r *Report) reportsWithTransaction() *gorm.DB {
+	return r.DB.
+		Model(&models.Report{}).
+		Select("report.*, transaction.creator_id AS transaction_creator_id").
+		Joins("JOIN transaction ON transaction.id = report.transaction_id")
+}
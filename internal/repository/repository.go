@@ -18,11 +18,18 @@ type HelpEventer interface {
 	UpdateNeeds(ctx context.Context, needs ...models.Need) error
 	GetHelpEventByTransactionID(ctx context.Context, transactionID models.ID) (models.HelpEvent, error)
 	UpdateHelpEvent(ctx context.Context, event models.HelpEvent) error
+	DeleteHelpEvent(ctx context.Context, id uint) error
 	GetUserHelpEvents(ctx context.Context, userID models.ID) ([]models.HelpEvent, error)
-	GetHelpEventsWithSearchAndSort(ctx context.Context,
-		searchValues models.HelpSearchInternal) (models.HelpEventPagination, error)
+	GetHelpEventsWithSearchAndSort(ctx context.Context, searchValues models.HelpSearchInternal,
+		now time.Time) (models.HelpEventPagination, error)
 	GetTransactionNeeds(ctx context.Context, transactionID models.ID) ([]models.Need, error)
 	GetHelpEventStatistics(ctx context.Context, id uint, from, to time.Time) ([]models.Transaction, error)
+	GetMoneyRaised(ctx context.Context, creatorID uint, from, to time.Time) ([]models.MoneyTotal, error)
+	GetScheduledHelpEvents(ctx context.Context, now time.Time) ([]models.HelpEvent, error)
+	GetExpiredHelpEvents(ctx context.Context, now time.Time, afterID models.ID, limit int) ([]models.HelpEvent, error)
+	CreateTransactionWithPledges(ctx context.Context, transaction models.Transaction, pledges []models.Need) (uint, error)
+	ReleaseTransactionPledges(ctx context.Context, transactionID uint) (bool, error)
+	ConfirmNeedsReceived(ctx context.Context, transactionID uint, needs []models.Need) error
 }
 
 type AdminCRUDer interface {
@@ -52,7 +59,17 @@ type ProposalEventer interface {
 	GetEvent(ctx context.Context, id uint) (models.ProposalEvent, error)
 	GetEvents(ctx context.Context) ([]models.ProposalEvent, error)
 	UpdateEvent(ctx context.Context, event models.ProposalEvent) error
-	UpdateRemainingHelps(ctx context.Context, eventID models.ID, increase bool, number int) error
+	CreateTransactionWithSlot(ctx context.Context, transaction models.Transaction) (uint, error)
+	ReleaseTransactionSlot(ctx context.Context, transactionID uint) (bool, error)
+	ReleaseTransactionSlotToWaitlist(ctx context.Context, transactionID uint,
+		promotedAt, confirmDeadline time.Time) (models.WaitlistEntry, error)
+	ReleaseSlot(ctx context.Context, eventID uint, promotedAt, confirmDeadline time.Time) (models.WaitlistEntry, error)
+	ChangeCapacity(ctx context.Context, eventID uint, capacity uint) error
+	StartNextOccurrence(ctx context.Context, eventID uint, occurrence int, endDate time.Time) error
+	GetScheduledProposalEvents(ctx context.Context, now time.Time) ([]models.ProposalEvent, error)
+	GetExpiredProposalEvents(ctx context.Context, now time.Time, afterID uint, limit int) ([]models.ProposalEvent, error)
+	GetTimeSlotBookingsToRemind(ctx context.Context, from, until time.Time) ([]models.TimeSlotBooking, error)
+	MarkTimeSlotReminded(ctx context.Context, transactionID uint) (bool, error)
 	DeleteEvent(ctx context.Context, id uint) error
 	GetUserProposalEvents(ctx context.Context, userID uint) ([]models.ProposalEvent, error)
 	GetProposalEventsWithSearchAndSort(ctx context.Context,
@@ -105,6 +122,7 @@ type Userer interface {
 
 type UserSearcher interface {
 	UpsertUserTags(ctx context.Context, userID uint, searchValues []models.MemberSearch) error
+	GetMembersBySearchValues(ctx context.Context, eventType models.EventType, tags []models.Tag) ([]uint, error)
 }
 
 type Complainer interface {
@@ -114,6 +132,88 @@ type Complainer interface {
 	BanEvent(ctx context.Context, eventID models.ID, eventType models.EventType) error
 }
 
+type Moderator interface {
+	CreateModerationItem(ctx context.Context, item models.ModerationItem) (uint, error)
+	GetModerationItems(ctx context.Context, status models.ModerationStatus) ([]models.ModerationItem, error)
+	GetModerationItemByID(ctx context.Context, id uint) (models.ModerationItem, error)
+	UpdateModerationItem(ctx context.Context, id uint, toUpdate map[string]any) error
+	GetBlockedWords(ctx context.Context) ([]models.BlockedWord, error)
+	CreateBlockedWord(ctx context.Context, word models.BlockedWord) (uint, error)
+	DeleteBlockedWord(ctx context.Context, id uint) error
+	GetUserRecentTexts(ctx context.Context, userID uint, from time.Time) ([]string, error)
+}
+
+type Waitlister interface {
+	AddToWaitlist(ctx context.Context, entry models.WaitlistEntry) (uint, error)
+	GetWaitlist(ctx context.Context, eventID uint) ([]models.WaitlistEntry, error)
+	GetMemberWaitlistEntry(ctx context.Context, eventID, memberID uint) (models.WaitlistEntry, error)
+	GetWaitlistHead(ctx context.Context, eventID uint) (models.WaitlistEntry, error)
+	UpdateWaitlistEntry(ctx context.Context, id uint, toUpdate map[string]any) error
+	GetExpiredWaitlistPromotions(ctx context.Context, now time.Time) ([]models.WaitlistEntry, error)
+	ExpireWaitlist(ctx context.Context, eventID uint) error
+	ExpireClosedEventWaitlists(ctx context.Context) error
+}
+
+type Reporter interface {
+	CreateReport(ctx context.Context, report models.Report) (uint, error)
+	GetEventReports(ctx context.Context, eventID uint, eventType models.EventType) ([]models.Report, error)
+	GetReportByID(ctx context.Context, id uint) (models.Report, error)
+}
+
+type Quotaer interface {
+	GetQuotaPolicies(ctx context.Context) ([]models.QuotaPolicy, error)
+	GetMemberQuotaPolicies(ctx context.Context, memberID uint) ([]models.QuotaPolicy, error)
+	SaveQuotaPolicy(ctx context.Context, policy models.QuotaPolicy) (uint, error)
+	DeleteMemberQuotaPolicy(ctx context.Context, memberID uint) error
+	SetVerifiedOrganization(ctx context.Context, memberID uint, verified bool) error
+	CountActiveEvents(ctx context.Context, memberID uint, eventType models.EventType) (int, error)
+	CountPendingResponses(ctx context.Context, memberID uint) (int, error)
+	CountCommentsSince(ctx context.Context, memberID uint, from time.Time) (int, error)
+}
+
+type EventTemplater interface {
+	CreateEventTemplate(ctx context.Context, template models.EventTemplate) (uint, error)
+	GetMemberEventTemplates(ctx context.Context, memberID uint) ([]models.EventTemplate, error)
+	GetEventTemplateByID(ctx context.Context, id uint) (models.EventTemplate, error)
+	DeleteEventTemplate(ctx context.Context, id uint) error
+}
+
+type Uniter interface {
+	GetUnits(ctx context.Context) ([]models.UnitDefinition, error)
+}
+
+type EventUpdater interface {
+	CreateEventUpdate(ctx context.Context, update models.EventUpdate) (uint, error)
+	GetEventUpdates(ctx context.Context, eventID uint, eventType models.EventType) ([]models.EventUpdate, error)
+	GetEventUpdateByID(ctx context.Context, id uint) (models.EventUpdate, error)
+	DeleteEventUpdate(ctx context.Context, id uint) error
+	FollowEvent(ctx context.Context, follower models.EventFollower) error
+	UnfollowEvent(ctx context.Context, eventID uint, eventType models.EventType, memberID uint) error
+	GetEventFollowers(ctx context.Context, eventID uint, eventType models.EventType) ([]uint, error)
+}
+
+type IntakeLedgerer interface {
+	RecordIntake(ctx context.Context, entries ...models.IntakeEntry) error
+	GetEventIntake(ctx context.Context, eventID uint) ([]models.IntakeEntry, error)
+}
+
+type DropPointer interface {
+	CreateDropPoint(ctx context.Context, point models.DropPoint) (uint, error)
+	DeleteDropPoint(ctx context.Context, eventID, id uint) error
+	GetDropPointArrivals(ctx context.Context, pointID uint, from, to time.Time) ([]models.DropPointArrival, error)
+}
+
+type CoOrganizerer interface {
+	AddCoOrganizer(ctx context.Context, coOrganizer models.EventCoOrganizer) error
+	RemoveCoOrganizer(ctx context.Context, eventID uint, eventType models.EventType, memberID uint) error
+	GetEventCoOrganizers(ctx context.Context, eventID uint, eventType models.EventType) ([]models.EventCoOrganizer, error)
+}
+
+type LeaderElector interface {
+	IsLeader(ctx context.Context) (bool, error)
+	ResignLeadership(ctx context.Context) error
+}
+
 type Repository struct {
 	Userer
 	AdminCRUDer
@@ -126,6 +226,17 @@ type Repository struct {
 	Notifier
 	HelpEventer
 	Complainer
+	Moderator
+	Waitlister
+	EventTemplater
+	Reporter
+	Quotaer
+	Uniter
+	EventUpdater
+	IntakeLedgerer
+	DropPointer
+	CoOrganizerer
+	LeaderElector
 }
 
 func New(dbConnector *Connector, config AWSConfig) *Repository {
@@ -141,5 +252,16 @@ func New(dbConnector *Connector, config AWSConfig) *Repository {
 		NewTransactionNotification(dbConnector),
 		NewHelpEvent(config, dbConnector),
 		NewComplaint(dbConnector),
+		NewModeration(dbConnector),
+		NewWaitlist(dbConnector),
+		NewEventTemplate(dbConnector),
+		NewReport(dbConnector),
+		NewQuota(dbConnector),
+		NewUnit(dbConnector),
+		NewEventUpdate(dbConnector),
+		NewIntake(dbConnector),
+		NewDropPoint(dbConnector),
+		NewCoOrganizer(dbConnector),
+		NewLeadership(dbConnector),
 	}
 }
@@ -12,23 +12,31 @@ type Tag struct {
 }
 
 func (t *Tag) CreateTag(ctx context.Context, tag models.Tag) error {
-	tx := t.DBConnector.DB.Begin()
+	tx := t.DBConnector.DB.WithContext(ctx).Begin()
 	defer func() {
 		if r := recover(); r != nil {
 			tx.Rollback()
 		}
 	}()
-	err := t.DBConnector.DB.Create(&tag).WithContext(ctx).Error
+	err := createTag(tx, tag)
 	if err != nil {
 		tx.Rollback()
 		return err
 	}
 
+	return tx.Commit().Error
+}
+
+func createTag(db *gorm.DB, tag models.Tag) error {
+	err := db.Create(&tag).Error
+	if err != nil {
+		return err
+	}
+
 	for _, tagValue := range tag.Values {
 		tagValue.TagID = tag.ID
-		err = t.DBConnector.DB.Create(&tagValue).WithContext(ctx).Error
+		err = db.Create(&tagValue).Error
 		if err != nil {
-			tx.Rollback()
 			return err
 		}
 	}
@@ -36,25 +44,38 @@ func (t *Tag) CreateTag(ctx context.Context, tag models.Tag) error {
 	return nil
 }
 
+// UpsertTags replaces the tags of the event and the location searches by distance rely on
+// in one transaction, so searches never see the old tags with the new location or the other way around.
+// The stored coordinates are kept while the location stays in the same settlement, as they may be entered by the author.
 func (t *Tag) UpsertTags(ctx context.Context, eventType models.EventType, eventID uint, tags []models.Tag) error {
-	tx := t.DBConnector.DB.Begin()
+	tx := t.DBConnector.DB.WithContext(ctx).Begin()
 	defer func() {
 		if r := recover(); r != nil {
 			tx.Rollback()
 		}
 	}()
-	err := t.DeleteAllTagsByEvent(ctx, eventID, eventType)
+	err := deleteAllTagsByEvent(tx, eventID, eventType)
 	if err != nil {
 		tx.Rollback()
 		return err
 	}
 	for _, tag := range tags {
 		if tag.Title == "location" {
+			stored := models.Address{}
+			err = tx.
+				Where("event_type = ?", eventType).
+				Where("event_id = ?", eventID).
+				Limit(1).
+				Find(&stored).
+				Error
+			if err != nil {
+				tx.Rollback()
+				return err
+			}
 			err = tx.Model(&models.Address{}).
 				Where("event_type = ?", eventType).
 				Where("event_id = ?", eventID).
 				Delete(&models.Address{}).
-				WithContext(ctx).
 				Error
 			if err != nil && !errors.Is(gorm.ErrRecordNotFound, err) {
 				tx.Rollback()
@@ -69,15 +90,24 @@ func (t *Tag) UpsertTags(ctx context.Context, eventType models.EventType, eventI
 					EventType:    eventType,
 					EventID:      eventID,
 				}
-				err = tx.Create(&location).WithContext(ctx).Error
+				if stored.Region == location.Region && stored.City == location.City {
+					location.Latitude, location.Longitude = stored.Latitude, stored.Longitude
+				}
+				err = location.Locate()
+				if err != nil {
+					tx.Rollback()
+					return err
+				}
+				err = tx.Create(&location).Error
 				if err != nil {
+					tx.Rollback()
 					return err
 				}
 			}
 
 			continue
 		}
-		err = t.CreateTag(ctx, tag)
+		err = createTag(tx, tag)
 		if err != nil {
 			tx.Rollback()
 			return err
@@ -116,11 +146,14 @@ func (t *Tag) GetTagsByEvent(ctx context.Context, eventID uint, eventType models
 }
 
 func (t *Tag) DeleteAllTagsByEvent(ctx context.Context, eventID uint, eventType models.EventType) error {
-	err := t.DBConnector.DB.
+	return deleteAllTagsByEvent(t.DBConnector.DB.WithContext(ctx), eventID, eventType)
+}
+
+func deleteAllTagsByEvent(db *gorm.DB, eventID uint, eventType models.EventType) error {
+	err := db.
 		Where("event_type = ?", eventType).
 		Where("event_id = ?", eventID).
 		Delete(&models.Tag{}).
-		WithContext(ctx).
 		Error
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 		return err
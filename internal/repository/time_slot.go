@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"Kurajj/internal/models"
+	"context"
+	"gorm.io/gorm"
+	"time"
+)
+
+// bookTimeSlot takes a place of the event's time slot with a single conditional UPDATE,
+// the slot is never booked over its capacity.
+func bookTimeSlot(db *gorm.DB, eventID, timeSlotID uint) error {
+	result := db.
+		Model(&models.TimeSlot{}).
+		Where("id = ?", timeSlotID).
+		Where("event_id = ?", eventID).
+		Where("booked < capacity").
+		UpdateColumn("booked", gorm.Expr("booked + 1"))
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return models.ErrTimeSlotFull
+	}
+
+	return nil
+}
+
+func releaseTimeSlot(db *gorm.DB, timeSlotID uint) error {
+	return db.
+		Model(&models.TimeSlot{}).
+		Where("id = ?", timeSlotID).
+		Where("booked > 0").
+		UpdateColumn("booked", gorm.Expr("booked - 1")).
+		Error
+}
+
+// GetTimeSlotBookingsToRemind returns bookings of unfinished transactions whose time slot starts
+// after from and not later than until, and whose participants were not reminded yet.
+func (p *ProposalEvent) GetTimeSlotBookingsToRemind(ctx context.Context, from, until time.Time) ([]models.TimeSlotBooking, error) {
+	bookings := make([]models.TimeSlotBooking, 0)
+	err := p.DBConnector.DB.
+		Table("transaction").
+		Select("transaction.id AS transaction_id, transaction.event_id, transaction.creator_id AS responder_id, "+
+			"propositional_event.author_id, proposal_event_time_slot.start_time").
+		Joins("JOIN proposal_event_time_slot ON proposal_event_time_slot.id = transaction.time_slot_id").
+		Joins("JOIN propositional_event ON propositional_event.id = transaction.event_id").
+		Where("transaction.time_slot_reminded = ?", false).
+		Where("transaction.slot_released = ?", false).
+		Not("transaction.transaction_status IN (?)", models.FinishedTransactionStatuses).
+		Where("proposal_event_time_slot.start_time > ?", from).
+		Where("proposal_event_time_slot.start_time <= ?", until).
+		Scan(&bookings).
+		WithContext(ctx).
+		Error
+	return bookings, err
+}
+
+// MarkTimeSlotReminded returns false when the participants of the transaction were already reminded.
+func (p *ProposalEvent) MarkTimeSlotReminded(ctx context.Context, transactionID uint) (bool, error) {
+	result := p.DBConnector.DB.
+		WithContext(ctx).
+		Model(&models.Transaction{}).
+		Where("id = ?", transactionID).
+		Where("time_slot_reminded = ?", false).
+		UpdateColumn("time_slot_reminded", true)
+	return result.RowsAffected != 0, result.Error
+}
@@ -15,11 +15,14 @@ type Transaction struct {
 	DBConnector *Connector
 }
 
+// GetGlobalStatistics returns the transactions created in the period, except the ones of deleted help events.
 func (t *Transaction) GetGlobalStatistics(ctx context.Context, from, to time.Time) ([]models.Transaction, error) {
 	transactions := []models.Transaction{}
 	err := t.DBConnector.DB.
 		Where("creation_date >= ? AND creation_date <= ?",
 			from, to).
+		Not("event_type = ? AND event_id IN (?)", models.HelpEventType,
+			t.DBConnector.DB.Model(&models.HelpEvent{}).Select("id").Where("is_deleted = ?", true)).
 		Find(&transactions).
 		WithContext(ctx).
 		Error
@@ -135,7 +138,7 @@ func (t *Transaction) GetCurrentEventTransactions(ctx context.Context, eventID u
 	err := t.DBConnector.DB.
 		Where("event_id = ?", eventID).
 		Where("event_type = ?", eventType).
-		Not("status IN (?)", []models.TransactionStatus{models.Completed, models.Interrupted, models.Canceled}).
+		Not("transaction_status IN (?)", models.FinishedTransactionStatuses).
 		Find(&transactions).WithContext(ctx).
 		Error
 
@@ -147,8 +150,8 @@ func (t *Transaction) UpdateAllNotFinishedTransactions(ctx context.Context, even
 		Model(&models.Transaction{}).
 		Where("event_id = ?", eventID).
 		Where("event_type = ?", eventType).
-		Not("status IN (?)", []models.TransactionStatus{models.Completed, models.Interrupted, models.Canceled}).
-		Update("status", newStatus).
+		Not("transaction_status IN (?)", models.FinishedTransactionStatuses).
+		Update("transaction_status", newStatus).
 		WithContext(ctx).
 		Error
 }
@@ -50,16 +50,10 @@ func (t *TransactionNotification) GetByMember(ctx context.Context, userID uint)
 	}
 
 	for i, notification := range notifications {
-		transaction := models.Transaction{}
-		err = t.DBConnector.DB.Where("id = ?", notification.TransactionID).First(&transaction).WithContext(ctx).Error
-		if err != nil {
-			zlog.Log.Error(err, "could not find any of Transaction")
-			return nil, err
-		}
 		switch notification.EventType {
 		case models.ProposalEventType:
 			event := models.ProposalEvent{}
-			err = t.DBConnector.DB.Where("id = ?", transaction.EventID).First(&event).WithContext(ctx).Error
+			err = t.DBConnector.DB.Where("id = ?", notification.EventID).First(&event).WithContext(ctx).Error
 			if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 				return nil, err
 			}
@@ -67,7 +61,7 @@ func (t *TransactionNotification) GetByMember(ctx context.Context, userID uint)
 			notifications[i].EventTitle = event.Title
 		case models.HelpEventType:
 			event := models.HelpEvent{}
-			err = t.DBConnector.DB.Where("id = ?", transaction.EventID).First(&event).WithContext(ctx).Error
+			err = t.DBConnector.DB.Where("id = ?", notification.EventID).First(&event).WithContext(ctx).Error
 			if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 				return nil, err
 			}
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"Kurajj/internal/models"
+	"context"
+)
+
+func NewUnit(db *Connector) *Unit {
+	return &Unit{db}
+}
+
+type Unit struct {
+	*Connector
+}
+
+func (u *Unit) GetUnits(ctx context.Context) ([]models.UnitDefinition, error) {
+	units := make([]models.UnitDefinition, 0)
+	err := u.DB.
+		Order("dimension, factor, code").
+		Find(&units).
+		WithContext(ctx).
+		Error
+	return units, err
+}
@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"Kurajj/internal/models"
+	"fmt"
+	"gorm.io/gorm/clause"
+	"strings"
+	"time"
+)
+
+// urgencyLevelDays is how many days closer to its end date each urgency level above normal moves an event.
+const urgencyLevelDays = 7
+
+// helpEventUrgency is the highest urgency of the help event and of its event needs that are not received yet.
+const helpEventUrgency = `GREATEST(help_event.urgency, (SELECT MAX(need.urgency) FROM need
+	WHERE need.help_event_id = help_event.id AND need.transaction_id IS NULL AND
+		(need.received_total < need.amount OR need.received_total_minor < need.amount_minor)))`
+
+// orderByUrgency sorts help events by the days left until their end date minus urgencyLevelDays
+// for every urgency level, so the ascending order puts the events needing help soonest first.
+// The days are counted from the application now rather than the database one.
+func orderByUrgency(order models.Order, now time.Time) clause.OrderBy {
+	return clause.OrderBy{
+		Expression: clause.Expr{
+			SQL: fmt.Sprintf(`EXTRACT(EPOCH FROM (help_event.end_date - ?::timestamp)) / 86400 -
+	%d * (array_position(enum_range(NULL::priority_rate), %s) - 1) %s`,
+				urgencyLevelDays, helpEventUrgency, strings.ToUpper(string(order))),
+			Vars:               []any{now},
+			WithoutParentheses: true,
+		},
+	}
+}
+
+// urgencyIn keeps the help events whose urgency is one of the urgencies.
+func urgencyIn(urgencies []models.Urgency) clause.Expr {
+	return clause.Expr{
+		SQL:  helpEventUrgency + " IN (?)",
+		Vars: []any{urgencies},
+	}
+}
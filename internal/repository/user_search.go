@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"gorm.io/gorm"
+	"strings"
 )
 
 type UserSearch struct {
@@ -72,6 +73,38 @@ func (t *UserSearch) deleteAllUserSearchValues(ctx context.Context, userID uint)
 	return nil
 }
 
+// GetMembersBySearchValues returns members who saved a search value of the event type
+// that matches any of the tags by title and value.
+func (t *UserSearch) GetMembersBySearchValues(ctx context.Context, eventType models.EventType, tags []models.Tag) ([]uint, error) {
+	memberIDs := make([]uint, 0)
+	db := t.DBConnector.DB.Session(&gorm.Session{})
+	matches := db.Where("1 = 0")
+	for _, tag := range tags {
+		values := make([]string, 0, len(tag.Values))
+		for _, value := range tag.Values {
+			if value.Value != "" {
+				values = append(values, strings.ToLower(value.Value))
+			}
+		}
+		if len(values) == 0 {
+			continue
+		}
+		matches = matches.Or("LOWER(member_search.title) = ? AND LOWER(member_search_value.value) IN (?)",
+			strings.ToLower(tag.Title), values)
+	}
+
+	err := db.
+		Model(&models.MemberSearch{}).
+		Distinct("member_search.member_id").
+		Joins("JOIN member_search_value ON member_search_value.member_search_id = member_search.id").
+		Where("member_search.event_type = ?", eventType).
+		Where(matches).
+		Pluck("member_search.member_id", &memberIDs).
+		WithContext(ctx).
+		Error
+	return memberIDs, err
+}
+
 func NewUserSearch(DBConnector *Connector) *UserSearch {
 	return &UserSearch{DBConnector: DBConnector}
 }
@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"Kurajj/internal/models"
+	"context"
+	"errors"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+	"time"
+)
+
+func NewWaitlist(db *Connector) *Waitlist {
+	return &Waitlist{db}
+}
+
+type Waitlist struct {
+	*Connector
+}
+
+func (w *Waitlist) AddToWaitlist(ctx context.Context, entry models.WaitlistEntry) (uint, error) {
+	err := w.DB.Create(&entry).WithContext(ctx).Error
+	return entry.ID, err
+}
+
+// GetWaitlist returns members that are still waiting or were promoted and have not confirmed yet,
+// in the order they joined.
+func (w *Waitlist) GetWaitlist(ctx context.Context, eventID uint) ([]models.WaitlistEntry, error) {
+	entries := make([]models.WaitlistEntry, 0)
+	err := w.DB.
+		Where("event_id = ?", eventID).
+		Where("status IN (?)", []models.WaitlistStatus{models.WaitlistWaiting, models.WaitlistPromoted}).
+		Order("creation_date, id").
+		Find(&entries).
+		WithContext(ctx).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	for i, entry := range entries {
+		member := models.User{}
+		err = w.DB.Where("id = ?", entry.MemberID).First(&member).WithContext(ctx).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+		entries[i].User = models.UserShortInfo{
+			ID:              member.ID,
+			Username:        member.FullName,
+			ProfileImageURL: member.AvatarImagePath,
+			PhoneNumber:     models.Telephone(member.Telephone),
+		}
+	}
+
+	return entries, nil
+}
+
+func (w *Waitlist) GetMemberWaitlistEntry(ctx context.Context, eventID, memberID uint) (models.WaitlistEntry, error) {
+	entry := models.WaitlistEntry{}
+	err := w.DB.
+		Where("event_id = ?", eventID).
+		Where("member_id = ?", memberID).
+		Where("status IN (?)", []models.WaitlistStatus{models.WaitlistWaiting, models.WaitlistPromoted}).
+		First(&entry).
+		WithContext(ctx).
+		Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return models.WaitlistEntry{}, models.ErrNotFound
+	}
+
+	return entry, err
+}
+
+func (w *Waitlist) GetWaitlistHead(ctx context.Context, eventID uint) (models.WaitlistEntry, error) {
+	entry := models.WaitlistEntry{}
+	err := w.DB.
+		Where("event_id = ?", eventID).
+		Where("status = ?", models.WaitlistWaiting).
+		Order("creation_date, id").
+		First(&entry).
+		WithContext(ctx).
+		Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return models.WaitlistEntry{}, models.ErrNotFound
+	}
+
+	return entry, err
+}
+
+func (w *Waitlist) UpdateWaitlistEntry(ctx context.Context, id uint, toUpdate map[string]any) error {
+	return w.DB.
+		Model(&models.WaitlistEntry{}).
+		Select(lo.Keys(toUpdate)).
+		Where("id = ?", id).
+		Updates(toUpdate).
+		WithContext(ctx).
+		Error
+}
+
+// ExpireWaitlist expires the entries still waiting or promoted in the waitlist of the event.
+func (w *Waitlist) ExpireWaitlist(ctx context.Context, eventID uint) error {
+	return w.DB.WithContext(ctx).
+		Model(&models.WaitlistEntry{}).
+		Where("event_id = ?", eventID).
+		Where("status IN (?)", []models.WaitlistStatus{models.WaitlistWaiting, models.WaitlistPromoted}).
+		Update("status", models.WaitlistExpired).
+		Error
+}
+
+// ExpireClosedEventWaitlists expires the entries still waiting or promoted in the waitlists of events
+// which are done, blocked or deleted.
+func (w *Waitlist) ExpireClosedEventWaitlists(ctx context.Context) error {
+	return w.DB.WithContext(ctx).
+		Model(&models.WaitlistEntry{}).
+		Where("status IN (?)", []models.WaitlistStatus{models.WaitlistWaiting, models.WaitlistPromoted}).
+		Where("event_id IN (?)", w.DB.Model(&models.ProposalEvent{}).Select("id").
+			Where("status IN (?) OR is_deleted = ?", []models.EventStatus{models.Done, models.Blocked}, true)).
+		Update("status", models.WaitlistExpired).
+		Error
+}
+
+func (w *Waitlist) GetExpiredWaitlistPromotions(ctx context.Context, now time.Time) ([]models.WaitlistEntry, error) {
+	entries := make([]models.WaitlistEntry, 0)
+	err := w.DB.
+		Where("status = ?", models.WaitlistPromoted).
+		Where("confirm_deadline < ?", now).
+		Find(&entries).
+		WithContext(ctx).
+		Error
+	return entries, err
+}
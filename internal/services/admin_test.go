@@ -34,6 +34,14 @@ func TestCreateAdmin(t *testing.T) {
 		},
 	}
 
+	repo.EXPECT().
+		GetMemberQuotaPolicies(context.TODO(), proposalEvent.AuthorID).Return([]models.QuotaPolicy{}, nil)
+	repo.EXPECT().
+		CountActiveEvents(context.TODO(), proposalEvent.AuthorID, models.ProposalEventType).Return(0, nil)
+	repo.EXPECT().
+		GetBlockedWords(context.TODO()).Return([]models.BlockedWord{}, nil)
+	repo.EXPECT().
+		GetUserRecentTexts(context.TODO(), proposalEvent.AuthorID, gomock.Any()).Return([]string{}, nil)
 	repo.EXPECT().
 		CreateProposalEvent(context.TODO(), proposalEvent)
 
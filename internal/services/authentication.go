@@ -26,6 +26,7 @@ type Authenticator interface {
 	SignIn(ctx context.Context, user models.User) (models.SignedInUser, error)
 	GetUserByRefreshToken(ctx context.Context, token string) (models.SignedInUser, error)
 	ParseToken(accessToken string) (uint, error)
+	IsAdmin(ctx context.Context, id uint) (bool, error)
 	NewRefreshToken() (string, error)
 	RefreshTokens(ctx context.Context, refreshToken string) (models.Tokens, error)
 	ConfirmEmail(ctx context.Context, email string) error
@@ -283,6 +284,19 @@ func (a *Authentication) ParseToken(accessToken string) (uint, error) {
 	return claims.ID, nil
 }
 
+// IsAdmin checks that the member is an active admin, deleted and blocked members are not.
+func (a *Authentication) IsAdmin(ctx context.Context, id uint) (bool, error) {
+	user, err := a.repo.GetUserInfo(ctx, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return user.IsAdmin, nil
+}
+
 func (a *Authentication) SignIn(ctx context.Context, user models.User) (models.SignedInUser, error) {
 	user.Password = hash.GenerateHash(user.Password, a.authConfig.Salt)
 	user.SearchIndex = hash.GenerateHash(user.Email, a.authConfig.Salt)
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"Kurajj/internal/models"
+	"context"
+	"errors"
+	"fmt"
+	"gorm.io/gorm"
+	"time"
+)
+
+func NewCoOrganizer(repo Repositorier) *CoOrganizer {
+	return &CoOrganizer{repo: repo}
+}
+
+type CoOrganizer struct {
+	repo Repositorier
+}
+
+// AddCoOrganizer lets the member manage the author's event with the role and notifies them,
+// adding the member again changes their role.
+func (c *CoOrganizer) AddCoOrganizer(ctx context.Context, eventID uint, eventType models.EventType, authorID uint,
+	request models.CoOrganizerRequest) error {
+	err := request.Role.Validate()
+	if err != nil {
+		return err
+	}
+	eventAuthorID, _, err := eventOrganizers(ctx, c.repo, eventID, eventType)
+	if err != nil {
+		return err
+	}
+	if eventAuthorID != authorID {
+		return models.ErrNotFound
+	}
+	if request.MemberID == authorID {
+		return fmt.Errorf("%w: the author already organizes the event", models.ErrInvalidCoOrganizer)
+	}
+	_, err = c.repo.GetUserInfo(ctx, request.MemberID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return fmt.Errorf("%w: member %d is not found", models.ErrInvalidCoOrganizer, request.MemberID)
+	}
+	if err != nil {
+		return err
+	}
+
+	err = c.repo.AddCoOrganizer(ctx, models.EventCoOrganizer{
+		EventID:      eventID,
+		EventType:    eventType,
+		MemberID:     request.MemberID,
+		Role:         request.Role,
+		InvitedBy:    authorID,
+		CreationDate: time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = c.repo.CreateNotification(ctx, models.TransactionNotification{
+		EventType:    eventType,
+		EventID:      eventID,
+		Action:       models.CoOrganizerAdded,
+		IsRead:       false,
+		CreationTime: time.Now(),
+		MemberID:     request.MemberID,
+		Summary:      string(request.Role),
+	})
+	return err
+}
+
+// RemoveCoOrganizer takes the member's permissions on the event away,
+// the author removes any co-organizer and a co-organizer can leave the event.
+func (c *CoOrganizer) RemoveCoOrganizer(ctx context.Context, eventID uint, eventType models.EventType,
+	memberID, requesterID uint) error {
+	eventAuthorID, _, err := eventOrganizers(ctx, c.repo, eventID, eventType)
+	if err != nil {
+		return err
+	}
+	if requesterID != eventAuthorID && requesterID != memberID {
+		return models.ErrNotFound
+	}
+
+	return c.repo.RemoveCoOrganizer(ctx, eventID, eventType, memberID)
+}
+
+func eventOrganizers(ctx context.Context, repo Repositorier, eventID uint,
+	eventType models.EventType) (uint, []models.EventCoOrganizer, error) {
+	switch eventType {
+	case models.HelpEventType:
+		event, err := repo.GetEventByID(ctx, models.ID(eventID))
+		if err != nil {
+			return 0, nil, err
+		}
+		return event.CreatedBy, event.CoOrganizers, nil
+	case models.ProposalEventType:
+		event, err := repo.GetEvent(ctx, eventID)
+		if err != nil {
+			return 0, nil, err
+		}
+		return event.AuthorID, event.CoOrganizers, nil
+	default:
+		return 0, nil, fmt.Errorf("%w: unknown event type %s", models.ErrInvalidCoOrganizer, eventType)
+	}
+}
+
+// notifyOrganizers sends the notification meant for the event author to its co-organizers as well.
+func notifyOrganizers(ctx context.Context, repo Repositorier, notification models.TransactionNotification,
+	authorID uint, coOrganizers []models.EventCoOrganizer) error {
+	memberIDs := make([]uint, 0, len(coOrganizers)+1)
+	memberIDs = append(memberIDs, authorID)
+	for _, coOrganizer := range coOrganizers {
+		memberIDs = append(memberIDs, coOrganizer.MemberID)
+	}
+
+	for _, memberID := range memberIDs {
+		notification.MemberID = memberID
+		_, err := repo.CreateNotification(ctx, notification)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
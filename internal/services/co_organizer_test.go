@@ -0,0 +1,217 @@
+package service_test
+
+import (
+	"Kurajj/internal/models"
+	service "Kurajj/internal/services"
+	mock_service "Kurajj/internal/services/mocks"
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func coOrganizedHelpEvent() models.HelpEvent {
+	event := pledgedHelpEvent
+	event.CoOrganizers = []models.EventCoOrganizer{
+		{EventID: 1, EventType: models.HelpEventType, MemberID: 5, Role: models.EditorRole},
+		{EventID: 1, EventType: models.HelpEventType, MemberID: 6, Role: models.TransactionManagerRole},
+	}
+	return event
+}
+
+func TestAddCoOrganizer(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().
+		GetEvent(context.TODO(), uint(1)).
+		Return(models.ProposalEvent{ID: 1, AuthorID: 2}, nil).
+		Times(4)
+	repo.EXPECT().
+		GetUserInfo(context.TODO(), uint(5)).
+		Return(models.User{ID: 5}, nil)
+	repo.EXPECT().
+		AddCoOrganizer(context.TODO(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, coOrganizer models.EventCoOrganizer) error {
+			assert.Equal(t, uint(1), coOrganizer.EventID)
+			assert.Equal(t, models.ProposalEventType, coOrganizer.EventType)
+			assert.Equal(t, uint(5), coOrganizer.MemberID)
+			assert.Equal(t, models.TransactionManagerRole, coOrganizer.Role)
+			assert.Equal(t, uint(2), coOrganizer.InvitedBy)
+			return nil
+		})
+	repo.EXPECT().
+		CreateNotification(context.TODO(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, notification models.TransactionNotification) (uint, error) {
+			assert.Equal(t, models.CoOrganizerAdded, notification.Action)
+			assert.Equal(t, uint(5), notification.MemberID)
+			return 1, nil
+		})
+
+	coOrganizerService := service.NewCoOrganizer(repo)
+
+	err := coOrganizerService.AddCoOrganizer(context.TODO(), 1, models.ProposalEventType, 2,
+		models.CoOrganizerRequest{MemberID: 5, Role: models.TransactionManagerRole})
+	assert.NoError(t, err)
+
+	err = coOrganizerService.AddCoOrganizer(context.TODO(), 1, models.ProposalEventType, 5,
+		models.CoOrganizerRequest{MemberID: 6, Role: models.EditorRole})
+	assert.ErrorIs(t, err, models.ErrNotFound)
+
+	err = coOrganizerService.AddCoOrganizer(context.TODO(), 1, models.ProposalEventType, 2,
+		models.CoOrganizerRequest{MemberID: 2, Role: models.EditorRole})
+	assert.ErrorIs(t, err, models.ErrInvalidCoOrganizer)
+
+	err = coOrganizerService.AddCoOrganizer(context.TODO(), 1, models.ProposalEventType, 2,
+		models.CoOrganizerRequest{MemberID: 5, Role: "owner"})
+	assert.ErrorIs(t, err, models.ErrInvalidCoOrganizer)
+
+	err = coOrganizerService.RemoveCoOrganizer(context.TODO(), 1, models.ProposalEventType, 5, 6)
+	assert.ErrorIs(t, err, models.ErrNotFound)
+}
+
+func TestUpdateHelpEventByCoOrganizer(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().
+		GetEventByID(context.TODO(), models.ID(1)).
+		Return(coOrganizedHelpEvent(), nil).
+		Times(2)
+	repo.EXPECT().
+		UpdateHelpEvent(context.TODO(), models.HelpEvent{ID: 1, EndDate: pledgedHelpEvent.EndDate})
+
+	helpEventService := service.NewHelpEvent(repo)
+
+	err := helpEventService.UpdateHelpEvent(context.TODO(), models.HelpEvent{ID: 1, EndDate: pledgedHelpEvent.EndDate}, 5)
+	assert.NoError(t, err)
+
+	err = helpEventService.UpdateHelpEvent(context.TODO(), models.HelpEvent{ID: 1}, 6)
+	assert.ErrorIs(t, err, models.ErrNotFound)
+}
+
+func TestUpdateTransactionStatusByCoOrganizer(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().
+		GetTransactionByID(context.TODO(), uint(4)).
+		Return(models.Transaction{
+			ID:                4,
+			CreatorID:         3,
+			EventID:           1,
+			EventType:         models.HelpEventType,
+			TransactionStatus: models.Waiting,
+		}, nil).
+		Times(3)
+	repo.EXPECT().
+		GetEventByID(context.TODO(), models.ID(1)).
+		Return(coOrganizedHelpEvent(), nil).
+		Times(3)
+	repo.EXPECT().
+		UpdateTransactionByID(context.TODO(), uint(4), gomock.Any())
+	repo.EXPECT().
+		CreateNotification(context.TODO(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, notification models.TransactionNotification) (uint, error) {
+			assert.Equal(t, uint(3), notification.MemberID)
+			assert.Equal(t, models.Accepted, notification.NewStatus)
+			return 1, nil
+		})
+
+	helpEventService := service.NewHelpEvent(repo)
+
+	transactionID, eventID := uint(4), uint(1)
+	err := helpEventService.UpdateTransactionStatus(context.TODO(), models.HelpEventTransaction{
+		TransactionID:      &transactionID,
+		HelpEventID:        &eventID,
+		EventCreator:       true,
+		TransactionStatus:  models.Accepted,
+		HelpEventCreatorID: 6,
+	}, nil, "", "")
+	assert.NoError(t, err)
+
+	err = helpEventService.UpdateTransactionStatus(context.TODO(), models.HelpEventTransaction{
+		TransactionID:      &transactionID,
+		HelpEventID:        &eventID,
+		EventCreator:       true,
+		TransactionStatus:  models.Accepted,
+		HelpEventCreatorID: 5,
+	}, nil, "", "")
+	assert.ErrorIs(t, err, models.ErrNotFound)
+
+	err = helpEventService.UpdateTransactionStatus(context.TODO(), models.HelpEventTransaction{
+		TransactionID:        &transactionID,
+		HelpEventID:          &eventID,
+		ResponderStatus:      models.Canceled,
+		TransactionCreatorID: 7,
+	}, nil, "", "")
+	assert.ErrorIs(t, err, models.ErrNotFound)
+}
+
+func TestCreateRequestNotifiesCoOrganizers(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().
+		GetEventByID(context.TODO(), models.ID(1)).
+		Return(coOrganizedHelpEvent(), nil)
+	repo.EXPECT().
+		GetMemberQuotaPolicies(context.TODO(), uint(3)).
+		Return([]models.QuotaPolicy{}, nil)
+	repo.EXPECT().
+		CountPendingResponses(context.TODO(), uint(3)).
+		Return(0, nil)
+	repo.EXPECT().
+		GetUnits(context.TODO()).
+		Return(seededUnits, nil)
+	repo.EXPECT().
+		CreateTransactionWithPledges(context.TODO(), gomock.Any(), gomock.Any()).
+		Return(uint(4), nil)
+	notified := make([]uint, 0)
+	repo.EXPECT().
+		CreateNotification(context.TODO(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, notification models.TransactionNotification) (uint, error) {
+			assert.Equal(t, models.Created, notification.Action)
+			notified = append(notified, notification.MemberID)
+			return 1, nil
+		}).
+		Times(3)
+
+	helpEventService := service.NewHelpEvent(repo)
+
+	_, err := helpEventService.CreateRequest(context.TODO(), 3, models.TransactionAcceptCreateRequest{
+		ID:      1,
+		Pledges: []models.NeedPledge{{NeedID: 10, Amount: 5}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []uint{2, 5, 6}, notified)
+}
+
+func TestAcceptByNotOrganizer(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().
+		GetTransactionByID(context.TODO(), uint(4)).
+		Return(models.Transaction{ID: 4, CreatorID: 3, EventID: 1, EventType: models.ProposalEventType}, nil)
+	repo.EXPECT().
+		GetEvent(context.TODO(), uint(1)).
+		Return(models.ProposalEvent{ID: 1, AuthorID: 2, CoOrganizers: []models.EventCoOrganizer{
+			{EventID: 1, EventType: models.ProposalEventType, MemberID: 5, Role: models.EditorRole},
+		}}, nil)
+
+	proposalEventService := service.NewProposalEvent(repo)
+
+	err := proposalEventService.Accept(context.TODO(), models.AcceptRequest{Accept: true, TransactionID: 4, MemberID: 5})
+	assert.ErrorIs(t, err, models.ErrNotFound)
+}
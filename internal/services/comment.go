@@ -8,11 +8,28 @@ import (
 )
 
 type Comment struct {
-	repo Repositorier
+	repo       Repositorier
+	moderation *Moderation
+	quota      *Quota
 }
 
 func (c *Comment) WriteComment(ctx context.Context, comment models.Comment) (uint, error) {
-	return c.repo.WriteComment(ctx, comment)
+	err := c.quota.CheckDailyComments(ctx, comment.UserID)
+	if err != nil {
+		return 0, err
+	}
+
+	moderationResult, err := c.moderation.Check(ctx, comment.UserID, comment.Text)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := c.repo.WriteComment(ctx, comment)
+	if err != nil {
+		return 0, err
+	}
+
+	return id, c.moderation.Flag(ctx, moderationResult, models.ModeratedComment, id, comment.UserID, comment.Text)
 }
 
 func (c *Comment) GetAllCommentsInEvent(ctx context.Context, eventID uint, eventType models.EventType) ([]models.Comment, error) {
@@ -24,13 +41,31 @@ func (c *Comment) GetCommentByID(ctx context.Context, id uint) (models.Comment,
 }
 
 func (c *Comment) UpdateComment(ctx context.Context, comment models.Comment) error {
+	oldComment, err := c.repo.GetCommentByID(ctx, comment.ID)
+	if err != nil {
+		return err
+	}
+
+	moderationResult := models.ModerationResult{Decision: models.ModerationAllow}
+	if comment.Text != "" && comment.Text != oldComment.Text {
+		moderationResult, err = c.moderation.Check(ctx, oldComment.UserID, comment.Text)
+		if err != nil {
+			return err
+		}
+	}
+
 	comment.IsUpdated = true
 	comment.UpdatedAt = sql.NullTime{
 		Time:  time.Now(),
 		Valid: true,
 	}
 
-	return c.repo.UpdateComment(ctx, comment.ID, comment.GetValuesToUpdate())
+	err = c.repo.UpdateComment(ctx, comment.ID, comment.GetValuesToUpdate())
+	if err != nil {
+		return err
+	}
+
+	return c.moderation.Flag(ctx, moderationResult, models.ModeratedComment, comment.ID, oldComment.UserID, comment.Text)
 }
 
 func (c *Comment) DeleteComment(ctx context.Context, id uint) error {
@@ -38,5 +73,5 @@ func (c *Comment) DeleteComment(ctx context.Context, id uint) error {
 }
 
 func NewComment(repo Repositorier) *Comment {
-	return &Comment{repo: repo}
+	return &Comment{repo: repo, moderation: NewModeration(repo), quota: NewQuota(repo)}
 }
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"Kurajj/internal/models"
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/samber/lo"
+	"time"
+)
+
+// AddDropPoint adds one more drop-off or pickup point to the author's help event.
+func (h *HelpEvent) AddDropPoint(ctx context.Context, eventID, memberID uint, point models.DropPoint) (uint, error) {
+	_, err := h.getOwnHelpEvent(ctx, eventID, memberID)
+	if err != nil {
+		return 0, err
+	}
+	err = point.Validate()
+	if err != nil {
+		return 0, err
+	}
+
+	point.HelpEventID = eventID
+	return h.repo.CreateDropPoint(ctx, point)
+}
+
+// DeleteDropPoint removes the point from the author's help event, transactions at the point keep it.
+func (h *HelpEvent) DeleteDropPoint(ctx context.Context, eventID, pointID, memberID uint) error {
+	_, err := h.getOwnHelpEvent(ctx, eventID, memberID)
+	if err != nil {
+		return err
+	}
+
+	return h.repo.DeleteDropPoint(ctx, eventID, pointID)
+}
+
+// GetDropPointArrivals returns the responders the author expects at the point during the day.
+func (h *HelpEvent) GetDropPointArrivals(ctx context.Context, eventID, pointID, memberID uint,
+	day time.Time) ([]models.DropPointArrival, error) {
+	event, err := h.getOwnHelpEvent(ctx, eventID, memberID)
+	if err != nil {
+		return nil, err
+	}
+	if !lo.ContainsBy(event.DropPoints, func(point models.DropPoint) bool {
+		return point.ID == pointID
+	}) {
+		return nil, models.ErrNotFound
+	}
+
+	from := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, day.Location())
+	return h.repo.GetDropPointArrivals(ctx, pointID, from, from.AddDate(0, 0, 1))
+}
+
+func validateDropPoints(points []models.DropPoint) error {
+	for _, point := range points {
+		err := point.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// chooseDropPoint stores the point and the arrival window the responder chose on the transaction.
+// The point should be open during the whole window.
+func chooseDropPoint(event models.HelpEvent, transaction *models.Transaction, pointID uint,
+	window *models.TimeWindow) error {
+	if pointID == 0 {
+		if window != nil {
+			return fmt.Errorf("%w: choose the point to arrive at", models.ErrInvalidDropPoint)
+		}
+		return nil
+	}
+	point, ok := lo.Find(event.DropPoints, func(point models.DropPoint) bool {
+		return point.ID == pointID
+	})
+	if !ok {
+		return fmt.Errorf("%w: the event has no point %d", models.ErrInvalidDropPoint, pointID)
+	}
+	if window == nil {
+		return fmt.Errorf("%w: the arrival window is required", models.ErrInvalidDropPoint)
+	}
+	err := window.Validate()
+	if err != nil {
+		return err
+	}
+	if window.Start.Before(time.Now()) {
+		return fmt.Errorf("%w: the arrival window should be in the future", models.ErrInvalidDropPoint)
+	}
+	if !point.OpeningHours.Contains(*window) {
+		return fmt.Errorf("%w: %s is closed during the arrival window", models.ErrInvalidDropPoint, point.Title)
+	}
+
+	transaction.DropPointID = sql.NullInt64{Int64: int64(pointID), Valid: true}
+	transaction.ArrivalStart = sql.NullTime{Time: window.Start, Valid: true}
+	transaction.ArrivalEnd = sql.NullTime{Time: window.End, Valid: true}
+	return nil
+}
+
+func cloneDropPoints(points []models.DropPoint) []models.DropPoint {
+	clones := make([]models.DropPoint, len(points))
+	for i, point := range points {
+		point.ID = 0
+		point.HelpEventID = 0
+		clones[i] = point
+	}
+
+	return clones
+}
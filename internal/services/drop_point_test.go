@@ -0,0 +1,162 @@
+package service_test
+
+import (
+	"Kurajj/internal/models"
+	service "Kurajj/internal/services"
+	mock_service "Kurajj/internal/services/mocks"
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+// nextWeekAt returns the time of the day a week later, so the arrival window is always in the future.
+func nextWeekAt(hour int) time.Time {
+	day := time.Now().AddDate(0, 0, 7)
+	return time.Date(day.Year(), day.Month(), day.Day(), hour, 0, 0, 0, time.Local)
+}
+
+func dropPointHelpEvent() models.HelpEvent {
+	event := pledgedHelpEvent
+	event.DropPoints = []models.DropPoint{
+		{ID: 4, HelpEventID: 1, Kind: models.DropOffPoint, Title: "Warehouse", City: "Львів", Street: "Городоцька",
+			OpeningHours: models.OpeningHours{{Weekday: nextWeekAt(0).Weekday(), Opens: "09:00", Closes: "18:00"}}},
+	}
+	return event
+}
+
+func TestCreateRequestAtDropPoint(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().
+		GetEventByID(context.TODO(), models.ID(1)).
+		Return(dropPointHelpEvent(), nil)
+	repo.EXPECT().
+		GetMemberQuotaPolicies(context.TODO(), uint(3)).
+		Return([]models.QuotaPolicy{}, nil)
+	repo.EXPECT().
+		CountPendingResponses(context.TODO(), uint(3)).
+		Return(0, nil)
+	repo.EXPECT().
+		GetUnits(context.TODO()).
+		Return(seededUnits, nil)
+	repo.EXPECT().
+		CreateTransactionWithPledges(context.TODO(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, transaction models.Transaction, _ []models.Need) (uint, error) {
+			assert.Equal(t, int64(4), transaction.DropPointID.Int64)
+			assert.Equal(t, nextWeekAt(10), transaction.ArrivalStart.Time)
+			assert.Equal(t, nextWeekAt(11), transaction.ArrivalEnd.Time)
+			return 5, nil
+		})
+	repo.EXPECT().
+		CreateNotification(context.TODO(), gomock.Any())
+
+	helpEventService := service.NewHelpEvent(repo)
+
+	transactionID, err := helpEventService.CreateRequest(context.TODO(), 3, models.TransactionAcceptCreateRequest{
+		ID:            1,
+		Pledges:       []models.NeedPledge{{NeedID: 10, Amount: 5}},
+		DropPointID:   4,
+		ArrivalWindow: &models.TimeWindow{Start: nextWeekAt(10), End: nextWeekAt(11)},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, uint(5), transactionID)
+}
+
+func TestCreateRequestWithInvalidArrival(t *testing.T) {
+	tests := []struct {
+		name    string
+		pointID uint
+		window  *models.TimeWindow
+	}{
+		{
+			name:   "window without point",
+			window: &models.TimeWindow{Start: nextWeekAt(10), End: nextWeekAt(11)},
+		},
+		{
+			name:    "unknown point",
+			pointID: 5,
+			window:  &models.TimeWindow{Start: nextWeekAt(10), End: nextWeekAt(11)},
+		},
+		{
+			name:    "no window",
+			pointID: 4,
+		},
+		{
+			name:    "point is closed",
+			pointID: 4,
+			window:  &models.TimeWindow{Start: nextWeekAt(17), End: nextWeekAt(19)},
+		},
+		{
+			name:    "window in the past",
+			pointID: 4,
+			window: &models.TimeWindow{Start: nextWeekAt(10).AddDate(0, 0, -14),
+				End: nextWeekAt(11).AddDate(0, 0, -14)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock_service.NewMockRepositorier(mockCtrl)
+
+			repo.EXPECT().
+				GetEventByID(context.TODO(), models.ID(1)).
+				Return(dropPointHelpEvent(), nil)
+			repo.EXPECT().
+				GetMemberQuotaPolicies(context.TODO(), uint(3)).
+				Return([]models.QuotaPolicy{}, nil)
+			repo.EXPECT().
+				CountPendingResponses(context.TODO(), uint(3)).
+				Return(0, nil)
+			repo.EXPECT().
+				GetUnits(context.TODO()).
+				Return(seededUnits, nil)
+
+			helpEventService := service.NewHelpEvent(repo)
+
+			_, err := helpEventService.CreateRequest(context.TODO(), 3, models.TransactionAcceptCreateRequest{
+				ID:            1,
+				Pledges:       []models.NeedPledge{{NeedID: 10, Amount: 5}},
+				DropPointID:   tt.pointID,
+				ArrivalWindow: tt.window,
+			})
+			assert.ErrorIs(t, err, models.ErrInvalidDropPoint)
+		})
+	}
+}
+
+func TestGetDropPointArrivals(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	day := time.Date(2024, time.May, 6, 15, 30, 0, 0, time.UTC)
+	repo.EXPECT().
+		GetEventByID(context.TODO(), models.ID(1)).
+		Return(dropPointHelpEvent(), nil).
+		Times(3)
+	repo.EXPECT().
+		GetDropPointArrivals(context.TODO(), uint(4), time.Date(2024, time.May, 6, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, time.May, 7, 0, 0, 0, 0, time.UTC)).
+		Return([]models.DropPointArrival{{TransactionID: 5, ResponderID: 3}}, nil)
+
+	helpEventService := service.NewHelpEvent(repo)
+
+	arrivals, err := helpEventService.GetDropPointArrivals(context.TODO(), 1, 4, 2, day)
+	assert.NoError(t, err)
+	assert.Len(t, arrivals, 1)
+
+	_, err = helpEventService.GetDropPointArrivals(context.TODO(), 1, 4, 3, day)
+	assert.ErrorIs(t, err, models.ErrNotFound)
+
+	_, err = helpEventService.GetDropPointArrivals(context.TODO(), 1, 5, 2, day)
+	assert.ErrorIs(t, err, models.ErrNotFound)
+}
@@ -0,0 +1,120 @@
+package service
+
+import (
+	"Kurajj/internal/models"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+func NewEventTemplate(repo Repositorier, proposalEvent ProposalEventer, helpEvent HelpEventer) *EventTemplate {
+	return &EventTemplate{repo: repo, proposalEvent: proposalEvent, helpEvent: helpEvent}
+}
+
+type EventTemplate struct {
+	repo          Repositorier
+	proposalEvent ProposalEventer
+	helpEvent     HelpEventer
+}
+
+func (e *EventTemplate) CreateEventTemplate(ctx context.Context, template models.EventTemplate) (uint, error) {
+	return e.repo.CreateEventTemplate(ctx, template)
+}
+
+func (e *EventTemplate) GetMemberEventTemplates(ctx context.Context, memberID uint) ([]models.EventTemplate, error) {
+	return e.repo.GetMemberEventTemplates(ctx, memberID)
+}
+
+func (e *EventTemplate) DeleteEventTemplate(ctx context.Context, id, memberID uint) error {
+	_, err := e.getMemberEventTemplate(ctx, id, memberID)
+	if err != nil {
+		return err
+	}
+
+	return e.repo.DeleteEventTemplate(ctx, id)
+}
+
+// InstantiateEventTemplate creates an event from the template. Overrides is a partial create request
+// whose fields replace the ones stored in the template.
+func (e *EventTemplate) InstantiateEventTemplate(ctx context.Context, id, memberID uint, overrides []byte) (uint, error) {
+	template, err := e.getMemberEventTemplate(ctx, id, memberID)
+	if err != nil {
+		return 0, err
+	}
+
+	switch template.EventType {
+	case models.ProposalEventType:
+		request := models.ProposalEventRequestCreate{}
+		err = decodeEventTemplate(template, overrides, &request)
+		if err != nil {
+			return 0, err
+		}
+		return e.proposalEvent.CreateEvent(ctx, request.InternalValue(memberID))
+	case models.HelpEventType:
+		request := models.HelpEventCreateRequest{}
+		err = decodeEventTemplate(template, overrides, &request)
+		if err != nil {
+			return 0, err
+		}
+		err = request.Validate()
+		if err != nil {
+			return 0, fmt.Errorf("%w: %s", models.ErrInvalidTemplate, err)
+		}
+		return e.helpEvent.CreateHelpEvent(ctx, request.ToInternal(memberID))
+	default:
+		return 0, fmt.Errorf("%w: unknown event type %s", models.ErrInvalidTemplate, template.EventType)
+	}
+}
+
+func (e *EventTemplate) getMemberEventTemplate(ctx context.Context, id, memberID uint) (models.EventTemplate, error) {
+	template, err := e.repo.GetEventTemplateByID(ctx, id)
+	if err != nil {
+		return models.EventTemplate{}, err
+	}
+	if template.MemberID != memberID {
+		return models.EventTemplate{}, models.ErrNotFound
+	}
+
+	return template, nil
+}
+
+func decodeEventTemplate(template models.EventTemplate, overrides []byte, request any) error {
+	err := json.Unmarshal([]byte(template.Payload), request)
+	if err != nil {
+		return fmt.Errorf("%w: %s", models.ErrInvalidTemplate, err)
+	}
+	if len(overrides) == 0 {
+		return nil
+	}
+
+	err = json.Unmarshal(overrides, request)
+	if err != nil {
+		return fmt.Errorf("%w: %s", models.ErrInvalidTemplate, err)
+	}
+
+	return nil
+}
+
+// cloneTags copies tags and their values without ids, so they can be created for another event.
+func cloneTags(tags []models.Tag) []models.Tag {
+	clonedTags := make([]models.Tag, len(tags))
+	for i, tag := range tags {
+		values := make([]models.TagValue, len(tag.Values))
+		for j, value := range tag.Values {
+			values[j] = models.TagValue{Value: value.Value}
+		}
+		clonedTags[i] = models.Tag{
+			Title:     tag.Title,
+			EventType: tag.EventType,
+			Values:    values,
+		}
+	}
+
+	return clonedTags
+}
+
+func cloneLocation(location models.Address) models.Address {
+	location.ID = 0
+	location.EventID = 0
+	return location
+}
@@ -0,0 +1,177 @@
+package service_test
+
+import (
+	"Kurajj/internal/models"
+	service "Kurajj/internal/services"
+	mock_service "Kurajj/internal/services/mocks"
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestCloneHelpEventResetsCounters(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	endDate := time.Now().Add(7 * 24 * time.Hour)
+	repo.EXPECT().
+		GetEventByID(context.TODO(), models.ID(1)).
+		Return(models.HelpEvent{
+			ID:          1,
+			Title:       "Monthly food drive",
+			Description: "Description",
+			CreatedBy:   2,
+			Status:      models.Done,
+			Urgency:     models.FastUrgency,
+			EndDate:     time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC),
+			ImagePath:   "https://example.com/image.png",
+			Needs: []models.Need{
+				{ID: 5, Title: "Bread", Amount: 10, Received: 4, ReceivedTotal: 7, HelpEventID: 1, Unit: models.Item,
+					Urgency: models.VeryFastUrgency},
+			},
+			Tags: []models.Tag{
+				{ID: 3, EventID: 1, Title: "food", EventType: models.HelpEventType,
+					Values: []models.TagValue{{ID: 4, TagID: 3, Value: "bread"}}},
+			},
+			Location: models.Address{ID: 6, EventID: 1, City: "Kharkiv", EventType: models.HelpEventType},
+		}, nil)
+	repo.EXPECT().
+		GetUnits(context.TODO()).Return(seededUnits, nil)
+	repo.EXPECT().
+		GetBlockedWords(context.TODO()).Return([]models.BlockedWord{}, nil)
+	repo.EXPECT().
+		GetUserRecentTexts(context.TODO(), uint(2), gomock.Any()).Return([]string{}, nil)
+	repo.EXPECT().
+		CreateEvent(context.TODO(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, event *models.HelpEvent) (uint, error) {
+			assert.Equal(t, models.InActive, event.Status)
+			assert.Equal(t, endDate, event.EndDate)
+			assert.Equal(t, models.FastUrgency, event.Urgency)
+			assert.Equal(t, "https://example.com/image.png", event.ImagePath)
+			assert.Equal(t, []models.Need{{Title: "Bread", Type: models.GoodsNeed, Amount: 10, Unit: models.Item,
+				Urgency: models.VeryFastUrgency}}, event.Needs)
+			assert.Equal(t, []models.Tag{{Title: "food", EventType: models.HelpEventType,
+				Values: []models.TagValue{{Value: "bread"}}}}, event.Tags)
+			assert.Equal(t, models.Address{City: "Kharkiv", EventType: models.HelpEventType}, event.Location)
+			return 7, nil
+		})
+
+	helpEventService := service.NewHelpEvent(repo)
+
+	id, err := helpEventService.CloneHelpEvent(context.TODO(), 1, 2, models.CloneEventRequest{EndDate: endDate})
+	assert.NoError(t, err)
+	assert.Equal(t, uint(7), id)
+}
+
+func TestCloneProposalEventOfAnotherAuthor(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().
+		GetEvent(context.TODO(), uint(1)).
+		Return(models.ProposalEvent{ID: 1, AuthorID: 2}, nil)
+
+	proposalEventService := service.NewProposalEvent(repo)
+
+	_, err := proposalEventService.CloneEvent(context.TODO(), 1, 3, models.CloneEventRequest{})
+	assert.ErrorIs(t, err, models.ErrNotFound)
+}
+
+func TestCloneProposalEventWithoutNewTimeSlots(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	startTime := time.Date(2025, time.March, 1, 10, 0, 0, 0, time.UTC)
+	repo.EXPECT().
+		GetEvent(context.TODO(), uint(1)).
+		Return(models.ProposalEvent{
+			ID:       1,
+			AuthorID: 2,
+			EndDate:  time.Date(2025, time.March, 2, 0, 0, 0, 0, time.UTC),
+			TimeSlots: []models.TimeSlot{
+				{ID: 3, EventID: 1, StartTime: startTime, EndTime: startTime.Add(time.Hour), Capacity: 2},
+			},
+		}, nil)
+
+	proposalEventService := service.NewProposalEvent(repo)
+
+	_, err := proposalEventService.CloneEvent(context.TODO(), 1, 2,
+		models.CloneEventRequest{EndDate: time.Now().Add(7 * 24 * time.Hour)})
+	assert.ErrorIs(t, err, models.ErrInvalidClone)
+}
+
+func TestCloneEventWithPastEndDate(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().
+		GetEventByID(context.TODO(), models.ID(1)).
+		Return(models.HelpEvent{ID: 1, CreatedBy: 2}, nil)
+
+	helpEventService := service.NewHelpEvent(repo)
+
+	_, err := helpEventService.CloneHelpEvent(context.TODO(), 1, 2,
+		models.CloneEventRequest{EndDate: time.Now().Add(-time.Hour)})
+	assert.ErrorIs(t, err, models.ErrInvalidClone)
+}
+
+func TestInstantiateEventTemplateWithOverrides(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+	proposalEventer := mock_service.NewMockProposalEventer(mockCtrl)
+
+	endDate := time.Date(2026, time.December, 1, 0, 0, 0, 0, time.UTC)
+	repo.EXPECT().
+		GetEventTemplateByID(context.TODO(), uint(1)).
+		Return(models.EventTemplate{
+			ID:        1,
+			MemberID:  2,
+			EventType: models.ProposalEventType,
+			Payload:   `{"title":"Rides to the hospital","description":"Description","maxConcurrentRequests":3}`,
+		}, nil)
+	proposalEventer.EXPECT().
+		CreateEvent(context.TODO(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, event models.ProposalEvent) (uint, error) {
+			assert.Equal(t, uint(2), event.AuthorID)
+			assert.Equal(t, "Rides to the hospital", event.Title)
+			assert.Equal(t, uint(3), event.MaxConcurrentRequests)
+			assert.Equal(t, endDate, event.EndDate)
+			assert.Equal(t, models.InActive, event.Status)
+			return 5, nil
+		})
+
+	templateService := service.NewEventTemplate(repo, proposalEventer, nil)
+
+	id, err := templateService.InstantiateEventTemplate(context.TODO(), 1, 2,
+		[]byte(`{"endDate":"2026-12-01T00:00:00Z","draft":true}`))
+	assert.NoError(t, err)
+	assert.Equal(t, uint(5), id)
+}
+
+func TestDeleteEventTemplateOfAnotherMember(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().
+		GetEventTemplateByID(context.TODO(), uint(1)).
+		Return(models.EventTemplate{ID: 1, MemberID: 2}, nil)
+
+	templateService := service.NewEventTemplate(repo, nil, nil)
+
+	err := templateService.DeleteEventTemplate(context.TODO(), 1, 3)
+	assert.ErrorIs(t, err, models.ErrNotFound)
+}
@@ -0,0 +1,249 @@
+package service
+
+import (
+	"Kurajj/internal/models"
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"time"
+	"unicode/utf8"
+)
+
+// maxUpdateSummaryLength limits how much of the update text gets into the notifications.
+const maxUpdateSummaryLength = 100
+
+func NewEventUpdate(repo Repositorier) *EventUpdate {
+	return &EventUpdate{repo: repo, moderation: NewModeration(repo)}
+}
+
+type EventUpdate struct {
+	repo       Repositorier
+	moderation *Moderation
+}
+
+// PostEventUpdate publishes the author's update of the event and notifies everyone with a transaction
+// on the event and everyone following it.
+func (e *EventUpdate) PostEventUpdate(ctx context.Context, eventID uint, eventType models.EventType, authorID uint,
+	request models.EventUpdateCreateRequest) (uint, error) {
+	err := request.Validate()
+	if err != nil {
+		return 0, err
+	}
+	eventAuthorID, err := e.eventAuthor(ctx, eventID, eventType)
+	if err != nil {
+		return 0, err
+	}
+	if eventAuthorID != authorID {
+		return 0, models.ErrNotFound
+	}
+	moderationResult, err := e.moderation.Check(ctx, authorID, request.Text)
+	if err != nil {
+		return 0, err
+	}
+
+	uploadedPaths, err := e.uploadedImagePaths(ctx, eventID, eventType, request.Images)
+	if err != nil {
+		return 0, err
+	}
+	imagePaths := make(models.ImagePaths, len(request.Images))
+	for i, image := range request.Images {
+		imagePaths[i], err = e.uploadImage(ctx, image, uploadedPaths)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	update := models.EventUpdate{
+		EventID:      eventID,
+		EventType:    eventType,
+		AuthorID:     authorID,
+		Text:         request.Text,
+		ImagePaths:   imagePaths,
+		CreationDate: time.Now(),
+	}
+	id, err := e.repo.CreateEventUpdate(ctx, update)
+	if err != nil {
+		return 0, err
+	}
+	err = e.moderation.Flag(ctx, moderationResult, models.ModeratedEventUpdate, id, authorID, update.Text)
+	if err != nil {
+		return 0, err
+	}
+
+	return id, e.notifyEventUpdated(ctx, update)
+}
+
+// DeleteEventUpdate deletes the update, only its author can do it.
+func (e *EventUpdate) DeleteEventUpdate(ctx context.Context, id, memberID uint) error {
+	update, err := e.repo.GetEventUpdateByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if update.AuthorID != memberID {
+		return models.ErrNotFound
+	}
+
+	return e.repo.DeleteEventUpdate(ctx, id)
+}
+
+// FollowEvent subscribes the member to the updates of the event, drafts can be followed only by their organizers.
+func (e *EventUpdate) FollowEvent(ctx context.Context, eventID uint, eventType models.EventType, memberID uint) error {
+	err := e.checkEventVisible(ctx, eventID, eventType, memberID)
+	if err != nil {
+		return err
+	}
+
+	return e.repo.FollowEvent(ctx, models.EventFollower{
+		EventID:      eventID,
+		EventType:    eventType,
+		MemberID:     memberID,
+		CreationDate: time.Now(),
+	})
+}
+
+func (e *EventUpdate) UnfollowEvent(ctx context.Context, eventID uint, eventType models.EventType, memberID uint) error {
+	return e.repo.UnfollowEvent(ctx, eventID, eventType, memberID)
+}
+
+func (e *EventUpdate) eventAuthor(ctx context.Context, eventID uint, eventType models.EventType) (uint, error) {
+	switch eventType {
+	case models.HelpEventType:
+		event, err := e.repo.GetEventByID(ctx, models.ID(eventID))
+		if err != nil {
+			return 0, err
+		}
+		return event.CreatedBy, nil
+	case models.ProposalEventType:
+		event, err := e.repo.GetEvent(ctx, eventID)
+		if err != nil {
+			return 0, err
+		}
+		return event.AuthorID, nil
+	default:
+		return 0, fmt.Errorf("%w: unknown event type %s", models.ErrInvalidEventUpdate, eventType)
+	}
+}
+
+// checkEventVisible hides drafts from the members who do not organize the event, the same way the event pages do.
+func (e *EventUpdate) checkEventVisible(ctx context.Context, eventID uint, eventType models.EventType,
+	memberID uint) error {
+	visible := false
+	switch eventType {
+	case models.HelpEventType:
+		event, err := e.repo.GetEventByID(ctx, models.ID(eventID))
+		if err != nil {
+			return err
+		}
+		visible = event.Status != models.InActive || event.Organizes(memberID, "")
+	case models.ProposalEventType:
+		event, err := e.repo.GetEvent(ctx, eventID)
+		if err != nil {
+			return err
+		}
+		visible = event.Status != models.InActive || event.Organizes(memberID, "")
+	default:
+		return fmt.Errorf("%w: unknown event type %s", models.ErrInvalidEventUpdate, eventType)
+	}
+	if !visible {
+		return models.ErrNotFound
+	}
+
+	return nil
+}
+
+// uploadedImagePaths collects the images of the earlier updates of the event,
+// they are loaded only when the request reuses an uploaded image.
+func (e *EventUpdate) uploadedImagePaths(ctx context.Context, eventID uint, eventType models.EventType,
+	images []models.EventUpdateImage) (map[string]bool, error) {
+	paths := make(map[string]bool)
+	_, ok := lo.Find(images, func(image models.EventUpdateImage) bool {
+		return image.FilePath != ""
+	})
+	if !ok {
+		return paths, nil
+	}
+
+	updates, err := e.repo.GetEventUpdates(ctx, eventID, eventType)
+	if err != nil {
+		return nil, err
+	}
+	for _, update := range updates {
+		for _, path := range update.ImagePaths {
+			paths[path] = true
+		}
+	}
+
+	return paths, nil
+}
+
+// uploadImage uploads the image, unless it is an image already uploaded with an earlier update of the event.
+func (e *EventUpdate) uploadImage(ctx context.Context, image models.EventUpdateImage,
+	uploadedPaths map[string]bool) (string, error) {
+	if image.FilePath != "" {
+		if !uploadedPaths[image.FilePath] {
+			return "", fmt.Errorf("%w: %s is not an image of the event updates", models.ErrInvalidEventUpdate,
+				image.FilePath)
+		}
+		return image.FilePath, nil
+	}
+	fileUniqueID, err := uuid.NewUUID()
+	if err != nil {
+		return "", err
+	}
+	fileName := fmt.Sprintf("%s.%s", fileUniqueID.String(), image.FileType)
+
+	return e.repo.Upload(ctx, fileName, bytes.NewReader(image.FileBytes))
+}
+
+// notifyEventUpdated notifies the creators of the event transactions and the event followers,
+// each member once and never the author.
+func (e *EventUpdate) notifyEventUpdated(ctx context.Context, update models.EventUpdate) error {
+	transactions, err := e.repo.GetAllEventTransactions(ctx, update.EventID, update.EventType)
+	if err != nil {
+		return err
+	}
+	followers, err := e.repo.GetEventFollowers(ctx, update.EventID, update.EventType)
+	if err != nil {
+		return err
+	}
+
+	memberIDs := make([]uint, 0, len(transactions)+len(followers))
+	for _, transaction := range transactions {
+		memberIDs = append(memberIDs, transaction.CreatorID)
+	}
+	memberIDs = append(memberIDs, followers...)
+
+	notified := map[uint]bool{update.AuthorID: true}
+	for _, memberID := range memberIDs {
+		if notified[memberID] {
+			continue
+		}
+		notified[memberID] = true
+		_, err = e.repo.CreateNotification(ctx, models.TransactionNotification{
+			EventType:    update.EventType,
+			EventID:      update.EventID,
+			Action:       models.EventUpdated,
+			MemberID:     memberID,
+			Summary:      updateSummary(update),
+			CreationTime: time.Now(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func updateSummary(update models.EventUpdate) string {
+	if update.Text == "" {
+		return fmt.Sprintf("%d new photos", len(update.ImagePaths))
+	}
+	if utf8.RuneCountInString(update.Text) <= maxUpdateSummaryLength {
+		return update.Text
+	}
+
+	return string([]rune(update.Text)[:maxUpdateSummaryLength]) + "..."
+}
@@ -0,0 +1,222 @@
+package service_test
+
+import (
+	"Kurajj/internal/models"
+	service "Kurajj/internal/services"
+	mock_service "Kurajj/internal/services/mocks"
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestPostEventUpdateNotifiesParticipantsAndFollowers(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().
+		GetEventByID(context.TODO(), models.ID(2)).
+		Return(models.HelpEvent{ID: 2, CreatedBy: 1}, nil)
+	repo.EXPECT().
+		GetBlockedWords(context.TODO()).Return([]models.BlockedWord{}, nil)
+	repo.EXPECT().
+		GetUserRecentTexts(context.TODO(), uint(1), gomock.Any()).Return([]string{}, nil)
+	repo.EXPECT().
+		GetEventUpdates(context.TODO(), uint(2), models.EventType(models.HelpEventType)).
+		Return([]models.EventUpdate{{ID: 4, ImagePaths: models.ImagePaths{"https://example.com/uploaded.png"}}}, nil)
+	repo.EXPECT().
+		Upload(context.TODO(), gomock.Any(), gomock.Any()).
+		Return("https://example.com/truck.png", nil)
+	repo.EXPECT().
+		CreateEventUpdate(context.TODO(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, update models.EventUpdate) (uint, error) {
+			assert.Equal(t, uint(2), update.EventID)
+			assert.Equal(t, models.EventType(models.HelpEventType), update.EventType)
+			assert.Equal(t, uint(1), update.AuthorID)
+			assert.Equal(t, "first truck delivered", update.Text)
+			assert.Equal(t, models.ImagePaths{"https://example.com/truck.png", "https://example.com/uploaded.png"},
+				update.ImagePaths)
+			return 5, nil
+		})
+	repo.EXPECT().
+		GetAllEventTransactions(context.TODO(), uint(2), models.EventType(models.HelpEventType)).
+		Return([]models.Transaction{{ID: 1, CreatorID: 3}, {ID: 2, CreatorID: 4}, {ID: 3, CreatorID: 3}}, nil)
+	repo.EXPECT().
+		GetEventFollowers(context.TODO(), uint(2), models.EventType(models.HelpEventType)).
+		Return([]uint{1, 4, 6}, nil)
+
+	notified := make([]uint, 0)
+	repo.EXPECT().
+		CreateNotification(context.TODO(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, notification models.TransactionNotification) (uint, error) {
+			assert.Equal(t, models.EventUpdated, notification.Action)
+			assert.Equal(t, uint(2), notification.EventID)
+			assert.Equal(t, "first truck delivered", notification.Summary)
+			notified = append(notified, notification.MemberID)
+			return 0, nil
+		}).
+		Times(3)
+
+	eventUpdateService := service.NewEventUpdate(repo)
+
+	id, err := eventUpdateService.PostEventUpdate(context.TODO(), 2, models.HelpEventType, 1,
+		models.EventUpdateCreateRequest{
+			Text: " first truck delivered ",
+			Images: []models.EventUpdateImage{
+				{FileBytes: []byte("image"), FileType: "png"},
+				{FilePath: "https://example.com/uploaded.png"},
+			},
+		})
+	assert.NoError(t, err)
+	assert.Equal(t, uint(5), id)
+	assert.Equal(t, []uint{3, 4, 6}, notified)
+}
+
+func TestPostEventUpdateByNotAuthor(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().
+		GetEvent(context.TODO(), uint(2)).
+		Return(models.ProposalEvent{ID: 2, AuthorID: 1}, nil)
+
+	eventUpdateService := service.NewEventUpdate(repo)
+
+	_, err := eventUpdateService.PostEventUpdate(context.TODO(), 2, models.ProposalEventType, 3,
+		models.EventUpdateCreateRequest{Text: "first truck delivered"})
+	assert.ErrorIs(t, err, models.ErrNotFound)
+}
+
+func TestPostInvalidEventUpdate(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	eventUpdateService := service.NewEventUpdate(repo)
+
+	_, err := eventUpdateService.PostEventUpdate(context.TODO(), 2, models.HelpEventType, 1,
+		models.EventUpdateCreateRequest{Text: "  "})
+	assert.ErrorIs(t, err, models.ErrInvalidEventUpdate)
+
+	_, err = eventUpdateService.PostEventUpdate(context.TODO(), 2, models.HelpEventType, 1,
+		models.EventUpdateCreateRequest{Images: []models.EventUpdateImage{{FileType: "png"}}})
+	assert.ErrorIs(t, err, models.ErrInvalidEventUpdate)
+}
+
+func TestPostEventUpdateWithImageOfAnotherEvent(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().
+		GetEventByID(context.TODO(), models.ID(2)).
+		Return(models.HelpEvent{ID: 2, CreatedBy: 1}, nil)
+	repo.EXPECT().
+		GetBlockedWords(context.TODO()).Return([]models.BlockedWord{}, nil)
+	repo.EXPECT().
+		GetUserRecentTexts(context.TODO(), uint(1), gomock.Any()).Return([]string{}, nil)
+	repo.EXPECT().
+		GetEventUpdates(context.TODO(), uint(2), models.EventType(models.HelpEventType)).
+		Return([]models.EventUpdate{{ID: 4, ImagePaths: models.ImagePaths{"https://example.com/uploaded.png"}}}, nil)
+
+	eventUpdateService := service.NewEventUpdate(repo)
+
+	_, err := eventUpdateService.PostEventUpdate(context.TODO(), 2, models.HelpEventType, 1,
+		models.EventUpdateCreateRequest{
+			Images: []models.EventUpdateImage{{FilePath: "https://example.com/private/passport.png"}},
+		})
+	assert.ErrorIs(t, err, models.ErrInvalidEventUpdate)
+}
+
+func TestPostEventUpdateWithBlockedWord(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().
+		GetEvent(context.TODO(), uint(2)).
+		Return(models.ProposalEvent{ID: 2, AuthorID: 1}, nil)
+	repo.EXPECT().
+		GetBlockedWords(context.TODO()).
+		Return([]models.BlockedWord{{ID: 1, Word: "scam", Language: models.English}}, nil)
+	repo.EXPECT().
+		GetUserRecentTexts(context.TODO(), uint(1), gomock.Any()).Return([]string{}, nil)
+
+	eventUpdateService := service.NewEventUpdate(repo)
+
+	_, err := eventUpdateService.PostEventUpdate(context.TODO(), 2, models.ProposalEventType, 1,
+		models.EventUpdateCreateRequest{Text: "this is a scam"})
+	assert.ErrorIs(t, err, models.ErrContentRejected)
+}
+
+func TestPostFlaggedEventUpdate(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().
+		GetEvent(context.TODO(), uint(2)).
+		Return(models.ProposalEvent{ID: 2, AuthorID: 1}, nil)
+	repo.EXPECT().
+		GetBlockedWords(context.TODO()).Return([]models.BlockedWord{}, nil)
+	repo.EXPECT().
+		GetUserRecentTexts(context.TODO(), uint(1), gomock.Any()).Return([]string{}, nil)
+	repo.EXPECT().
+		CreateEventUpdate(context.TODO(), gomock.Any()).Return(uint(5), nil)
+	repo.EXPECT().
+		CreateModerationItem(context.TODO(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, item models.ModerationItem) (uint, error) {
+			assert.Equal(t, uint(5), item.EntityID)
+			assert.Equal(t, models.ModeratedEventUpdate, item.EntityType)
+			assert.Equal(t, uint(1), item.AuthorID)
+			return 1, nil
+		})
+	repo.EXPECT().
+		GetAllEventTransactions(context.TODO(), uint(2), models.EventType(models.ProposalEventType)).
+		Return([]models.Transaction{}, nil)
+	repo.EXPECT().
+		GetEventFollowers(context.TODO(), uint(2), models.EventType(models.ProposalEventType)).
+		Return([]uint{}, nil)
+
+	eventUpdateService := service.NewEventUpdate(repo)
+
+	id, err := eventUpdateService.PostEventUpdate(context.TODO(), 2, models.ProposalEventType, 1,
+		models.EventUpdateCreateRequest{Text: "message me at t.me/volunteer_hub for details"})
+	assert.NoError(t, err)
+	assert.Equal(t, uint(5), id)
+}
+
+func TestFollowDraftEvent(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().
+		GetEvent(context.TODO(), uint(2)).
+		Return(models.ProposalEvent{ID: 2, AuthorID: 1, Status: models.InActive,
+			CoOrganizers: []models.EventCoOrganizer{{MemberID: 4, Role: models.EditorRole}}}, nil).
+		Times(2)
+	repo.EXPECT().
+		FollowEvent(context.TODO(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, follower models.EventFollower) error {
+			assert.Equal(t, uint(4), follower.MemberID)
+			return nil
+		})
+
+	eventUpdateService := service.NewEventUpdate(repo)
+
+	err := eventUpdateService.FollowEvent(context.TODO(), 2, models.ProposalEventType, 3)
+	assert.ErrorIs(t, err, models.ErrNotFound)
+
+	err = eventUpdateService.FollowEvent(context.TODO(), 2, models.ProposalEventType, 4)
+	assert.NoError(t, err)
+}
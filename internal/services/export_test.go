@@ -0,0 +1,24 @@
+package service
+
+import (
+	zlog "Kurajj/pkg/logger"
+	"github.com/go-logr/logr"
+	"time"
+)
+
+func init() {
+	zlog.Log = logr.Discard()
+}
+
+// Background jobs are run by the schedule only, the tests reach them through these aliases.
+var (
+	ExpireProposalEvents = (*ProposalEvent).expireEvents
+	ExpireHelpEvents     = (*HelpEvent).expireEvents
+
+	ExpireWaitlistPromotions = (*ProposalEvent).expireWaitlistPromotions
+)
+
+// SetClock replaces the clock the help event service compares event dates with.
+func SetClock(h *HelpEvent, now func() time.Time) {
+	h.now = now
+}
@@ -4,27 +4,28 @@ import (
 	"Kurajj/internal/models"
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
-	"github.com/google/uuid"
-	"github.com/robfig/cron/v3"
 	"github.com/samber/lo"
+	"gorm.io/gorm"
 	"io"
 	"time"
 	_ "time/tzdata"
 )
 
-func NewHelpEvent(r Repositorier) HelpEventer {
-	helpEventService := &HelpEvent{repo: r, Transaction: NewTransaction(r), MaxEventsPerUser: 5}
-	helpEventCron := cron.New()
-	helpEventCron.AddFunc("@every 1m", helpEventService.provisionEvents)
-	helpEventCron.Start()
-	return helpEventService
+func NewHelpEvent(r Repositorier) *HelpEvent {
+	return &HelpEvent{repo: r, Transaction: NewTransaction(r), moderation: NewModeration(r), quota: NewQuota(r),
+		units: NewUnit(r), now: time.Now}
 }
 
 type HelpEvent struct {
 	*Transaction
-	repo             Repositorier
-	MaxEventsPerUser uint
+	repo       Repositorier
+	moderation *Moderation
+	quota      *Quota
+	units      *Unit
+	// now is the clock the stored event dates are compared with, the same one the scheduled jobs use.
+	now func() time.Time
 }
 
 func (h *HelpEvent) GetHelpEventStatistics(ctx context.Context, fromStart int, creatorID uint) (models.HelpEventStatistics, error) {
@@ -39,33 +40,45 @@ func (h *HelpEvent) GetHelpEventStatistics(ctx context.Context, fromStart int, c
 	}
 
 	statistics := h.generateStatistics(currentTransactions, previousTransactions)
+	statistics.MoneyRaised, err = h.repo.GetMoneyRaised(ctx, creatorID, statistics.StartDate, statistics.EndDate)
+	if err != nil {
+		return models.HelpEventStatistics{}, err
+	}
 	return statistics, nil
 }
 
-func (h *HelpEvent) provisionEvents() {
-	ctx := context.Background()
-	events, err := h.repo.GetAllHelpEvents(ctx)
-	if err != nil {
-		fmt.Println(err)
-	}
-	//loc, err := time.LoadLocation("Europe/Kiev")
-	//if err != nil {
-	//	fmt.Println(err)
-	//	return
-	//}
-	currentTime := time.Now().Add(3 * time.Hour)
-	for _, e := range events {
-		endDate := e.EndDate
-		if e.Status == models.Active && currentTime.After(endDate) {
-			e.Status = models.Done
-			err = h.repo.UpdateHelpEvent(ctx, e)
+// expireEvents finishes active events whose end date has passed. Events are processed in batches of batchSize.
+func (h *HelpEvent) expireEvents(ctx context.Context, now time.Time, batchSize int) error {
+	var lastErr error
+	var afterID models.ID
+	for {
+		events, err := h.repo.GetExpiredHelpEvents(ctx, now, afterID, batchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, e := range events {
+			afterID = models.ID(e.ID)
+			err = h.expireEvent(ctx, e)
 			if err != nil {
-				fmt.Println(err)
+				lastErr = fmt.Errorf("event %d: %w", e.ID, err)
 			}
 		}
+		if len(events) < batchSize {
+			return lastErr
+		}
 	}
 }
 
+func (h *HelpEvent) expireEvent(ctx context.Context, e models.HelpEvent) error {
+	err := h.repo.UpdateHelpEvent(ctx, models.HelpEvent{ID: e.ID, Status: models.Done})
+	if err != nil {
+		return err
+	}
+
+	return h.settleExpiredEvent(ctx, uint(e.ID), models.HelpEventType, uint(e.CreatedBy))
+}
+
 func (h *HelpEvent) getCurrentMonthTransactions(ctx context.Context, fromStart int, creatorID uint) ([]models.Transaction, error) {
 	currentMonthTo := time.Now()
 	currentMonthFrom := currentMonthTo.AddDate(0, 0, -fromStart)
@@ -156,12 +169,107 @@ func (h *HelpEvent) getRequestsCount(transactions []models.Transaction, from tim
 	return count
 }
 
-func (h *HelpEvent) UpdateHelpEvent(ctx context.Context, event models.HelpEvent) error {
-	return h.repo.UpdateHelpEvent(ctx, event)
+// UpdateHelpEvent updates the event on behalf of its author or an editor among its co-organizers.
+func (h *HelpEvent) UpdateHelpEvent(ctx context.Context, event models.HelpEvent, memberID uint) error {
+	oldEvent, err := h.repo.GetEventByID(ctx, models.ID(event.ID))
+	if err != nil {
+		return err
+	}
+	if !oldEvent.Organizes(memberID, models.EditorRole) {
+		return models.ErrNotFound
+	}
+
+	if event.PublishAt.Valid {
+		endDate := event.EndDate
+		if endDate.IsZero() {
+			endDate = oldEvent.EndDate
+		}
+		err = validatePublishDate(oldEvent.Status, event.PublishAt.Time, endDate)
+		if err != nil {
+			return err
+		}
+	}
+	if oldEvent.Status != models.Active && event.Status == models.Active {
+		err = h.quota.CheckActiveEvents(ctx, oldEvent.CreatedBy, models.HelpEventType)
+		if err != nil {
+			return err
+		}
+	}
+	isPublished := oldEvent.Status == models.InActive && event.Status == models.Active
+
+	needUrgencies, err := urgencyChanges(oldEvent, event.Urgency, event.Needs)
+	if err != nil {
+		return err
+	}
+	event.Needs = nil
+
+	changedTexts := changedEventTexts(oldEvent.Title, event.Title, oldEvent.Description, event.Description)
+	moderationResult := models.ModerationResult{Decision: models.ModerationAllow}
+	if len(changedTexts) != 0 {
+		moderationResult, err = h.moderation.Check(ctx, oldEvent.CreatedBy, changedTexts...)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = h.repo.UpdateHelpEvent(ctx, event)
+	if err != nil {
+		return err
+	}
+	if len(needUrgencies) != 0 {
+		err = h.updateNeeds(ctx, needUrgencies...)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = h.moderation.Flag(ctx, moderationResult, models.ModeratedHelpEvent, oldEvent.ID, oldEvent.CreatedBy,
+		changedTexts...)
+	if err != nil {
+		return err
+	}
+
+	if isPublished {
+		return notifySearchMatches(ctx, h.repo, models.HelpEventType, oldEvent.ID, oldEvent.CreatedBy,
+			oldEvent.Tags, oldEvent.Location)
+	}
+	urgencyChanged := (event.Urgency != "" && event.Urgency != oldEvent.Urgency) || len(needUrgencies) != 0
+	if urgencyChanged && oldEvent.Status == models.Active {
+		return h.notifyUrgencyChanged(ctx, oldEvent, event.Urgency, needUrgencies)
+	}
+
+	return nil
+}
+
+// publishScheduledEvents makes drafts active once their publish date comes.
+// A draft stays scheduled while its author has no room for one more active event.
+func (h *HelpEvent) publishScheduledEvents(ctx context.Context, now time.Time) error {
+	events, err := h.repo.GetScheduledHelpEvents(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	var lastErr error
+	for _, e := range events {
+		err = h.UpdateHelpEvent(ctx, models.HelpEvent{ID: e.ID, Status: models.Active}, e.CreatedBy)
+		if err != nil {
+			lastErr = fmt.Errorf("event %d: %w", e.ID, err)
+		}
+	}
+	return lastErr
 }
 
 func (h *HelpEvent) GetHelpEventBySearch(ctx context.Context, search models.HelpSearchInternal) (models.HelpEventPagination, error) {
-	events, err := h.repo.GetHelpEventsWithSearchAndSort(ctx, search)
+	err := models.ValidateGeoSearch(search.Near, search.RadiusKm, search.SortField)
+	if err != nil {
+		return models.HelpEventPagination{}, err
+	}
+	err = models.ValidateUrgencies(search.Urgency)
+	if err != nil {
+		return models.HelpEventPagination{}, err
+	}
+
+	events, err := h.repo.GetHelpEventsWithSearchAndSort(ctx, search, h.now())
 	if err != nil {
 		return models.HelpEventPagination{}, err
 	}
@@ -171,6 +279,23 @@ func (h *HelpEvent) GetHelpEventBySearch(ctx context.Context, search models.Help
 	return events, nil
 }
 
+// GetHelpEvents returns a page of all active help events.
+func (h *HelpEvent) GetHelpEvents(ctx context.Context, pagination models.PaginationRequest) (models.HelpEventPagination, error) {
+	return h.GetHelpEventBySearch(ctx, models.HelpSearchInternal{
+		State:      []models.EventStatus{models.Active},
+		Pagination: pagination,
+	})
+}
+
+// GetMemberHelpEvents returns a page of the published help events created by the member.
+func (h *HelpEvent) GetMemberHelpEvents(ctx context.Context, memberID uint,
+	pagination models.PaginationRequest) (models.HelpEventPagination, error) {
+	return h.GetHelpEventBySearch(ctx, models.HelpSearchInternal{
+		CreatorID:  &memberID,
+		Pagination: pagination,
+	})
+}
+
 func (h *HelpEvent) GetUserHelpEvents(ctx context.Context, userID models.ID) ([]models.HelpEvent, error) {
 	events, err := h.repo.GetUserHelpEvents(ctx, userID)
 	if err != nil {
@@ -192,71 +317,70 @@ func (h *HelpEvent) UpdateTransactionStatus(ctx context.Context, transaction mod
 	if err != nil {
 		return err
 	}
-	var notificationReceiver uint
+	// completed transactions can still be corrected, the other finished ones have released their pledges
+	if oldTransaction.TransactionStatus != models.Completed &&
+		lo.Contains(models.FinishedTransactionStatuses, oldTransaction.TransactionStatus) {
+		return fmt.Errorf("transaction cannot be changed when it it in %s state", oldTransaction.TransactionStatus)
+	}
+	helpEvent, err := h.repo.GetEventByID(ctx, models.ID(oldTransaction.EventID))
+	if err != nil {
+		return err
+	}
+	// the organizers side is the author and the transaction managers, the other side is the responder only
+	if transaction.EventCreator && !helpEvent.Organizes(transaction.HelpEventCreatorID, models.TransactionManagerRole) ||
+		!transaction.EventCreator && oldTransaction.CreatorID != transaction.TransactionCreatorID {
+		return models.ErrNotFound
+	}
 	notificationStatus := models.TransactionStatus("")
 	if transaction.EventCreator {
+		wasCompleted := oldTransaction.TransactionStatus == models.Completed
 		notificationStatus = transaction.TransactionStatus
 		oldTransaction.UpdateStatus(!transaction.EventCreator, transaction.TransactionStatus)
 		oldTransaction.CompetitionDate = sql.NullTime{
 			Time:  time.Now(),
 			Valid: true,
 		}
-		notificationReceiver = oldTransaction.CreatorID
 		if transaction.TransactionStatus == models.Completed {
-			eventNeeds, err := h.repo.GetHelpEventNeeds(ctx, models.ID(*transaction.HelpEventID))
+			err = h.convertReceived(ctx, *transaction.TransactionID, transaction.Needs)
 			if err != nil {
 				return err
 			}
-			transactionNeeds, err := h.repo.GetTransactionNeeds(ctx, models.ID(*transaction.TransactionID))
+			err = h.confirmNeedsReceived(ctx, *transaction.TransactionID, transaction.Needs)
 			if err != nil {
 				return err
 			}
-			for i, eventNeed := range eventNeeds {
-				transactionNeed, transactionNeedIndex, _ := lo.FindIndexOf(transactionNeeds, func(n models.Need) bool {
-					return n.Title == eventNeed.Title && n.Unit == eventNeed.Unit && n.Amount == eventNeed.Amount
-				})
-				if transactionNeedIndex == -1 {
-					continue
+			// receipts of a transaction are recorded once, later changes are corrections of the ledger
+			if !wasCompleted {
+				err = h.recordReceipts(ctx, *transaction.HelpEventID, *transaction.TransactionID,
+					transaction.HelpEventCreatorID)
+				if err != nil {
+					return err
 				}
-				eventNeeds[i].ReceivedTotal += transactionNeed.Received
 			}
-			err = h.updateNeeds(ctx, eventNeeds...)
-			if err != nil {
-				return err
-			}
-			err = h.CompleteHelpEvent(ctx, *transaction.HelpEventID, eventNeeds)
+			err = h.completeReceivedHelpEvent(ctx, *transaction.HelpEventID)
 			if err != nil {
 				return err
 			}
 		}
 	} else {
 		notificationStatus = transaction.ResponderStatus
-		helpEvent, err := h.repo.GetEventByID(ctx, models.ID(oldTransaction.EventID))
+		oldTransaction.UpdateStatus(!transaction.EventCreator, transaction.ResponderStatus)
+		err = h.convertReceived(ctx, *transaction.TransactionID, transaction.Needs)
 		if err != nil {
 			return err
 		}
-		notificationReceiver = helpEvent.CreatedBy
-		oldTransaction.UpdateStatus(!transaction.EventCreator, transaction.ResponderStatus)
 		err = h.updateNeeds(ctx, transaction.Needs...)
 		if err != nil {
 			return err
 		}
 
 		if transaction.ResponderStatus == models.Completed {
-			if createdFilePath != "" {
-				oldTransaction.ReportURL = createdFilePath
-			} else {
-				fileUniqueID, err := uuid.NewUUID()
-				if err != nil {
-					return err
-				}
-				fileName := fmt.Sprintf("%s.%s", fileUniqueID.String(), fileType)
-				filePath, err := h.repo.Upload(ctx, fileName, file)
-				if err != nil {
-					return err
-				}
-				oldTransaction.ReportURL = filePath
+			report, err := saveTransactionReport(ctx, h.repo, oldTransaction, transaction.TransactionCreatorID,
+				file, fileType, createdFilePath)
+			if err != nil {
+				return err
 			}
+			oldTransaction.ReportURL = report.S3Path
 		}
 	}
 
@@ -264,8 +388,14 @@ func (h *HelpEvent) UpdateTransactionStatus(ctx context.Context, transaction mod
 	if err != nil {
 		return err
 	}
+	if lo.Contains(slotReleasingStatuses, oldTransaction.TransactionStatus) {
+		_, err = h.repo.ReleaseTransactionPledges(ctx, oldTransaction.ID)
+		if err != nil {
+			return err
+		}
+	}
 
-	err = h.createNotification(ctx, models.TransactionNotification{
+	notification := models.TransactionNotification{
 		EventType:     models.HelpEventType,
 		EventID:       oldTransaction.EventID,
 		Action:        models.Updated,
@@ -273,15 +403,80 @@ func (h *HelpEvent) UpdateTransactionStatus(ctx context.Context, transaction mod
 		IsRead:        false,
 		NewStatus:     notificationStatus,
 		CreationTime:  time.Now(),
-		MemberID:      notificationReceiver,
-	})
+	}
+	if transaction.EventCreator {
+		notification.MemberID = oldTransaction.CreatorID
+		return h.createNotification(ctx, notification)
+	}
 
-	return err
+	return notifyOrganizers(ctx, h.repo, notification, helpEvent.CreatedBy, helpEvent.CoOrganizers)
+}
+
+// DeleteHelpEvent deletes the author's event, cancels its unfinished transactions and gives their pledges back.
+// Responders of the canceled transactions and the co-organizers are notified.
+func (h *HelpEvent) DeleteHelpEvent(ctx context.Context, id, memberID uint) error {
+	event, err := h.repo.GetEventByID(ctx, models.ID(id))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return models.ErrNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if event.CreatedBy != memberID {
+		return models.ErrNotFound
+	}
+	transactions, err := h.repo.GetCurrentEventTransactions(ctx, id, models.HelpEventType)
+	if err != nil {
+		return err
+	}
+
+	err = h.repo.DeleteHelpEvent(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	for _, transaction := range transactions {
+		_, err = h.repo.ReleaseTransactionPledges(ctx, transaction.ID)
+		if err != nil {
+			return err
+		}
+		err = h.createNotification(ctx, models.TransactionNotification{
+			EventType:     models.HelpEventType,
+			EventID:       id,
+			Action:        models.EventDeleted,
+			TransactionID: transaction.ID,
+			NewStatus:     models.Canceled,
+			IsRead:        false,
+			CreationTime:  time.Now(),
+			MemberID:      transaction.CreatorID,
+			Summary:       "Your transaction was canceled.",
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, coOrganizer := range event.CoOrganizers {
+		err = h.createNotification(ctx, models.TransactionNotification{
+			EventType:    models.HelpEventType,
+			EventID:      id,
+			Action:       models.EventDeleted,
+			IsRead:       false,
+			CreationTime: time.Now(),
+			MemberID:     coOrganizer.MemberID,
+			Summary:      fmt.Sprintf("%d unfinished transactions were canceled.", len(transactions)),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func (h *HelpEvent) CompleteHelpEvent(ctx context.Context, helpEventID uint, eventNeeds []models.Need) error {
 	allNeedsCompleted := lo.CountBy(eventNeeds, func(n models.Need) bool {
-		return n.Amount == n.ReceivedTotal
+		return n.IsReceived()
 	}) == len(eventNeeds)
 
 	if allNeedsCompleted {
@@ -302,23 +497,105 @@ func (h *HelpEvent) CompleteHelpEvent(ctx context.Context, helpEventID uint, eve
 }
 
 func (h *HelpEvent) CreateHelpEvent(ctx context.Context, event *models.HelpEvent) (uint, error) {
-	userID := models.ID(event.CreatedBy)
-	events, err := h.GetUserHelpEvents(ctx, userID)
+	var err error
+	if event.Status != models.InActive {
+		err = h.quota.CheckActiveEvents(ctx, event.CreatedBy, models.HelpEventType)
+		if err != nil {
+			return 0, err
+		}
+	}
+	if event.PublishAt.Valid {
+		err = validatePublishDate(event.Status, event.PublishAt.Time, event.EndDate)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	err = event.Questionnaire.Validate()
+	if err != nil {
+		return 0, err
+	}
+	err = event.Location.ValidateCoordinates()
+	if err != nil {
+		return 0, err
+	}
+	err = event.Urgency.Validate()
+	if err != nil {
+		return 0, err
+	}
+	err = validateNeeds(event.Needs)
+	if err != nil {
+		return 0, err
+	}
+	err = validateDropPoints(event.DropPoints)
+	if err != nil {
+		return 0, err
+	}
+	err = h.units.normalizeNeeds(ctx, event.Needs)
 	if err != nil {
 		return 0, err
 	}
-	currentEventsCount := uint(0)
-	for _, event := range events {
-		if event.Status == models.Active {
-			currentEventsCount += 1
-		}
 
-		if currentEventsCount >= h.MaxEventsPerUser {
-			return 0, fmt.Errorf("user cannot create more than %d events", h.MaxEventsPerUser)
-		}
+	moderationResult, err := h.moderation.Check(ctx, event.CreatedBy, event.Title, event.Description)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := h.repo.CreateEvent(ctx, event)
+	if err != nil {
+		return 0, err
+	}
+
+	return id, h.moderation.Flag(ctx, moderationResult, models.ModeratedHelpEvent, id, event.CreatedBy,
+		event.Title, event.Description)
+}
+
+// CloneHelpEvent copies the author's event with its needs and their urgency into a new draft with reset counters.
+// The copy ends at the requested end date.
+func (h *HelpEvent) CloneHelpEvent(ctx context.Context, id models.ID, authorID uint, request models.CloneEventRequest) (uint, error) {
+	event, err := h.repo.GetEventByID(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+	if event.CreatedBy != authorID {
+		return 0, models.ErrNotFound
+	}
+	err = request.Validate(time.Now())
+	if err != nil {
+		return 0, err
+	}
+	if len(request.TimeSlots) != 0 {
+		return 0, fmt.Errorf("%w: help events have no time slots", models.ErrInvalidClone)
+	}
 
+	needs := make([]models.Need, len(event.Needs))
+	for i, need := range event.Needs {
+		needs[i] = models.Need{
+			Title:       need.Title,
+			Type:        need.Type,
+			Amount:      need.Amount,
+			AmountMinor: need.AmountMinor,
+			Unit:        need.Unit,
+			Currency:    need.Currency,
+			Urgency:     need.Urgency,
+		}
 	}
-	return h.repo.CreateEvent(ctx, event)
+
+	return h.CreateHelpEvent(ctx, &models.HelpEvent{
+		Title:         event.Title,
+		Description:   event.Description,
+		Needs:         needs,
+		Tags:          cloneTags(event.Tags),
+		EndDate:       request.EndDate,
+		Urgency:       event.Urgency,
+		Status:        models.InActive,
+		CreatedBy:     authorID,
+		CreatedAt:     time.Now(),
+		Location:      cloneLocation(event.Location),
+		DropPoints:    cloneDropPoints(event.DropPoints),
+		ImagePath:     event.ImagePath,
+		Questionnaire: event.Questionnaire,
+	})
 }
 
 func (h *HelpEvent) GetHelpEventByID(ctx context.Context, id models.ID) (models.HelpEvent, error) {
@@ -339,6 +616,9 @@ func (h *HelpEvent) CreateRequest(ctx context.Context, userID models.ID, transac
 	if models.ID(helpEvent.CreatedBy) == userID {
 		return 0, fmt.Errorf("event creator cannot response his/her own events")
 	}
+	if helpEvent.Status == models.InActive {
+		return 0, models.ErrNotFound
+	}
 	for _, transaction := range helpEvent.Transactions {
 		if models.ID(transaction.CreatorID) == userID && lo.Contains([]models.TransactionStatus{
 			models.Accepted,
@@ -348,47 +628,109 @@ func (h *HelpEvent) CreateRequest(ctx context.Context, userID models.ID, transac
 			return 0, fmt.Errorf("user already has transaction in this event")
 		}
 	}
-	transactionID, err := h.CreateTransaction(ctx, models.Transaction{
-		CreatorID:         uint(userID),
-		EventID:           uint(transactionInfo.ID),
-		Comment:           transactionInfo.Comment,
-		EventType:         models.HelpEventType,
-		CreationDate:      time.Now(),
-		TransactionStatus: models.Waiting,
-		ResponderStatus:   models.NotStarted,
-	})
+	err = h.quota.CheckPendingResponses(ctx, uint(userID))
 	if err != nil {
 		return 0, err
 	}
-
-	helpEventNeeds, err := h.repo.GetHelpEventNeeds(ctx, models.ID(transactionInfo.ID))
+	err = helpEvent.Questionnaire.CheckAnswers(transactionInfo.Answers)
 	if err != nil {
 		return 0, err
 	}
-
-	for i := range helpEventNeeds {
-		helpEventNeeds[i].TransactionID = &transactionID
-		helpEventNeeds[i].ID = 0
-		helpEventNeeds[i].Received = 0
-		_, err := h.repo.CreateNeed(ctx, helpEventNeeds[i])
+	var pledges []models.Need
+	if len(transactionInfo.Pledges) != 0 {
+		registry, err := h.units.registry(ctx)
+		if err != nil {
+			return 0, err
+		}
+		pledges, err = preparePledges(registry, helpEvent.Needs, transactionInfo.Pledges)
 		if err != nil {
 			return 0, err
 		}
 	}
 
-	err = h.createNotification(ctx, models.TransactionNotification{
+	transaction := models.Transaction{
+		CreatorID:            uint(userID),
+		EventID:              uint(transactionInfo.ID),
+		Comment:              transactionInfo.Comment,
+		EventType:            models.HelpEventType,
+		CreationDate:         time.Now(),
+		TransactionStatus:    models.Waiting,
+		ResponderStatus:      models.NotStarted,
+		QuestionnaireAnswers: transactionInfo.Answers,
+	}
+	err = chooseDropPoint(helpEvent, &transaction, transactionInfo.DropPointID, transactionInfo.ArrivalWindow)
+	if err != nil {
+		return 0, err
+	}
+	var transactionID uint
+	if len(pledges) != 0 {
+		transactionID, err = h.repo.CreateTransactionWithPledges(ctx, transaction, pledges)
+	} else {
+		transactionID, err = h.createTransactionWithAllNeeds(ctx, transaction)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	err = notifyOrganizers(ctx, h.repo, models.TransactionNotification{
 		EventType:     models.HelpEventType,
 		EventID:       uint(transactionInfo.ID),
 		Action:        models.Created,
 		TransactionID: transactionID,
 		IsRead:        false,
 		CreationTime:  time.Now(),
-		MemberID:      helpEvent.CreatedBy,
-	})
+	}, helpEvent.CreatedBy, helpEvent.CoOrganizers)
 
 	return transactionID, err
 }
 
+// validateNeeds checks the needs of a new event, needs without a type are goods.
+func validateNeeds(needs []models.Need) error {
+	for i := range needs {
+		if needs[i].Type == "" {
+			needs[i].Type = models.GoodsNeed
+		}
+		err := needs[i].Validate()
+		if err != nil {
+			return err
+		}
+		err = needs[i].Urgency.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// createTransactionWithAllNeeds creates the transaction of a responder who takes part in all needs of the event.
+func (h *HelpEvent) createTransactionWithAllNeeds(ctx context.Context, transaction models.Transaction) (uint, error) {
+	transactionID, err := h.CreateTransaction(ctx, transaction)
+	if err != nil {
+		return 0, err
+	}
+
+	helpEventNeeds, err := h.repo.GetHelpEventNeeds(ctx, models.ID(transaction.EventID))
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range helpEventNeeds {
+		helpEventNeeds[i].TransactionID = &transactionID
+		helpEventNeeds[i].ID = 0
+		helpEventNeeds[i].Received = 0
+		helpEventNeeds[i].Reserved = 0
+		helpEventNeeds[i].ReceivedMinor = 0
+		helpEventNeeds[i].ReservedMinor = 0
+		_, err := h.repo.CreateNeed(ctx, helpEventNeeds[i])
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return transactionID, nil
+}
+
 func (h *HelpEvent) createNotification(ctx context.Context, notification models.TransactionNotification) error {
 	_, err := h.repo.CreateNotification(ctx, notification)
 	return err
@@ -55,9 +55,13 @@ func TestGetHelpEventStatistics(t *testing.T) {
 			ResponderStatus:   models.Waiting,
 		},
 	}, nil)
+	repo.EXPECT().
+		GetMoneyRaised(context.TODO(), uint(1), gomock.Any(), gomock.Any()).
+		Return([]models.MoneyTotal{{Currency: "UAH", Amount: 150000}}, nil)
 
 	stats, err := helpEvent.GetHelpEventStatistics(context.TODO(), 28, uint(1))
 	assert.NoError(t, err)
+	assert.Equal(t, []models.MoneyTotal{{Currency: "UAH", Amount: 150000}}, stats.MoneyRaised)
 
 	expectedStatistics := models.HelpEventStatistics{
 		DefaultStatistics: models.DefaultStatistics{
@@ -72,3 +76,132 @@ func TestGetHelpEventStatistics(t *testing.T) {
 	assert.Equal(t, expectedStatistics.TransactionsCount, stats.TransactionsCount)
 	assert.Equal(t, expectedStatistics.TransactionsCountCompareWithPreviousMonth, stats.TransactionsCountCompareWithPreviousMonth)
 }
+
+func TestGetHelpEventsListsActiveEvents(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+	pagination := models.PaginationRequest{PageNumber: 2, PageSize: 5}
+
+	repo.EXPECT().
+		GetHelpEventsWithSearchAndSort(context.TODO(), models.HelpSearchInternal{
+			State:      []models.EventStatus{models.Active},
+			Pagination: pagination,
+		}, gomock.Any()).
+		Return(models.HelpEventPagination{Events: []models.HelpEvent{{ID: 1}}}, nil)
+
+	helpEvent := service.NewHelpEvent(repo)
+	events, err := helpEvent.GetHelpEvents(context.TODO(), pagination)
+
+	assert.NoError(t, err)
+	assert.Len(t, events.Events, 1)
+}
+
+func TestGetHelpEventBySearchUsesJobClock(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+	now := time.Date(2026, 10, 8, 12, 0, 0, 0, time.UTC)
+
+	repo.EXPECT().
+		GetHelpEventsWithSearchAndSort(context.TODO(), gomock.Any(), now).
+		Return(models.HelpEventPagination{}, nil)
+
+	helpEvent := service.NewHelpEvent(repo)
+	service.SetClock(helpEvent, func() time.Time {
+		return now
+	})
+	_, err := helpEvent.GetHelpEventBySearch(context.TODO(), models.HelpSearchInternal{})
+
+	assert.NoError(t, err)
+}
+
+func TestGetMemberHelpEventsFiltersByCreator(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+	pagination := models.PaginationRequest{PageNumber: 1, PageSize: 10}
+
+	repo.EXPECT().
+		GetHelpEventsWithSearchAndSort(context.TODO(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, search models.HelpSearchInternal, _ time.Time) (models.HelpEventPagination, error) {
+			assert.Equal(t, uint(7), *search.CreatorID)
+			assert.Empty(t, search.State)
+			assert.Equal(t, pagination, search.Pagination)
+			return models.HelpEventPagination{Events: []models.HelpEvent{{ID: 3, CreatedBy: 7}}}, nil
+		})
+
+	helpEvent := service.NewHelpEvent(repo)
+	events, err := helpEvent.GetMemberHelpEvents(context.TODO(), 7, pagination)
+
+	assert.NoError(t, err)
+	assert.Equal(t, uint(7), events.Events[0].CreatedBy)
+}
+
+func TestDeleteHelpEventCancelsTransactionsAndNotifiesParticipants(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().
+		GetEventByID(context.TODO(), models.ID(1)).
+		Return(models.HelpEvent{
+			ID:           1,
+			CreatedBy:    2,
+			CoOrganizers: []models.EventCoOrganizer{{MemberID: 4, Role: models.EditorRole}},
+		}, nil)
+	repo.EXPECT().
+		GetCurrentEventTransactions(context.TODO(), uint(1), models.EventType(models.HelpEventType)).
+		Return([]models.Transaction{{ID: 20, CreatorID: 3}, {ID: 21, CreatorID: 5}}, nil)
+	repo.EXPECT().
+		DeleteHelpEvent(context.TODO(), uint(1))
+	repo.EXPECT().
+		ReleaseTransactionPledges(context.TODO(), uint(20)).
+		Return(true, nil)
+	repo.EXPECT().
+		ReleaseTransactionPledges(context.TODO(), uint(21)).
+		Return(true, nil)
+
+	notified := map[uint]models.TransactionNotification{}
+	repo.EXPECT().
+		CreateNotification(context.TODO(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, notification models.TransactionNotification) (uint, error) {
+			notified[notification.MemberID] = notification
+			return 1, nil
+		}).
+		Times(3)
+
+	helpEvent := service.NewHelpEvent(repo)
+	err := helpEvent.DeleteHelpEvent(context.TODO(), 1, 2)
+
+	assert.NoError(t, err)
+	assert.Len(t, notified, 3)
+	assert.Equal(t, models.EventDeleted, notified[3].Action)
+	assert.Equal(t, uint(20), notified[3].TransactionID)
+	assert.Equal(t, models.Canceled, notified[5].NewStatus)
+	assert.Equal(t, "2 unfinished transactions were canceled.", notified[4].Summary)
+}
+
+func TestDeleteHelpEventOfAnotherMember(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().
+		GetEventByID(context.TODO(), models.ID(1)).
+		Return(models.HelpEvent{
+			ID:           1,
+			CreatedBy:    2,
+			CoOrganizers: []models.EventCoOrganizer{{MemberID: 4, Role: models.EditorRole}},
+		}, nil)
+
+	helpEvent := service.NewHelpEvent(repo)
+	err := helpEvent.DeleteHelpEvent(context.TODO(), 1, 4)
+
+	assert.ErrorIs(t, err, models.ErrNotFound)
+}
@@ -0,0 +1,211 @@
+package service
+
+import (
+	"Kurajj/internal/models"
+	"context"
+	"fmt"
+	"github.com/samber/lo"
+	"math"
+	"time"
+)
+
+// GetIntakeLedger returns the intake ledger of all needs of the author's help event.
+func (h *HelpEvent) GetIntakeLedger(ctx context.Context, eventID, memberID uint) ([]models.IntakeEntry, error) {
+	_, err := h.getOwnHelpEvent(ctx, eventID, memberID)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.repo.GetEventIntake(ctx, eventID)
+}
+
+// CorrectIntake records the author's correction of the received total of the event need.
+func (h *HelpEvent) CorrectIntake(ctx context.Context, eventID, memberID uint,
+	correction models.IntakeCorrectionRequest) error {
+	event, err := h.getOwnHelpEvent(ctx, eventID, memberID)
+	if err != nil {
+		return err
+	}
+	need, ok := lo.Find(event.Needs, func(n models.Need) bool {
+		return n.ID == correction.NeedID
+	})
+	if !ok {
+		return fmt.Errorf("%w: the event has no need %d", models.ErrInvalidIntake, correction.NeedID)
+	}
+	if correction.TransactionID != nil && !lo.ContainsBy(event.Transactions, func(t models.Transaction) bool {
+		return t.ID == *correction.TransactionID
+	}) {
+		return fmt.Errorf("%w: the event has no transaction %d", models.ErrInvalidIntake, *correction.TransactionID)
+	}
+
+	entry := models.IntakeEntry{
+		NeedID:        need.ID,
+		HelpEventID:   eventID,
+		TransactionID: correction.TransactionID,
+		Kind:          models.IntakeCorrection,
+		Unit:          need.Unit,
+		RecordedBy:    memberID,
+		Comment:       correction.Comment,
+		CreationDate:  time.Now(),
+	}
+	if need.IsMoney() {
+		entry.QuantityMinor, err = models.MinorUnitsIn(math.Abs(correction.Quantity), correction.Unit)
+		if correction.Quantity < 0 {
+			entry.QuantityMinor = -entry.QuantityMinor
+		}
+	} else {
+		entry.Quantity, err = h.convertIntake(ctx, correction, need.Unit)
+	}
+	if err != nil {
+		return err
+	}
+	if entry.Quantity == 0 && entry.QuantityMinor == 0 {
+		return fmt.Errorf("%w: quantity should not be zero", models.ErrInvalidIntake)
+	}
+	if need.ReceivedTotal+entry.Quantity < 0 || need.ReceivedTotalMinor+entry.QuantityMinor < 0 {
+		return fmt.Errorf("%w: received total of %s cannot be negative", models.ErrInvalidIntake, need.Title)
+	}
+
+	err = h.repo.RecordIntake(ctx, entry)
+	if err != nil {
+		return err
+	}
+
+	return h.completeReceivedHelpEvent(ctx, eventID)
+}
+
+// convertIntake converts the quantity of the correction to the unit of the need.
+func (h *HelpEvent) convertIntake(ctx context.Context, correction models.IntakeCorrectionRequest,
+	unit models.Unit) (float64, error) {
+	registry, err := h.units.registry(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return registry.Convert(correction.Quantity, correction.Unit, unit)
+}
+
+// ReverseIntake undoes the entry of the intake ledger by recording the opposite quantity. Every entry except
+// reversals can be reversed once.
+func (h *HelpEvent) ReverseIntake(ctx context.Context, eventID, entryID, memberID uint, comment string) error {
+	event, err := h.getOwnHelpEvent(ctx, eventID, memberID)
+	if err != nil {
+		return err
+	}
+	entries, err := h.repo.GetEventIntake(ctx, eventID)
+	if err != nil {
+		return err
+	}
+	entry, ok := lo.Find(entries, func(e models.IntakeEntry) bool {
+		return e.ID == entryID
+	})
+	if !ok {
+		return models.ErrNotFound
+	}
+	if entry.Kind == models.IntakeReversal {
+		return fmt.Errorf("%w: a reversal cannot be reversed", models.ErrInvalidIntake)
+	}
+	if lo.ContainsBy(entries, func(e models.IntakeEntry) bool {
+		return e.ReversesID != nil && *e.ReversesID == entryID
+	}) {
+		return fmt.Errorf("%w: entry %d is already reversed", models.ErrInvalidIntake, entryID)
+	}
+	need, _ := lo.Find(event.Needs, func(n models.Need) bool {
+		return n.ID == entry.NeedID
+	})
+	if need.ReceivedTotal-entry.Quantity < 0 || need.ReceivedTotalMinor-entry.QuantityMinor < 0 {
+		return fmt.Errorf("%w: received total of %s cannot be negative", models.ErrInvalidIntake, need.Title)
+	}
+
+	err = h.repo.RecordIntake(ctx, models.IntakeEntry{
+		NeedID:        entry.NeedID,
+		HelpEventID:   eventID,
+		TransactionID: entry.TransactionID,
+		Kind:          models.IntakeReversal,
+		Quantity:      -entry.Quantity,
+		QuantityMinor: -entry.QuantityMinor,
+		Unit:          entry.Unit,
+		RecordedBy:    memberID,
+		ReversesID:    &entry.ID,
+		Comment:       comment,
+		CreationDate:  time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	return h.completeReceivedHelpEvent(ctx, eventID)
+}
+
+// recordReceipts appends what the author confirmed to have received in the transaction
+// to the intake ledger of the event needs.
+func (h *HelpEvent) recordReceipts(ctx context.Context, eventID, transactionID, recordedBy uint) error {
+	eventNeeds, err := h.repo.GetHelpEventNeeds(ctx, models.ID(eventID))
+	if err != nil {
+		return err
+	}
+	transactionNeeds, err := h.repo.GetTransactionNeeds(ctx, models.ID(transactionID))
+	if err != nil {
+		return err
+	}
+
+	entries := make([]models.IntakeEntry, 0, len(transactionNeeds))
+	now := time.Now()
+	for _, transactionNeed := range transactionNeeds {
+		if transactionNeed.Received == 0 && transactionNeed.ReceivedMinor == 0 {
+			continue
+		}
+		eventNeed, ok := eventNeedOf(eventNeeds, transactionNeed)
+		if !ok {
+			continue
+		}
+		entries = append(entries, models.IntakeEntry{
+			NeedID:        eventNeed.ID,
+			HelpEventID:   eventID,
+			TransactionID: &transactionID,
+			Kind:          models.IntakeReceipt,
+			Quantity:      transactionNeed.Received,
+			QuantityMinor: transactionNeed.ReceivedMinor,
+			Unit:          eventNeed.Unit,
+			RecordedBy:    recordedBy,
+			CreationDate:  now,
+		})
+	}
+
+	return h.repo.RecordIntake(ctx, entries...)
+}
+
+// eventNeedOf finds the event need of the transaction need. Transactions responding to all needs of the event
+// have copies of the needs without a link to them, such copies are matched by their title, unit and amounts.
+func eventNeedOf(eventNeeds []models.Need, transactionNeed models.Need) (models.Need, bool) {
+	return lo.Find(eventNeeds, func(n models.Need) bool {
+		if transactionNeed.EventNeedID != nil {
+			return *transactionNeed.EventNeedID == n.ID
+		}
+		return n.Title == transactionNeed.Title && n.Unit == transactionNeed.Unit && n.Amount == transactionNeed.Amount &&
+			n.AmountMinor == transactionNeed.AmountMinor
+	})
+}
+
+// completeReceivedHelpEvent completes the help event when the ledger shows that all its needs were received.
+func (h *HelpEvent) completeReceivedHelpEvent(ctx context.Context, eventID uint) error {
+	eventNeeds, err := h.repo.GetHelpEventNeeds(ctx, models.ID(eventID))
+	if err != nil {
+		return err
+	}
+
+	return h.CompleteHelpEvent(ctx, eventID, eventNeeds)
+}
+
+// getOwnHelpEvent returns the help event of the member, events of other members are not found.
+func (h *HelpEvent) getOwnHelpEvent(ctx context.Context, eventID, memberID uint) (models.HelpEvent, error) {
+	event, err := h.repo.GetEventByID(ctx, models.ID(eventID))
+	if err != nil {
+		return models.HelpEvent{}, err
+	}
+	if event.CreatedBy != memberID {
+		return models.HelpEvent{}, models.ErrNotFound
+	}
+
+	return event, nil
+}
@@ -0,0 +1,295 @@
+package service_test
+
+import (
+	"Kurajj/internal/models"
+	service "Kurajj/internal/services"
+	mock_service "Kurajj/internal/services/mocks"
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func intakeHelpEvent() models.HelpEvent {
+	return models.HelpEvent{
+		ID:        2,
+		CreatedBy: 1,
+		Needs: []models.Need{
+			{ID: 10, Title: "Water", Type: models.GoodsNeed, Amount: 10, ReceivedTotal: 4, HelpEventID: 2, Unit: models.Liter},
+			{ID: 11, Title: "Money", Type: models.MoneyNeed, AmountMinor: 10000, ReceivedTotalMinor: 2500, HelpEventID: 2,
+				Unit: models.MoneyUnit, Currency: "UAH"},
+		},
+		Transactions: []models.Transaction{{ID: 1, CreatorID: 3}},
+	}
+}
+
+func TestCompleteTransactionRecordsReceipts(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().
+		GetTransactionByID(context.TODO(), uint(1)).
+		Return(models.Transaction{
+			ID:                1,
+			CreatorID:         3,
+			EventID:           2,
+			EventType:         models.HelpEventType,
+			TransactionStatus: models.InProcess,
+		}, nil)
+	repo.EXPECT().
+		GetEventByID(context.TODO(), models.ID(2)).
+		Return(intakeHelpEvent(), nil)
+	repo.EXPECT().
+		GetUnits(context.TODO()).
+		Return(seededUnits, nil)
+	repo.EXPECT().
+		GetTransactionNeeds(context.TODO(), models.ID(1)).
+		Return([]models.Need{
+			{ID: 20, Title: "Water", Amount: 5, Received: 4, HelpEventID: 2, TransactionID: lo.ToPtr(uint(1)),
+				EventNeedID: lo.ToPtr(uint(10)), Unit: models.Liter},
+		}, nil).
+		Times(2)
+	repo.EXPECT().
+		ConfirmNeedsReceived(context.TODO(), uint(1), []models.Need{{ID: 20, Received: 4, Unit: models.Liter}})
+	repo.EXPECT().
+		GetHelpEventNeeds(context.TODO(), models.ID(2)).
+		Return([]models.Need{{ID: 10, Title: "Water", Amount: 10, HelpEventID: 2, Unit: models.Liter}}, nil)
+	repo.EXPECT().
+		RecordIntake(context.TODO(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, entries ...models.IntakeEntry) error {
+			assert.Len(t, entries, 1)
+			assert.Equal(t, uint(10), entries[0].NeedID)
+			assert.Equal(t, uint(2), entries[0].HelpEventID)
+			assert.Equal(t, uint(1), *entries[0].TransactionID)
+			assert.Equal(t, models.IntakeReceipt, entries[0].Kind)
+			assert.Equal(t, 4.0, entries[0].Quantity)
+			assert.Equal(t, models.Unit(models.Liter), entries[0].Unit)
+			assert.Equal(t, uint(1), entries[0].RecordedBy)
+			return nil
+		})
+	repo.EXPECT().
+		GetHelpEventNeeds(context.TODO(), models.ID(2)).
+		Return([]models.Need{{ID: 10, Title: "Water", Amount: 10, ReceivedTotal: 4, HelpEventID: 2, Unit: models.Liter}}, nil)
+	repo.EXPECT().
+		UpdateTransactionByID(context.TODO(), uint(1), gomock.Any())
+	repo.EXPECT().
+		ReleaseTransactionPledges(context.TODO(), uint(1)).
+		Return(true, nil).
+		AnyTimes()
+	repo.EXPECT().
+		CreateNotification(context.TODO(), gomock.Any())
+
+	helpEventService := service.NewHelpEvent(repo)
+
+	err := helpEventService.UpdateTransactionStatus(context.TODO(), models.HelpEventTransaction{
+		TransactionID:      lo.ToPtr(uint(1)),
+		HelpEventID:        lo.ToPtr(uint(2)),
+		EventCreator:       true,
+		TransactionStatus:  models.Completed,
+		HelpEventCreatorID: 1,
+		Needs:              []models.Need{{ID: 20, Received: 4000, Unit: "milliliter"}},
+	}, nil, "", "")
+	assert.NoError(t, err)
+}
+
+func TestCorrectIntake(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().
+		GetEventByID(context.TODO(), models.ID(2)).
+		Return(intakeHelpEvent(), nil)
+	repo.EXPECT().
+		GetUnits(context.TODO()).
+		Return(seededUnits, nil)
+	repo.EXPECT().
+		RecordIntake(context.TODO(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, entries ...models.IntakeEntry) error {
+			assert.Len(t, entries, 1)
+			assert.Equal(t, uint(10), entries[0].NeedID)
+			assert.Equal(t, models.IntakeCorrection, entries[0].Kind)
+			assert.Equal(t, -0.5, entries[0].Quantity)
+			assert.Equal(t, models.Unit(models.Liter), entries[0].Unit)
+			assert.Equal(t, uint(1), *entries[0].TransactionID)
+			assert.Equal(t, "one bottle was broken", entries[0].Comment)
+			return nil
+		})
+	repo.EXPECT().
+		GetHelpEventNeeds(context.TODO(), models.ID(2)).
+		Return(intakeHelpEvent().Needs, nil)
+
+	helpEventService := service.NewHelpEvent(repo)
+
+	err := helpEventService.CorrectIntake(context.TODO(), 2, 1, models.IntakeCorrectionRequest{
+		NeedID:        10,
+		Quantity:      -500,
+		Unit:          "milliliter",
+		TransactionID: lo.ToPtr(uint(1)),
+		Comment:       "one bottle was broken",
+	})
+	assert.NoError(t, err)
+}
+
+func TestCorrectMoneyIntake(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().
+		GetEventByID(context.TODO(), models.ID(2)).
+		Return(intakeHelpEvent(), nil)
+	repo.EXPECT().
+		RecordIntake(context.TODO(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, entries ...models.IntakeEntry) error {
+			assert.Len(t, entries, 1)
+			assert.Equal(t, uint(11), entries[0].NeedID)
+			assert.Equal(t, int64(-2500), entries[0].QuantityMinor)
+			assert.Zero(t, entries[0].Quantity)
+			assert.Equal(t, models.Unit(models.MoneyUnit), entries[0].Unit)
+			return nil
+		})
+	repo.EXPECT().
+		GetHelpEventNeeds(context.TODO(), models.ID(2)).
+		Return(intakeHelpEvent().Needs, nil)
+
+	helpEventService := service.NewHelpEvent(repo)
+
+	err := helpEventService.CorrectIntake(context.TODO(), 2, 1, models.IntakeCorrectionRequest{
+		NeedID:   11,
+		Quantity: -2500,
+		Comment:  "the transfer was returned",
+	})
+	assert.NoError(t, err)
+}
+
+func TestCorrectIntakeWithInvalidCorrections(t *testing.T) {
+	tests := []struct {
+		name       string
+		memberID   uint
+		correction models.IntakeCorrectionRequest
+		err        error
+	}{
+		{
+			name:       "not the author",
+			memberID:   3,
+			correction: models.IntakeCorrectionRequest{NeedID: 10, Quantity: 1},
+			err:        models.ErrNotFound,
+		},
+		{
+			name:       "unknown need",
+			memberID:   1,
+			correction: models.IntakeCorrectionRequest{NeedID: 12, Quantity: 1},
+			err:        models.ErrInvalidIntake,
+		},
+		{
+			name:       "unknown transaction",
+			memberID:   1,
+			correction: models.IntakeCorrectionRequest{NeedID: 10, Quantity: 1, TransactionID: lo.ToPtr(uint(5))},
+			err:        models.ErrInvalidIntake,
+		},
+		{
+			name:       "negative received total",
+			memberID:   1,
+			correction: models.IntakeCorrectionRequest{NeedID: 10, Quantity: -5},
+			err:        models.ErrInvalidIntake,
+		},
+		{
+			name:       "incompatible unit",
+			memberID:   1,
+			correction: models.IntakeCorrectionRequest{NeedID: 10, Quantity: 1, Unit: models.Kilogram},
+			err:        models.ErrInvalidUnit,
+		},
+		{
+			name:       "fraction of minor units",
+			memberID:   1,
+			correction: models.IntakeCorrectionRequest{NeedID: 11, Quantity: 0.5},
+			err:        models.ErrInvalidMoney,
+		},
+		{
+			name:       "negative money received total",
+			memberID:   1,
+			correction: models.IntakeCorrectionRequest{NeedID: 11, Quantity: -2501},
+			err:        models.ErrInvalidIntake,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock_service.NewMockRepositorier(mockCtrl)
+
+			repo.EXPECT().
+				GetEventByID(context.TODO(), models.ID(2)).
+				Return(intakeHelpEvent(), nil)
+			repo.EXPECT().
+				GetUnits(context.TODO()).
+				Return(seededUnits, nil).
+				AnyTimes()
+
+			helpEventService := service.NewHelpEvent(repo)
+
+			err := helpEventService.CorrectIntake(context.TODO(), 2, tt.memberID, tt.correction)
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestReverseIntake(t *testing.T) {
+	ledger := []models.IntakeEntry{
+		{ID: 7, NeedID: 10, HelpEventID: 2, TransactionID: lo.ToPtr(uint(1)), Kind: models.IntakeReceipt,
+			Quantity: 4, Unit: models.Liter, RecordedBy: 1},
+		{ID: 8, NeedID: 10, HelpEventID: 2, Kind: models.IntakeCorrection, Quantity: 1, Unit: models.Liter,
+			RecordedBy: 1},
+		{ID: 9, NeedID: 10, HelpEventID: 2, Kind: models.IntakeReversal, Quantity: -1, Unit: models.Liter,
+			RecordedBy: 1, ReversesID: lo.ToPtr(uint(8))},
+	}
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().
+		GetEventByID(context.TODO(), models.ID(2)).
+		Return(intakeHelpEvent(), nil).
+		Times(4)
+	repo.EXPECT().
+		GetEventIntake(context.TODO(), uint(2)).
+		Return(ledger, nil).
+		Times(4)
+	repo.EXPECT().
+		RecordIntake(context.TODO(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, entries ...models.IntakeEntry) error {
+			assert.Len(t, entries, 1)
+			assert.Equal(t, models.IntakeReversal, entries[0].Kind)
+			assert.Equal(t, -4.0, entries[0].Quantity)
+			assert.Equal(t, uint(7), *entries[0].ReversesID)
+			assert.Equal(t, uint(1), *entries[0].TransactionID)
+			return nil
+		})
+	repo.EXPECT().
+		GetHelpEventNeeds(context.TODO(), models.ID(2)).
+		Return(intakeHelpEvent().Needs, nil)
+
+	helpEventService := service.NewHelpEvent(repo)
+
+	err := helpEventService.ReverseIntake(context.TODO(), 2, 7, 1, "counted twice")
+	assert.NoError(t, err)
+
+	err = helpEventService.ReverseIntake(context.TODO(), 2, 8, 1, "")
+	assert.ErrorIs(t, err, models.ErrInvalidIntake)
+
+	err = helpEventService.ReverseIntake(context.TODO(), 2, 9, 1, "")
+	assert.ErrorIs(t, err, models.ErrInvalidIntake)
+
+	err = helpEventService.ReverseIntake(context.TODO(), 2, 6, 1, "")
+	assert.ErrorIs(t, err, models.ErrNotFound)
+}
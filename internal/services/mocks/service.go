@@ -37,6 +37,35 @@ func (m *MockRepositorier) EXPECT() *MockRepositorierMockRecorder {
 	return m.recorder
 }
 
+// AddCoOrganizer mocks base method.
+func (m *MockRepositorier) AddCoOrganizer(ctx context.Context, coOrganizer models.EventCoOrganizer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCoOrganizer", ctx, coOrganizer)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddCoOrganizer indicates an expected call of AddCoOrganizer.
+func (mr *MockRepositorierMockRecorder) AddCoOrganizer(ctx, coOrganizer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCoOrganizer", reflect.TypeOf((*MockRepositorier)(nil).AddCoOrganizer), ctx, coOrganizer)
+}
+
+// AddToWaitlist mocks base method.
+func (m *MockRepositorier) AddToWaitlist(ctx context.Context, entry models.WaitlistEntry) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddToWaitlist", ctx, entry)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddToWaitlist indicates an expected call of AddToWaitlist.
+func (mr *MockRepositorierMockRecorder) AddToWaitlist(ctx, entry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddToWaitlist", reflect.TypeOf((*MockRepositorier)(nil).AddToWaitlist), ctx, entry)
+}
+
 // BanEvent mocks base method.
 func (m *MockRepositorier) BanEvent(ctx context.Context, eventID models.ID, eventType models.EventType) error {
 	m.ctrl.T.Helper()
@@ -65,6 +94,20 @@ func (mr *MockRepositorierMockRecorder) BanUser(ctx, userID interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BanUser", reflect.TypeOf((*MockRepositorier)(nil).BanUser), ctx, userID)
 }
 
+// ChangeCapacity mocks base method.
+func (m *MockRepositorier) ChangeCapacity(ctx context.Context, eventID, capacity uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeCapacity", ctx, eventID, capacity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeCapacity indicates an expected call of ChangeCapacity.
+func (mr *MockRepositorierMockRecorder) ChangeCapacity(ctx, eventID, capacity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeCapacity", reflect.TypeOf((*MockRepositorier)(nil).ChangeCapacity), ctx, eventID, capacity)
+}
+
 // Complain mocks base method.
 func (m *MockRepositorier) Complain(ctx context.Context, complaint models.Complaint) (int, error) {
 	m.ctrl.T.Helper()
@@ -80,6 +123,65 @@ func (mr *MockRepositorierMockRecorder) Complain(ctx, complaint interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complain", reflect.TypeOf((*MockRepositorier)(nil).Complain), ctx, complaint)
 }
 
+// ConfirmNeedsReceived mocks base method.
+func (m *MockRepositorier) ConfirmNeedsReceived(ctx context.Context, transactionID uint, needs []models.Need) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmNeedsReceived", ctx, transactionID, needs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConfirmNeedsReceived indicates an expected call of ConfirmNeedsReceived.
+func (mr *MockRepositorierMockRecorder) ConfirmNeedsReceived(ctx, transactionID, needs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmNeedsReceived", reflect.TypeOf((*MockRepositorier)(nil).ConfirmNeedsReceived), ctx, transactionID, needs)
+}
+
+// CountActiveEvents mocks base method.
+func (m *MockRepositorier) CountActiveEvents(ctx context.Context, memberID uint, eventType models.EventType) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountActiveEvents", ctx, memberID, eventType)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountActiveEvents indicates an expected call of CountActiveEvents.
+func (mr *MockRepositorierMockRecorder) CountActiveEvents(ctx, memberID, eventType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountActiveEvents", reflect.TypeOf((*MockRepositorier)(nil).CountActiveEvents), ctx, memberID, eventType)
+}
+
+// CountCommentsSince mocks base method.
+func (m *MockRepositorier) CountCommentsSince(ctx context.Context, memberID uint, from time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountCommentsSince", ctx, memberID, from)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountCommentsSince indicates an expected call of CountCommentsSince.
+func (mr *MockRepositorierMockRecorder) CountCommentsSince(ctx, memberID, from interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountCommentsSince", reflect.TypeOf((*MockRepositorier)(nil).CountCommentsSince), ctx, memberID, from)
+}
+
+// CountPendingResponses mocks base method.
+func (m *MockRepositorier) CountPendingResponses(ctx context.Context, memberID uint) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountPendingResponses", ctx, memberID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountPendingResponses indicates an expected call of CountPendingResponses.
+func (mr *MockRepositorierMockRecorder) CountPendingResponses(ctx, memberID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountPendingResponses", reflect.TypeOf((*MockRepositorier)(nil).CountPendingResponses), ctx, memberID)
+}
+
 // CreateAdmin mocks base method.
 func (m *MockRepositorier) CreateAdmin(ctx context.Context, admin models.User) (uint, error) {
 	m.ctrl.T.Helper()
@@ -95,6 +197,36 @@ func (mr *MockRepositorierMockRecorder) CreateAdmin(ctx, admin interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAdmin", reflect.TypeOf((*MockRepositorier)(nil).CreateAdmin), ctx, admin)
 }
 
+// CreateBlockedWord mocks base method.
+func (m *MockRepositorier) CreateBlockedWord(ctx context.Context, word models.BlockedWord) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBlockedWord", ctx, word)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBlockedWord indicates an expected call of CreateBlockedWord.
+func (mr *MockRepositorierMockRecorder) CreateBlockedWord(ctx, word interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBlockedWord", reflect.TypeOf((*MockRepositorier)(nil).CreateBlockedWord), ctx, word)
+}
+
+// CreateDropPoint mocks base method.
+func (m *MockRepositorier) CreateDropPoint(ctx context.Context, point models.DropPoint) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDropPoint", ctx, point)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDropPoint indicates an expected call of CreateDropPoint.
+func (mr *MockRepositorierMockRecorder) CreateDropPoint(ctx, point interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDropPoint", reflect.TypeOf((*MockRepositorier)(nil).CreateDropPoint), ctx, point)
+}
+
 // CreateEvent mocks base method.
 func (m *MockRepositorier) CreateEvent(ctx context.Context, event *models.HelpEvent) (uint, error) {
 	m.ctrl.T.Helper()
@@ -110,6 +242,51 @@ func (mr *MockRepositorierMockRecorder) CreateEvent(ctx, event interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEvent", reflect.TypeOf((*MockRepositorier)(nil).CreateEvent), ctx, event)
 }
 
+// CreateEventTemplate mocks base method.
+func (m *MockRepositorier) CreateEventTemplate(ctx context.Context, template models.EventTemplate) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEventTemplate", ctx, template)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEventTemplate indicates an expected call of CreateEventTemplate.
+func (mr *MockRepositorierMockRecorder) CreateEventTemplate(ctx, template interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEventTemplate", reflect.TypeOf((*MockRepositorier)(nil).CreateEventTemplate), ctx, template)
+}
+
+// CreateEventUpdate mocks base method.
+func (m *MockRepositorier) CreateEventUpdate(ctx context.Context, update models.EventUpdate) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEventUpdate", ctx, update)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEventUpdate indicates an expected call of CreateEventUpdate.
+func (mr *MockRepositorierMockRecorder) CreateEventUpdate(ctx, update interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEventUpdate", reflect.TypeOf((*MockRepositorier)(nil).CreateEventUpdate), ctx, update)
+}
+
+// CreateModerationItem mocks base method.
+func (m *MockRepositorier) CreateModerationItem(ctx context.Context, item models.ModerationItem) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateModerationItem", ctx, item)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateModerationItem indicates an expected call of CreateModerationItem.
+func (mr *MockRepositorierMockRecorder) CreateModerationItem(ctx, item interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateModerationItem", reflect.TypeOf((*MockRepositorier)(nil).CreateModerationItem), ctx, item)
+}
+
 // CreateNeed mocks base method.
 func (m *MockRepositorier) CreateNeed(ctx context.Context, need models.Need) (uint, error) {
 	m.ctrl.T.Helper()
@@ -155,6 +332,21 @@ func (mr *MockRepositorierMockRecorder) CreateProposalEvent(ctx, event interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProposalEvent", reflect.TypeOf((*MockRepositorier)(nil).CreateProposalEvent), ctx, event)
 }
 
+// CreateReport mocks base method.
+func (m *MockRepositorier) CreateReport(ctx context.Context, report models.Report) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReport", ctx, report)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReport indicates an expected call of CreateReport.
+func (mr *MockRepositorierMockRecorder) CreateReport(ctx, report interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReport", reflect.TypeOf((*MockRepositorier)(nil).CreateReport), ctx, report)
+}
+
 // CreateTag mocks base method.
 func (m *MockRepositorier) CreateTag(ctx context.Context, tag models.Tag) error {
 	m.ctrl.T.Helper()
@@ -184,6 +376,36 @@ func (mr *MockRepositorierMockRecorder) CreateTransaction(ctx, transaction inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransaction", reflect.TypeOf((*MockRepositorier)(nil).CreateTransaction), ctx, transaction)
 }
 
+// CreateTransactionWithPledges mocks base method.
+func (m *MockRepositorier) CreateTransactionWithPledges(ctx context.Context, transaction models.Transaction, pledges []models.Need) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransactionWithPledges", ctx, transaction, pledges)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTransactionWithPledges indicates an expected call of CreateTransactionWithPledges.
+func (mr *MockRepositorierMockRecorder) CreateTransactionWithPledges(ctx, transaction, pledges interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransactionWithPledges", reflect.TypeOf((*MockRepositorier)(nil).CreateTransactionWithPledges), ctx, transaction, pledges)
+}
+
+// CreateTransactionWithSlot mocks base method.
+func (m *MockRepositorier) CreateTransactionWithSlot(ctx context.Context, transaction models.Transaction) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransactionWithSlot", ctx, transaction)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTransactionWithSlot indicates an expected call of CreateTransactionWithSlot.
+func (mr *MockRepositorierMockRecorder) CreateTransactionWithSlot(ctx, transaction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransactionWithSlot", reflect.TypeOf((*MockRepositorier)(nil).CreateTransactionWithSlot), ctx, transaction)
+}
+
 // CreateUser mocks base method.
 func (m *MockRepositorier) CreateUser(ctx context.Context, user models.User) (uint, error) {
 	m.ctrl.T.Helper()
@@ -241,6 +463,20 @@ func (mr *MockRepositorierMockRecorder) DeleteAllTagsByEvent(ctx, eventID, event
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAllTagsByEvent", reflect.TypeOf((*MockRepositorier)(nil).DeleteAllTagsByEvent), ctx, eventID, eventType)
 }
 
+// DeleteBlockedWord mocks base method.
+func (m *MockRepositorier) DeleteBlockedWord(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBlockedWord", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBlockedWord indicates an expected call of DeleteBlockedWord.
+func (mr *MockRepositorierMockRecorder) DeleteBlockedWord(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBlockedWord", reflect.TypeOf((*MockRepositorier)(nil).DeleteBlockedWord), ctx, id)
+}
+
 // DeleteComment mocks base method.
 func (m *MockRepositorier) DeleteComment(ctx context.Context, id uint) error {
 	m.ctrl.T.Helper()
@@ -255,6 +491,20 @@ func (mr *MockRepositorierMockRecorder) DeleteComment(ctx, id interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteComment", reflect.TypeOf((*MockRepositorier)(nil).DeleteComment), ctx, id)
 }
 
+// DeleteDropPoint mocks base method.
+func (m *MockRepositorier) DeleteDropPoint(ctx context.Context, eventID, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDropPoint", ctx, eventID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDropPoint indicates an expected call of DeleteDropPoint.
+func (mr *MockRepositorierMockRecorder) DeleteDropPoint(ctx, eventID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDropPoint", reflect.TypeOf((*MockRepositorier)(nil).DeleteDropPoint), ctx, eventID, id)
+}
+
 // DeleteEvent mocks base method.
 func (m *MockRepositorier) DeleteEvent(ctx context.Context, id uint) error {
 	m.ctrl.T.Helper()
@@ -269,6 +519,62 @@ func (mr *MockRepositorierMockRecorder) DeleteEvent(ctx, id interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEvent", reflect.TypeOf((*MockRepositorier)(nil).DeleteEvent), ctx, id)
 }
 
+// DeleteEventTemplate mocks base method.
+func (m *MockRepositorier) DeleteEventTemplate(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEventTemplate", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEventTemplate indicates an expected call of DeleteEventTemplate.
+func (mr *MockRepositorierMockRecorder) DeleteEventTemplate(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventTemplate", reflect.TypeOf((*MockRepositorier)(nil).DeleteEventTemplate), ctx, id)
+}
+
+// DeleteEventUpdate mocks base method.
+func (m *MockRepositorier) DeleteEventUpdate(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEventUpdate", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEventUpdate indicates an expected call of DeleteEventUpdate.
+func (mr *MockRepositorierMockRecorder) DeleteEventUpdate(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventUpdate", reflect.TypeOf((*MockRepositorier)(nil).DeleteEventUpdate), ctx, id)
+}
+
+// DeleteHelpEvent mocks base method.
+func (m *MockRepositorier) DeleteHelpEvent(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteHelpEvent", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteHelpEvent indicates an expected call of DeleteHelpEvent.
+func (mr *MockRepositorierMockRecorder) DeleteHelpEvent(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHelpEvent", reflect.TypeOf((*MockRepositorier)(nil).DeleteHelpEvent), ctx, id)
+}
+
+// DeleteMemberQuotaPolicy mocks base method.
+func (m *MockRepositorier) DeleteMemberQuotaPolicy(ctx context.Context, memberID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMemberQuotaPolicy", ctx, memberID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMemberQuotaPolicy indicates an expected call of DeleteMemberQuotaPolicy.
+func (mr *MockRepositorierMockRecorder) DeleteMemberQuotaPolicy(ctx, memberID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMemberQuotaPolicy", reflect.TypeOf((*MockRepositorier)(nil).DeleteMemberQuotaPolicy), ctx, memberID)
+}
+
 // DeleteUser mocks base method.
 func (m *MockRepositorier) DeleteUser(ctx context.Context, id uint) error {
 	m.ctrl.T.Helper()
@@ -283,6 +589,48 @@ func (mr *MockRepositorierMockRecorder) DeleteUser(ctx, id interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockRepositorier)(nil).DeleteUser), ctx, id)
 }
 
+// ExpireClosedEventWaitlists mocks base method.
+func (m *MockRepositorier) ExpireClosedEventWaitlists(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireClosedEventWaitlists", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExpireClosedEventWaitlists indicates an expected call of ExpireClosedEventWaitlists.
+func (mr *MockRepositorierMockRecorder) ExpireClosedEventWaitlists(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireClosedEventWaitlists", reflect.TypeOf((*MockRepositorier)(nil).ExpireClosedEventWaitlists), ctx)
+}
+
+// ExpireWaitlist mocks base method.
+func (m *MockRepositorier) ExpireWaitlist(ctx context.Context, eventID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireWaitlist", ctx, eventID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExpireWaitlist indicates an expected call of ExpireWaitlist.
+func (mr *MockRepositorierMockRecorder) ExpireWaitlist(ctx, eventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireWaitlist", reflect.TypeOf((*MockRepositorier)(nil).ExpireWaitlist), ctx, eventID)
+}
+
+// FollowEvent mocks base method.
+func (m *MockRepositorier) FollowEvent(ctx context.Context, follower models.EventFollower) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FollowEvent", ctx, follower)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FollowEvent indicates an expected call of FollowEvent.
+func (mr *MockRepositorierMockRecorder) FollowEvent(ctx, follower interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FollowEvent", reflect.TypeOf((*MockRepositorier)(nil).FollowEvent), ctx, follower)
+}
+
 // Get mocks base method.
 func (m *MockRepositorier) Get(ctx context.Context, identifier string) (io.Reader, error) {
 	m.ctrl.T.Helper()
@@ -388,6 +736,21 @@ func (mr *MockRepositorierMockRecorder) GetAllHelpEvents(ctx interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllHelpEvents", reflect.TypeOf((*MockRepositorier)(nil).GetAllHelpEvents), ctx)
 }
 
+// GetBlockedWords mocks base method.
+func (m *MockRepositorier) GetBlockedWords(ctx context.Context) ([]models.BlockedWord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockedWords", ctx)
+	ret0, _ := ret[0].([]models.BlockedWord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockedWords indicates an expected call of GetBlockedWords.
+func (mr *MockRepositorierMockRecorder) GetBlockedWords(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockedWords", reflect.TypeOf((*MockRepositorier)(nil).GetBlockedWords), ctx)
+}
+
 // GetByID mocks base method.
 func (m *MockRepositorier) GetByID(ctx context.Context, id uint) (models.TransactionNotification, error) {
 	m.ctrl.T.Helper()
@@ -463,6 +826,21 @@ func (mr *MockRepositorierMockRecorder) GetCurrentEventTransactions(ctx, eventID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentEventTransactions", reflect.TypeOf((*MockRepositorier)(nil).GetCurrentEventTransactions), ctx, eventID, eventType)
 }
 
+// GetDropPointArrivals mocks base method.
+func (m *MockRepositorier) GetDropPointArrivals(ctx context.Context, pointID uint, from, to time.Time) ([]models.DropPointArrival, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDropPointArrivals", ctx, pointID, from, to)
+	ret0, _ := ret[0].([]models.DropPointArrival)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDropPointArrivals indicates an expected call of GetDropPointArrivals.
+func (mr *MockRepositorierMockRecorder) GetDropPointArrivals(ctx, pointID, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDropPointArrivals", reflect.TypeOf((*MockRepositorier)(nil).GetDropPointArrivals), ctx, pointID, from, to)
+}
+
 // GetEntity mocks base method.
 func (m *MockRepositorier) GetEntity(ctx context.Context, email, password string, isAdmin, isDeleted bool) (models.User, error) {
 	m.ctrl.T.Helper()
@@ -508,94 +886,349 @@ func (mr *MockRepositorierMockRecorder) GetEventByID(ctx, id interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventByID", reflect.TypeOf((*MockRepositorier)(nil).GetEventByID), ctx, id)
 }
 
-// GetEvents mocks base method.
-func (m *MockRepositorier) GetEvents(ctx context.Context) ([]models.ProposalEvent, error) {
+// GetEventCoOrganizers mocks base method.
+func (m *MockRepositorier) GetEventCoOrganizers(ctx context.Context, eventID uint, eventType models.EventType) ([]models.EventCoOrganizer, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetEvents", ctx)
-	ret0, _ := ret[0].([]models.ProposalEvent)
+	ret := m.ctrl.Call(m, "GetEventCoOrganizers", ctx, eventID, eventType)
+	ret0, _ := ret[0].([]models.EventCoOrganizer)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetEvents indicates an expected call of GetEvents.
-func (mr *MockRepositorierMockRecorder) GetEvents(ctx interface{}) *gomock.Call {
+// GetEventCoOrganizers indicates an expected call of GetEventCoOrganizers.
+func (mr *MockRepositorierMockRecorder) GetEventCoOrganizers(ctx, eventID, eventType interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvents", reflect.TypeOf((*MockRepositorier)(nil).GetEvents), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventCoOrganizers", reflect.TypeOf((*MockRepositorier)(nil).GetEventCoOrganizers), ctx, eventID, eventType)
 }
 
-// GetGlobalStatistics mocks base method.
-func (m *MockRepositorier) GetGlobalStatistics(ctx context.Context, from, to time.Time) ([]models.Transaction, error) {
+// GetEventFollowers mocks base method.
+func (m *MockRepositorier) GetEventFollowers(ctx context.Context, eventID uint, eventType models.EventType) ([]uint, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetGlobalStatistics", ctx, from, to)
-	ret0, _ := ret[0].([]models.Transaction)
+	ret := m.ctrl.Call(m, "GetEventFollowers", ctx, eventID, eventType)
+	ret0, _ := ret[0].([]uint)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetGlobalStatistics indicates an expected call of GetGlobalStatistics.
-func (mr *MockRepositorierMockRecorder) GetGlobalStatistics(ctx, from, to interface{}) *gomock.Call {
+// GetEventFollowers indicates an expected call of GetEventFollowers.
+func (mr *MockRepositorierMockRecorder) GetEventFollowers(ctx, eventID, eventType interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGlobalStatistics", reflect.TypeOf((*MockRepositorier)(nil).GetGlobalStatistics), ctx, from, to)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventFollowers", reflect.TypeOf((*MockRepositorier)(nil).GetEventFollowers), ctx, eventID, eventType)
 }
 
-// GetHelpEventByTransactionID mocks base method.
-func (m *MockRepositorier) GetHelpEventByTransactionID(ctx context.Context, transactionID models.ID) (models.HelpEvent, error) {
+// GetEventIntake mocks base method.
+func (m *MockRepositorier) GetEventIntake(ctx context.Context, eventID uint) ([]models.IntakeEntry, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetHelpEventByTransactionID", ctx, transactionID)
-	ret0, _ := ret[0].(models.HelpEvent)
+	ret := m.ctrl.Call(m, "GetEventIntake", ctx, eventID)
+	ret0, _ := ret[0].([]models.IntakeEntry)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetHelpEventByTransactionID indicates an expected call of GetHelpEventByTransactionID.
-func (mr *MockRepositorierMockRecorder) GetHelpEventByTransactionID(ctx, transactionID interface{}) *gomock.Call {
+// GetEventIntake indicates an expected call of GetEventIntake.
+func (mr *MockRepositorierMockRecorder) GetEventIntake(ctx, eventID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHelpEventByTransactionID", reflect.TypeOf((*MockRepositorier)(nil).GetHelpEventByTransactionID), ctx, transactionID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventIntake", reflect.TypeOf((*MockRepositorier)(nil).GetEventIntake), ctx, eventID)
 }
 
-// GetHelpEventNeeds mocks base method.
-func (m *MockRepositorier) GetHelpEventNeeds(ctx context.Context, eventID models.ID) ([]models.Need, error) {
+// GetEventReports mocks base method.
+func (m *MockRepositorier) GetEventReports(ctx context.Context, eventID uint, eventType models.EventType) ([]models.Report, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetHelpEventNeeds", ctx, eventID)
-	ret0, _ := ret[0].([]models.Need)
+	ret := m.ctrl.Call(m, "GetEventReports", ctx, eventID, eventType)
+	ret0, _ := ret[0].([]models.Report)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetHelpEventNeeds indicates an expected call of GetHelpEventNeeds.
-func (mr *MockRepositorierMockRecorder) GetHelpEventNeeds(ctx, eventID interface{}) *gomock.Call {
+// GetEventReports indicates an expected call of GetEventReports.
+func (mr *MockRepositorierMockRecorder) GetEventReports(ctx, eventID, eventType interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHelpEventNeeds", reflect.TypeOf((*MockRepositorier)(nil).GetHelpEventNeeds), ctx, eventID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventReports", reflect.TypeOf((*MockRepositorier)(nil).GetEventReports), ctx, eventID, eventType)
 }
 
-// GetHelpEventStatistics mocks base method.
-func (m *MockRepositorier) GetHelpEventStatistics(ctx context.Context, id uint, from, to time.Time) ([]models.Transaction, error) {
+// GetEventTemplateByID mocks base method.
+func (m *MockRepositorier) GetEventTemplateByID(ctx context.Context, id uint) (models.EventTemplate, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetHelpEventStatistics", ctx, id, from, to)
-	ret0, _ := ret[0].([]models.Transaction)
+	ret := m.ctrl.Call(m, "GetEventTemplateByID", ctx, id)
+	ret0, _ := ret[0].(models.EventTemplate)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetHelpEventStatistics indicates an expected call of GetHelpEventStatistics.
-func (mr *MockRepositorierMockRecorder) GetHelpEventStatistics(ctx, id, from, to interface{}) *gomock.Call {
+// GetEventTemplateByID indicates an expected call of GetEventTemplateByID.
+func (mr *MockRepositorierMockRecorder) GetEventTemplateByID(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHelpEventStatistics", reflect.TypeOf((*MockRepositorier)(nil).GetHelpEventStatistics), ctx, id, from, to)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventTemplateByID", reflect.TypeOf((*MockRepositorier)(nil).GetEventTemplateByID), ctx, id)
 }
 
-// GetHelpEventsWithSearchAndSort mocks base method.
-func (m *MockRepositorier) GetHelpEventsWithSearchAndSort(ctx context.Context, searchValues models.HelpSearchInternal) (models.HelpEventPagination, error) {
+// GetEventUpdateByID mocks base method.
+func (m *MockRepositorier) GetEventUpdateByID(ctx context.Context, id uint) (models.EventUpdate, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetHelpEventsWithSearchAndSort", ctx, searchValues)
+	ret := m.ctrl.Call(m, "GetEventUpdateByID", ctx, id)
+	ret0, _ := ret[0].(models.EventUpdate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventUpdateByID indicates an expected call of GetEventUpdateByID.
+func (mr *MockRepositorierMockRecorder) GetEventUpdateByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventUpdateByID", reflect.TypeOf((*MockRepositorier)(nil).GetEventUpdateByID), ctx, id)
+}
+
+// GetEventUpdates mocks base method.
+func (m *MockRepositorier) GetEventUpdates(ctx context.Context, eventID uint, eventType models.EventType) ([]models.EventUpdate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventUpdates", ctx, eventID, eventType)
+	ret0, _ := ret[0].([]models.EventUpdate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventUpdates indicates an expected call of GetEventUpdates.
+func (mr *MockRepositorierMockRecorder) GetEventUpdates(ctx, eventID, eventType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventUpdates", reflect.TypeOf((*MockRepositorier)(nil).GetEventUpdates), ctx, eventID, eventType)
+}
+
+// GetEvents mocks base method.
+func (m *MockRepositorier) GetEvents(ctx context.Context) ([]models.ProposalEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEvents", ctx)
+	ret0, _ := ret[0].([]models.ProposalEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEvents indicates an expected call of GetEvents.
+func (mr *MockRepositorierMockRecorder) GetEvents(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvents", reflect.TypeOf((*MockRepositorier)(nil).GetEvents), ctx)
+}
+
+// GetExpiredHelpEvents mocks base method.
+func (m *MockRepositorier) GetExpiredHelpEvents(ctx context.Context, now time.Time, afterID models.ID, limit int) ([]models.HelpEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExpiredHelpEvents", ctx, now, afterID, limit)
+	ret0, _ := ret[0].([]models.HelpEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExpiredHelpEvents indicates an expected call of GetExpiredHelpEvents.
+func (mr *MockRepositorierMockRecorder) GetExpiredHelpEvents(ctx, now, afterID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpiredHelpEvents", reflect.TypeOf((*MockRepositorier)(nil).GetExpiredHelpEvents), ctx, now, afterID, limit)
+}
+
+// GetExpiredProposalEvents mocks base method.
+func (m *MockRepositorier) GetExpiredProposalEvents(ctx context.Context, now time.Time, afterID uint, limit int) ([]models.ProposalEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExpiredProposalEvents", ctx, now, afterID, limit)
+	ret0, _ := ret[0].([]models.ProposalEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExpiredProposalEvents indicates an expected call of GetExpiredProposalEvents.
+func (mr *MockRepositorierMockRecorder) GetExpiredProposalEvents(ctx, now, afterID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpiredProposalEvents", reflect.TypeOf((*MockRepositorier)(nil).GetExpiredProposalEvents), ctx, now, afterID, limit)
+}
+
+// GetExpiredWaitlistPromotions mocks base method.
+func (m *MockRepositorier) GetExpiredWaitlistPromotions(ctx context.Context, now time.Time) ([]models.WaitlistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExpiredWaitlistPromotions", ctx, now)
+	ret0, _ := ret[0].([]models.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExpiredWaitlistPromotions indicates an expected call of GetExpiredWaitlistPromotions.
+func (mr *MockRepositorierMockRecorder) GetExpiredWaitlistPromotions(ctx, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpiredWaitlistPromotions", reflect.TypeOf((*MockRepositorier)(nil).GetExpiredWaitlistPromotions), ctx, now)
+}
+
+// GetGlobalStatistics mocks base method.
+func (m *MockRepositorier) GetGlobalStatistics(ctx context.Context, from, to time.Time) ([]models.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGlobalStatistics", ctx, from, to)
+	ret0, _ := ret[0].([]models.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGlobalStatistics indicates an expected call of GetGlobalStatistics.
+func (mr *MockRepositorierMockRecorder) GetGlobalStatistics(ctx, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGlobalStatistics", reflect.TypeOf((*MockRepositorier)(nil).GetGlobalStatistics), ctx, from, to)
+}
+
+// GetHelpEventByTransactionID mocks base method.
+func (m *MockRepositorier) GetHelpEventByTransactionID(ctx context.Context, transactionID models.ID) (models.HelpEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHelpEventByTransactionID", ctx, transactionID)
+	ret0, _ := ret[0].(models.HelpEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHelpEventByTransactionID indicates an expected call of GetHelpEventByTransactionID.
+func (mr *MockRepositorierMockRecorder) GetHelpEventByTransactionID(ctx, transactionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHelpEventByTransactionID", reflect.TypeOf((*MockRepositorier)(nil).GetHelpEventByTransactionID), ctx, transactionID)
+}
+
+// GetHelpEventNeeds mocks base method.
+func (m *MockRepositorier) GetHelpEventNeeds(ctx context.Context, eventID models.ID) ([]models.Need, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHelpEventNeeds", ctx, eventID)
+	ret0, _ := ret[0].([]models.Need)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHelpEventNeeds indicates an expected call of GetHelpEventNeeds.
+func (mr *MockRepositorierMockRecorder) GetHelpEventNeeds(ctx, eventID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHelpEventNeeds", reflect.TypeOf((*MockRepositorier)(nil).GetHelpEventNeeds), ctx, eventID)
+}
+
+// GetHelpEventStatistics mocks base method.
+func (m *MockRepositorier) GetHelpEventStatistics(ctx context.Context, id uint, from, to time.Time) ([]models.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHelpEventStatistics", ctx, id, from, to)
+	ret0, _ := ret[0].([]models.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHelpEventStatistics indicates an expected call of GetHelpEventStatistics.
+func (mr *MockRepositorierMockRecorder) GetHelpEventStatistics(ctx, id, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHelpEventStatistics", reflect.TypeOf((*MockRepositorier)(nil).GetHelpEventStatistics), ctx, id, from, to)
+}
+
+// GetHelpEventsWithSearchAndSort mocks base method.
+func (m *MockRepositorier) GetHelpEventsWithSearchAndSort(ctx context.Context, searchValues models.HelpSearchInternal, now time.Time) (models.HelpEventPagination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHelpEventsWithSearchAndSort", ctx, searchValues, now)
 	ret0, _ := ret[0].(models.HelpEventPagination)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetHelpEventsWithSearchAndSort indicates an expected call of GetHelpEventsWithSearchAndSort.
-func (mr *MockRepositorierMockRecorder) GetHelpEventsWithSearchAndSort(ctx, searchValues interface{}) *gomock.Call {
+func (mr *MockRepositorierMockRecorder) GetHelpEventsWithSearchAndSort(ctx, searchValues, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHelpEventsWithSearchAndSort", reflect.TypeOf((*MockRepositorier)(nil).GetHelpEventsWithSearchAndSort), ctx, searchValues, now)
+}
+
+// GetMemberEventTemplates mocks base method.
+func (m *MockRepositorier) GetMemberEventTemplates(ctx context.Context, memberID uint) ([]models.EventTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMemberEventTemplates", ctx, memberID)
+	ret0, _ := ret[0].([]models.EventTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMemberEventTemplates indicates an expected call of GetMemberEventTemplates.
+func (mr *MockRepositorierMockRecorder) GetMemberEventTemplates(ctx, memberID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMemberEventTemplates", reflect.TypeOf((*MockRepositorier)(nil).GetMemberEventTemplates), ctx, memberID)
+}
+
+// GetMemberQuotaPolicies mocks base method.
+func (m *MockRepositorier) GetMemberQuotaPolicies(ctx context.Context, memberID uint) ([]models.QuotaPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMemberQuotaPolicies", ctx, memberID)
+	ret0, _ := ret[0].([]models.QuotaPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMemberQuotaPolicies indicates an expected call of GetMemberQuotaPolicies.
+func (mr *MockRepositorierMockRecorder) GetMemberQuotaPolicies(ctx, memberID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMemberQuotaPolicies", reflect.TypeOf((*MockRepositorier)(nil).GetMemberQuotaPolicies), ctx, memberID)
+}
+
+// GetMemberWaitlistEntry mocks base method.
+func (m *MockRepositorier) GetMemberWaitlistEntry(ctx context.Context, eventID, memberID uint) (models.WaitlistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMemberWaitlistEntry", ctx, eventID, memberID)
+	ret0, _ := ret[0].(models.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMemberWaitlistEntry indicates an expected call of GetMemberWaitlistEntry.
+func (mr *MockRepositorierMockRecorder) GetMemberWaitlistEntry(ctx, eventID, memberID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMemberWaitlistEntry", reflect.TypeOf((*MockRepositorier)(nil).GetMemberWaitlistEntry), ctx, eventID, memberID)
+}
+
+// GetMembersBySearchValues mocks base method.
+func (m *MockRepositorier) GetMembersBySearchValues(ctx context.Context, eventType models.EventType, tags []models.Tag) ([]uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMembersBySearchValues", ctx, eventType, tags)
+	ret0, _ := ret[0].([]uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMembersBySearchValues indicates an expected call of GetMembersBySearchValues.
+func (mr *MockRepositorierMockRecorder) GetMembersBySearchValues(ctx, eventType, tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMembersBySearchValues", reflect.TypeOf((*MockRepositorier)(nil).GetMembersBySearchValues), ctx, eventType, tags)
+}
+
+// GetModerationItemByID mocks base method.
+func (m *MockRepositorier) GetModerationItemByID(ctx context.Context, id uint) (models.ModerationItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModerationItemByID", ctx, id)
+	ret0, _ := ret[0].(models.ModerationItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModerationItemByID indicates an expected call of GetModerationItemByID.
+func (mr *MockRepositorierMockRecorder) GetModerationItemByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModerationItemByID", reflect.TypeOf((*MockRepositorier)(nil).GetModerationItemByID), ctx, id)
+}
+
+// GetModerationItems mocks base method.
+func (m *MockRepositorier) GetModerationItems(ctx context.Context, status models.ModerationStatus) ([]models.ModerationItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModerationItems", ctx, status)
+	ret0, _ := ret[0].([]models.ModerationItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModerationItems indicates an expected call of GetModerationItems.
+func (mr *MockRepositorierMockRecorder) GetModerationItems(ctx, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModerationItems", reflect.TypeOf((*MockRepositorier)(nil).GetModerationItems), ctx, status)
+}
+
+// GetMoneyRaised mocks base method.
+func (m *MockRepositorier) GetMoneyRaised(ctx context.Context, creatorID uint, from, to time.Time) ([]models.MoneyTotal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMoneyRaised", ctx, creatorID, from, to)
+	ret0, _ := ret[0].([]models.MoneyTotal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMoneyRaised indicates an expected call of GetMoneyRaised.
+func (mr *MockRepositorierMockRecorder) GetMoneyRaised(ctx, creatorID, from, to interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHelpEventsWithSearchAndSort", reflect.TypeOf((*MockRepositorier)(nil).GetHelpEventsWithSearchAndSort), ctx, searchValues)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMoneyRaised", reflect.TypeOf((*MockRepositorier)(nil).GetMoneyRaised), ctx, creatorID, from, to)
 }
 
 // GetProposalEventByTransactionID mocks base method.
@@ -643,6 +1276,66 @@ func (mr *MockRepositorierMockRecorder) GetProposalEventsWithSearchAndSort(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProposalEventsWithSearchAndSort", reflect.TypeOf((*MockRepositorier)(nil).GetProposalEventsWithSearchAndSort), ctx, searchValues)
 }
 
+// GetQuotaPolicies mocks base method.
+func (m *MockRepositorier) GetQuotaPolicies(ctx context.Context) ([]models.QuotaPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuotaPolicies", ctx)
+	ret0, _ := ret[0].([]models.QuotaPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuotaPolicies indicates an expected call of GetQuotaPolicies.
+func (mr *MockRepositorierMockRecorder) GetQuotaPolicies(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuotaPolicies", reflect.TypeOf((*MockRepositorier)(nil).GetQuotaPolicies), ctx)
+}
+
+// GetReportByID mocks base method.
+func (m *MockRepositorier) GetReportByID(ctx context.Context, id uint) (models.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReportByID", ctx, id)
+	ret0, _ := ret[0].(models.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReportByID indicates an expected call of GetReportByID.
+func (mr *MockRepositorierMockRecorder) GetReportByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReportByID", reflect.TypeOf((*MockRepositorier)(nil).GetReportByID), ctx, id)
+}
+
+// GetScheduledHelpEvents mocks base method.
+func (m *MockRepositorier) GetScheduledHelpEvents(ctx context.Context, now time.Time) ([]models.HelpEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScheduledHelpEvents", ctx, now)
+	ret0, _ := ret[0].([]models.HelpEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScheduledHelpEvents indicates an expected call of GetScheduledHelpEvents.
+func (mr *MockRepositorierMockRecorder) GetScheduledHelpEvents(ctx, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScheduledHelpEvents", reflect.TypeOf((*MockRepositorier)(nil).GetScheduledHelpEvents), ctx, now)
+}
+
+// GetScheduledProposalEvents mocks base method.
+func (m *MockRepositorier) GetScheduledProposalEvents(ctx context.Context, now time.Time) ([]models.ProposalEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScheduledProposalEvents", ctx, now)
+	ret0, _ := ret[0].([]models.ProposalEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScheduledProposalEvents indicates an expected call of GetScheduledProposalEvents.
+func (mr *MockRepositorierMockRecorder) GetScheduledProposalEvents(ctx, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScheduledProposalEvents", reflect.TypeOf((*MockRepositorier)(nil).GetScheduledProposalEvents), ctx, now)
+}
+
 // GetTagsByEvent mocks base method.
 func (m *MockRepositorier) GetTagsByEvent(ctx context.Context, eventID uint, eventType models.EventType) ([]models.Tag, error) {
 	m.ctrl.T.Helper()
@@ -658,6 +1351,21 @@ func (mr *MockRepositorierMockRecorder) GetTagsByEvent(ctx, eventID, eventType i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTagsByEvent", reflect.TypeOf((*MockRepositorier)(nil).GetTagsByEvent), ctx, eventID, eventType)
 }
 
+// GetTimeSlotBookingsToRemind mocks base method.
+func (m *MockRepositorier) GetTimeSlotBookingsToRemind(ctx context.Context, from, until time.Time) ([]models.TimeSlotBooking, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTimeSlotBookingsToRemind", ctx, from, until)
+	ret0, _ := ret[0].([]models.TimeSlotBooking)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTimeSlotBookingsToRemind indicates an expected call of GetTimeSlotBookingsToRemind.
+func (mr *MockRepositorierMockRecorder) GetTimeSlotBookingsToRemind(ctx, from, until interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTimeSlotBookingsToRemind", reflect.TypeOf((*MockRepositorier)(nil).GetTimeSlotBookingsToRemind), ctx, from, until)
+}
+
 // GetTransactionByID mocks base method.
 func (m *MockRepositorier) GetTransactionByID(ctx context.Context, id uint) (models.Transaction, error) {
 	m.ctrl.T.Helper()
@@ -688,6 +1396,21 @@ func (mr *MockRepositorierMockRecorder) GetTransactionNeeds(ctx, transactionID i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionNeeds", reflect.TypeOf((*MockRepositorier)(nil).GetTransactionNeeds), ctx, transactionID)
 }
 
+// GetUnits mocks base method.
+func (m *MockRepositorier) GetUnits(ctx context.Context) ([]models.UnitDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnits", ctx)
+	ret0, _ := ret[0].([]models.UnitDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnits indicates an expected call of GetUnits.
+func (mr *MockRepositorierMockRecorder) GetUnits(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnits", reflect.TypeOf((*MockRepositorier)(nil).GetUnits), ctx)
+}
+
 // GetUserAuthentication mocks base method.
 func (m *MockRepositorier) GetUserAuthentication(ctx context.Context, email, password string) (models.User, error) {
 	m.ctrl.T.Helper()
@@ -748,53 +1471,292 @@ func (mr *MockRepositorierMockRecorder) GetUserProposalEvents(ctx, userID interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserProposalEvents", reflect.TypeOf((*MockRepositorier)(nil).GetUserProposalEvents), ctx, userID)
 }
 
-// IsEmailTaken mocks base method.
-func (m *MockRepositorier) IsEmailTaken(ctx context.Context, email string) (bool, error) {
+// GetUserRecentTexts mocks base method.
+func (m *MockRepositorier) GetUserRecentTexts(ctx context.Context, userID uint, from time.Time) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IsEmailTaken", ctx, email)
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "GetUserRecentTexts", ctx, userID, from)
+	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// IsEmailTaken indicates an expected call of IsEmailTaken.
-func (mr *MockRepositorierMockRecorder) IsEmailTaken(ctx, email interface{}) *gomock.Call {
+// GetUserRecentTexts indicates an expected call of GetUserRecentTexts.
+func (mr *MockRepositorierMockRecorder) GetUserRecentTexts(ctx, userID, from interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsEmailTaken", reflect.TypeOf((*MockRepositorier)(nil).IsEmailTaken), ctx, email)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRecentTexts", reflect.TypeOf((*MockRepositorier)(nil).GetUserRecentTexts), ctx, userID, from)
 }
 
-// ReadNotifications mocks base method.
-func (m *MockRepositorier) ReadNotifications(ctx context.Context, ids []uint) error {
+// GetWaitlist mocks base method.
+func (m *MockRepositorier) GetWaitlist(ctx context.Context, eventID uint) ([]models.WaitlistEntry, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadNotifications", ctx, ids)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetWaitlist", ctx, eventID)
+	ret0, _ := ret[0].([]models.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// ReadNotifications indicates an expected call of ReadNotifications.
-func (mr *MockRepositorierMockRecorder) ReadNotifications(ctx, ids interface{}) *gomock.Call {
+// GetWaitlist indicates an expected call of GetWaitlist.
+func (mr *MockRepositorierMockRecorder) GetWaitlist(ctx, eventID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadNotifications", reflect.TypeOf((*MockRepositorier)(nil).ReadNotifications), ctx, ids)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWaitlist", reflect.TypeOf((*MockRepositorier)(nil).GetWaitlist), ctx, eventID)
 }
 
-// SetSession mocks base method.
-func (m *MockRepositorier) SetSession(ctx context.Context, userID uint, session models.MemberSession) error {
+// GetWaitlistHead mocks base method.
+func (m *MockRepositorier) GetWaitlistHead(ctx context.Context, eventID uint) (models.WaitlistEntry, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetSession", ctx, userID, session)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetWaitlistHead", ctx, eventID)
+	ret0, _ := ret[0].(models.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// SetSession indicates an expected call of SetSession.
-func (mr *MockRepositorierMockRecorder) SetSession(ctx, userID, session interface{}) *gomock.Call {
+// GetWaitlistHead indicates an expected call of GetWaitlistHead.
+func (mr *MockRepositorierMockRecorder) GetWaitlistHead(ctx, eventID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSession", reflect.TypeOf((*MockRepositorier)(nil).SetSession), ctx, userID, session)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWaitlistHead", reflect.TypeOf((*MockRepositorier)(nil).GetWaitlistHead), ctx, eventID)
 }
 
-// Update mocks base method.
-func (m *MockRepositorier) Update(ctx context.Context, newNotification models.TransactionNotification) error {
+// IsEmailTaken mocks base method.
+func (m *MockRepositorier) IsEmailTaken(ctx context.Context, email string) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", ctx, newNotification)
+	ret := m.ctrl.Call(m, "IsEmailTaken", ctx, email)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsEmailTaken indicates an expected call of IsEmailTaken.
+func (mr *MockRepositorierMockRecorder) IsEmailTaken(ctx, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsEmailTaken", reflect.TypeOf((*MockRepositorier)(nil).IsEmailTaken), ctx, email)
+}
+
+// IsLeader mocks base method.
+func (m *MockRepositorier) IsLeader(ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsLeader", ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsLeader indicates an expected call of IsLeader.
+func (mr *MockRepositorierMockRecorder) IsLeader(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsLeader", reflect.TypeOf((*MockRepositorier)(nil).IsLeader), ctx)
+}
+
+// MarkTimeSlotReminded mocks base method.
+func (m *MockRepositorier) MarkTimeSlotReminded(ctx context.Context, transactionID uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkTimeSlotReminded", ctx, transactionID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkTimeSlotReminded indicates an expected call of MarkTimeSlotReminded.
+func (mr *MockRepositorierMockRecorder) MarkTimeSlotReminded(ctx, transactionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkTimeSlotReminded", reflect.TypeOf((*MockRepositorier)(nil).MarkTimeSlotReminded), ctx, transactionID)
+}
+
+// ReadNotifications mocks base method.
+func (m *MockRepositorier) ReadNotifications(ctx context.Context, ids []uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadNotifications", ctx, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReadNotifications indicates an expected call of ReadNotifications.
+func (mr *MockRepositorierMockRecorder) ReadNotifications(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadNotifications", reflect.TypeOf((*MockRepositorier)(nil).ReadNotifications), ctx, ids)
+}
+
+// RecordIntake mocks base method.
+func (m *MockRepositorier) RecordIntake(ctx context.Context, entries ...models.IntakeEntry) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range entries {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RecordIntake", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordIntake indicates an expected call of RecordIntake.
+func (mr *MockRepositorierMockRecorder) RecordIntake(ctx interface{}, entries ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, entries...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordIntake", reflect.TypeOf((*MockRepositorier)(nil).RecordIntake), varargs...)
+}
+
+// ReleaseSlot mocks base method.
+func (m *MockRepositorier) ReleaseSlot(ctx context.Context, eventID uint, promotedAt, confirmDeadline time.Time) (models.WaitlistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseSlot", ctx, eventID, promotedAt, confirmDeadline)
+	ret0, _ := ret[0].(models.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseSlot indicates an expected call of ReleaseSlot.
+func (mr *MockRepositorierMockRecorder) ReleaseSlot(ctx, eventID, promotedAt, confirmDeadline interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseSlot", reflect.TypeOf((*MockRepositorier)(nil).ReleaseSlot), ctx, eventID, promotedAt, confirmDeadline)
+}
+
+// ReleaseTransactionPledges mocks base method.
+func (m *MockRepositorier) ReleaseTransactionPledges(ctx context.Context, transactionID uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseTransactionPledges", ctx, transactionID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseTransactionPledges indicates an expected call of ReleaseTransactionPledges.
+func (mr *MockRepositorierMockRecorder) ReleaseTransactionPledges(ctx, transactionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseTransactionPledges", reflect.TypeOf((*MockRepositorier)(nil).ReleaseTransactionPledges), ctx, transactionID)
+}
+
+// ReleaseTransactionSlot mocks base method.
+func (m *MockRepositorier) ReleaseTransactionSlot(ctx context.Context, transactionID uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseTransactionSlot", ctx, transactionID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseTransactionSlot indicates an expected call of ReleaseTransactionSlot.
+func (mr *MockRepositorierMockRecorder) ReleaseTransactionSlot(ctx, transactionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseTransactionSlot", reflect.TypeOf((*MockRepositorier)(nil).ReleaseTransactionSlot), ctx, transactionID)
+}
+
+// ReleaseTransactionSlotToWaitlist mocks base method.
+func (m *MockRepositorier) ReleaseTransactionSlotToWaitlist(ctx context.Context, transactionID uint, promotedAt, confirmDeadline time.Time) (models.WaitlistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseTransactionSlotToWaitlist", ctx, transactionID, promotedAt, confirmDeadline)
+	ret0, _ := ret[0].(models.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseTransactionSlotToWaitlist indicates an expected call of ReleaseTransactionSlotToWaitlist.
+func (mr *MockRepositorierMockRecorder) ReleaseTransactionSlotToWaitlist(ctx, transactionID, promotedAt, confirmDeadline interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseTransactionSlotToWaitlist", reflect.TypeOf((*MockRepositorier)(nil).ReleaseTransactionSlotToWaitlist), ctx, transactionID, promotedAt, confirmDeadline)
+}
+
+// RemoveCoOrganizer mocks base method.
+func (m *MockRepositorier) RemoveCoOrganizer(ctx context.Context, eventID uint, eventType models.EventType, memberID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveCoOrganizer", ctx, eventID, eventType, memberID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveCoOrganizer indicates an expected call of RemoveCoOrganizer.
+func (mr *MockRepositorierMockRecorder) RemoveCoOrganizer(ctx, eventID, eventType, memberID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCoOrganizer", reflect.TypeOf((*MockRepositorier)(nil).RemoveCoOrganizer), ctx, eventID, eventType, memberID)
+}
+
+// ResignLeadership mocks base method.
+func (m *MockRepositorier) ResignLeadership(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResignLeadership", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResignLeadership indicates an expected call of ResignLeadership.
+func (mr *MockRepositorierMockRecorder) ResignLeadership(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResignLeadership", reflect.TypeOf((*MockRepositorier)(nil).ResignLeadership), ctx)
+}
+
+// SaveQuotaPolicy mocks base method.
+func (m *MockRepositorier) SaveQuotaPolicy(ctx context.Context, policy models.QuotaPolicy) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveQuotaPolicy", ctx, policy)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveQuotaPolicy indicates an expected call of SaveQuotaPolicy.
+func (mr *MockRepositorierMockRecorder) SaveQuotaPolicy(ctx, policy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveQuotaPolicy", reflect.TypeOf((*MockRepositorier)(nil).SaveQuotaPolicy), ctx, policy)
+}
+
+// SetSession mocks base method.
+func (m *MockRepositorier) SetSession(ctx context.Context, userID uint, session models.MemberSession) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSession", ctx, userID, session)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSession indicates an expected call of SetSession.
+func (mr *MockRepositorierMockRecorder) SetSession(ctx, userID, session interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSession", reflect.TypeOf((*MockRepositorier)(nil).SetSession), ctx, userID, session)
+}
+
+// SetVerifiedOrganization mocks base method.
+func (m *MockRepositorier) SetVerifiedOrganization(ctx context.Context, memberID uint, verified bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetVerifiedOrganization", ctx, memberID, verified)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetVerifiedOrganization indicates an expected call of SetVerifiedOrganization.
+func (mr *MockRepositorierMockRecorder) SetVerifiedOrganization(ctx, memberID, verified interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetVerifiedOrganization", reflect.TypeOf((*MockRepositorier)(nil).SetVerifiedOrganization), ctx, memberID, verified)
+}
+
+// StartNextOccurrence mocks base method.
+func (m *MockRepositorier) StartNextOccurrence(ctx context.Context, eventID uint, occurrence int, endDate time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartNextOccurrence", ctx, eventID, occurrence, endDate)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartNextOccurrence indicates an expected call of StartNextOccurrence.
+func (mr *MockRepositorierMockRecorder) StartNextOccurrence(ctx, eventID, occurrence, endDate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartNextOccurrence", reflect.TypeOf((*MockRepositorier)(nil).StartNextOccurrence), ctx, eventID, occurrence, endDate)
+}
+
+// UnfollowEvent mocks base method.
+func (m *MockRepositorier) UnfollowEvent(ctx context.Context, eventID uint, eventType models.EventType, memberID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnfollowEvent", ctx, eventID, eventType, memberID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnfollowEvent indicates an expected call of UnfollowEvent.
+func (mr *MockRepositorierMockRecorder) UnfollowEvent(ctx, eventID, eventType, memberID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnfollowEvent", reflect.TypeOf((*MockRepositorier)(nil).UnfollowEvent), ctx, eventID, eventType, memberID)
+}
+
+// Update mocks base method.
+func (m *MockRepositorier) Update(ctx context.Context, newNotification models.TransactionNotification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, newNotification)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
@@ -875,6 +1837,20 @@ func (mr *MockRepositorierMockRecorder) UpdateHelpEvent(ctx, event interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHelpEvent", reflect.TypeOf((*MockRepositorier)(nil).UpdateHelpEvent), ctx, event)
 }
 
+// UpdateModerationItem mocks base method.
+func (m *MockRepositorier) UpdateModerationItem(ctx context.Context, id uint, toUpdate map[string]any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateModerationItem", ctx, id, toUpdate)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateModerationItem indicates an expected call of UpdateModerationItem.
+func (mr *MockRepositorierMockRecorder) UpdateModerationItem(ctx, id, toUpdate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateModerationItem", reflect.TypeOf((*MockRepositorier)(nil).UpdateModerationItem), ctx, id, toUpdate)
+}
+
 // UpdateNeeds mocks base method.
 func (m *MockRepositorier) UpdateNeeds(ctx context.Context, needs ...models.Need) error {
 	m.ctrl.T.Helper()
@@ -894,20 +1870,6 @@ func (mr *MockRepositorierMockRecorder) UpdateNeeds(ctx interface{}, needs ...in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNeeds", reflect.TypeOf((*MockRepositorier)(nil).UpdateNeeds), varargs...)
 }
 
-// UpdateRemainingHelps mocks base method.
-func (m *MockRepositorier) UpdateRemainingHelps(ctx context.Context, eventID models.ID, increase bool, number int) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateRemainingHelps", ctx, eventID, increase, number)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// UpdateRemainingHelps indicates an expected call of UpdateRemainingHelps.
-func (mr *MockRepositorierMockRecorder) UpdateRemainingHelps(ctx, eventID, increase, number interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRemainingHelps", reflect.TypeOf((*MockRepositorier)(nil).UpdateRemainingHelps), ctx, eventID, increase, number)
-}
-
 // UpdateTransactionByEvent mocks base method.
 func (m *MockRepositorier) UpdateTransactionByEvent(ctx context.Context, eventID uint, eventType models.EventType, toUpdate map[string]any) error {
 	m.ctrl.T.Helper()
@@ -936,6 +1898,20 @@ func (mr *MockRepositorierMockRecorder) UpdateTransactionByID(ctx, id, toUpdate
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransactionByID", reflect.TypeOf((*MockRepositorier)(nil).UpdateTransactionByID), ctx, id, toUpdate)
 }
 
+// UpdateUser mocks base method.
+func (m *MockRepositorier) UpdateUser(ctx context.Context, user models.UserUpdate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUser", ctx, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUser indicates an expected call of UpdateUser.
+func (mr *MockRepositorierMockRecorder) UpdateUser(ctx, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockRepositorier)(nil).UpdateUser), ctx, user)
+}
+
 // UpdateUserByEmail mocks base method.
 func (m *MockRepositorier) UpdateUserByEmail(ctx context.Context, email string, values map[string]any) error {
 	m.ctrl.T.Helper()
@@ -950,6 +1926,20 @@ func (mr *MockRepositorierMockRecorder) UpdateUserByEmail(ctx, email, values int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserByEmail", reflect.TypeOf((*MockRepositorier)(nil).UpdateUserByEmail), ctx, email, values)
 }
 
+// UpdateWaitlistEntry mocks base method.
+func (m *MockRepositorier) UpdateWaitlistEntry(ctx context.Context, id uint, toUpdate map[string]any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWaitlistEntry", ctx, id, toUpdate)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWaitlistEntry indicates an expected call of UpdateWaitlistEntry.
+func (mr *MockRepositorierMockRecorder) UpdateWaitlistEntry(ctx, id, toUpdate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWaitlistEntry", reflect.TypeOf((*MockRepositorier)(nil).UpdateWaitlistEntry), ctx, id, toUpdate)
+}
+
 // Upload mocks base method.
 func (m *MockRepositorier) Upload(ctx context.Context, fileName string, fileData io.Reader) (string, error) {
 	m.ctrl.T.Helper()
@@ -1045,6 +2035,21 @@ func (m *MockHelpEventer) EXPECT() *MockHelpEventerMockRecorder {
 	return m.recorder
 }
 
+// CloneHelpEvent mocks base method.
+func (m *MockHelpEventer) CloneHelpEvent(ctx context.Context, id models.ID, authorID uint, request models.CloneEventRequest) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloneHelpEvent", ctx, id, authorID, request)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloneHelpEvent indicates an expected call of CloneHelpEvent.
+func (mr *MockHelpEventerMockRecorder) CloneHelpEvent(ctx, id, authorID, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloneHelpEvent", reflect.TypeOf((*MockHelpEventer)(nil).CloneHelpEvent), ctx, id, authorID, request)
+}
+
 // CreateHelpEvent mocks base method.
 func (m *MockHelpEventer) CreateHelpEvent(ctx context.Context, event *models.HelpEvent) (uint, error) {
 	m.ctrl.T.Helper()
@@ -1075,6 +2080,20 @@ func (mr *MockHelpEventerMockRecorder) CreateRequest(ctx, userID, transactionInf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRequest", reflect.TypeOf((*MockHelpEventer)(nil).CreateRequest), ctx, userID, transactionInfo)
 }
 
+// DeleteHelpEvent mocks base method.
+func (m *MockHelpEventer) DeleteHelpEvent(ctx context.Context, id, memberID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteHelpEvent", ctx, id, memberID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteHelpEvent indicates an expected call of DeleteHelpEvent.
+func (mr *MockHelpEventerMockRecorder) DeleteHelpEvent(ctx, id, memberID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHelpEvent", reflect.TypeOf((*MockHelpEventer)(nil).DeleteHelpEvent), ctx, id, memberID)
+}
+
 // GetHelpEventByID mocks base method.
 func (m *MockHelpEventer) GetHelpEventByID(ctx context.Context, id models.ID) (models.HelpEvent, error) {
 	m.ctrl.T.Helper()
@@ -1135,6 +2154,36 @@ func (mr *MockHelpEventerMockRecorder) GetHelpEventStatistics(ctx, fromStart, cr
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHelpEventStatistics", reflect.TypeOf((*MockHelpEventer)(nil).GetHelpEventStatistics), ctx, fromStart, creatorID)
 }
 
+// GetHelpEvents mocks base method.
+func (m *MockHelpEventer) GetHelpEvents(ctx context.Context, pagination models.PaginationRequest) (models.HelpEventPagination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHelpEvents", ctx, pagination)
+	ret0, _ := ret[0].(models.HelpEventPagination)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHelpEvents indicates an expected call of GetHelpEvents.
+func (mr *MockHelpEventerMockRecorder) GetHelpEvents(ctx, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHelpEvents", reflect.TypeOf((*MockHelpEventer)(nil).GetHelpEvents), ctx, pagination)
+}
+
+// GetMemberHelpEvents mocks base method.
+func (m *MockHelpEventer) GetMemberHelpEvents(ctx context.Context, memberID uint, pagination models.PaginationRequest) (models.HelpEventPagination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMemberHelpEvents", ctx, memberID, pagination)
+	ret0, _ := ret[0].(models.HelpEventPagination)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMemberHelpEvents indicates an expected call of GetMemberHelpEvents.
+func (mr *MockHelpEventerMockRecorder) GetMemberHelpEvents(ctx, memberID, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMemberHelpEvents", reflect.TypeOf((*MockHelpEventer)(nil).GetMemberHelpEvents), ctx, memberID, pagination)
+}
+
 // GetUserHelpEvents mocks base method.
 func (m *MockHelpEventer) GetUserHelpEvents(ctx context.Context, userID models.ID) ([]models.HelpEvent, error) {
 	m.ctrl.T.Helper()
@@ -1151,17 +2200,17 @@ func (mr *MockHelpEventerMockRecorder) GetUserHelpEvents(ctx, userID interface{}
 }
 
 // UpdateHelpEvent mocks base method.
-func (m *MockHelpEventer) UpdateHelpEvent(ctx context.Context, event models.HelpEvent) error {
+func (m *MockHelpEventer) UpdateHelpEvent(ctx context.Context, event models.HelpEvent, memberID uint) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateHelpEvent", ctx, event)
+	ret := m.ctrl.Call(m, "UpdateHelpEvent", ctx, event, memberID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateHelpEvent indicates an expected call of UpdateHelpEvent.
-func (mr *MockHelpEventerMockRecorder) UpdateHelpEvent(ctx, event interface{}) *gomock.Call {
+func (mr *MockHelpEventerMockRecorder) UpdateHelpEvent(ctx, event, memberID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHelpEvent", reflect.TypeOf((*MockHelpEventer)(nil).UpdateHelpEvent), ctx, event)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHelpEvent", reflect.TypeOf((*MockHelpEventer)(nil).UpdateHelpEvent), ctx, event, memberID)
 }
 
 // UpdateTransactionStatus mocks base method.
@@ -1215,6 +2264,35 @@ func (mr *MockProposalEventerMockRecorder) Accept(ctx, request interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Accept", reflect.TypeOf((*MockProposalEventer)(nil).Accept), ctx, request)
 }
 
+// CloneEvent mocks base method.
+func (m *MockProposalEventer) CloneEvent(ctx context.Context, id, authorID uint, request models.CloneEventRequest) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloneEvent", ctx, id, authorID, request)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloneEvent indicates an expected call of CloneEvent.
+func (mr *MockProposalEventerMockRecorder) CloneEvent(ctx, id, authorID, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloneEvent", reflect.TypeOf((*MockProposalEventer)(nil).CloneEvent), ctx, id, authorID, request)
+}
+
+// ConfirmWaitlistSlot mocks base method.
+func (m *MockProposalEventer) ConfirmWaitlistSlot(ctx context.Context, proposalEventID, memberID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmWaitlistSlot", ctx, proposalEventID, memberID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConfirmWaitlistSlot indicates an expected call of ConfirmWaitlistSlot.
+func (mr *MockProposalEventerMockRecorder) ConfirmWaitlistSlot(ctx, proposalEventID, memberID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmWaitlistSlot", reflect.TypeOf((*MockProposalEventer)(nil).ConfirmWaitlistSlot), ctx, proposalEventID, memberID)
+}
+
 // CreateEvent mocks base method.
 func (m *MockProposalEventer) CreateEvent(ctx context.Context, event models.ProposalEvent) (uint, error) {
 	m.ctrl.T.Helper()
@@ -1274,6 +2352,21 @@ func (mr *MockProposalEventerMockRecorder) GetEvents(ctx interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvents", reflect.TypeOf((*MockProposalEventer)(nil).GetEvents), ctx)
 }
 
+// GetMemberProposalEvents mocks base method.
+func (m *MockProposalEventer) GetMemberProposalEvents(ctx context.Context, memberID uint, pagination models.PaginationRequest) (models.ProposalEventPagination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMemberProposalEvents", ctx, memberID, pagination)
+	ret0, _ := ret[0].(models.ProposalEventPagination)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMemberProposalEvents indicates an expected call of GetMemberProposalEvents.
+func (mr *MockProposalEventerMockRecorder) GetMemberProposalEvents(ctx, memberID, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMemberProposalEvents", reflect.TypeOf((*MockProposalEventer)(nil).GetMemberProposalEvents), ctx, memberID, pagination)
+}
+
 // GetProposalEventBySearch mocks base method.
 func (m *MockProposalEventer) GetProposalEventBySearch(ctx context.Context, search models.ProposalEventSearchInternal) (models.ProposalEventPagination, error) {
 	m.ctrl.T.Helper()
@@ -1334,32 +2427,76 @@ func (mr *MockProposalEventerMockRecorder) GetUserProposalEvents(ctx, userID int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserProposalEvents", reflect.TypeOf((*MockProposalEventer)(nil).GetUserProposalEvents), ctx, userID)
 }
 
-// Response mocks base method.
-func (m *MockProposalEventer) Response(ctx context.Context, proposalEventID, responderID uint, comment string) error {
+// GetWaitlist mocks base method.
+func (m *MockProposalEventer) GetWaitlist(ctx context.Context, proposalEventID uint) ([]models.WaitlistEntry, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Response", ctx, proposalEventID, responderID, comment)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetWaitlist", ctx, proposalEventID)
+	ret0, _ := ret[0].([]models.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Response indicates an expected call of Response.
-func (mr *MockProposalEventerMockRecorder) Response(ctx, proposalEventID, responderID, comment interface{}) *gomock.Call {
+// GetWaitlist indicates an expected call of GetWaitlist.
+func (mr *MockProposalEventerMockRecorder) GetWaitlist(ctx, proposalEventID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Response", reflect.TypeOf((*MockProposalEventer)(nil).Response), ctx, proposalEventID, responderID, comment)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWaitlist", reflect.TypeOf((*MockProposalEventer)(nil).GetWaitlist), ctx, proposalEventID)
+}
+
+// JoinWaitlist mocks base method.
+func (m *MockProposalEventer) JoinWaitlist(ctx context.Context, proposalEventID, memberID uint, comment string, answers models.QuestionnaireAnswers) (models.WaitlistJoinResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JoinWaitlist", ctx, proposalEventID, memberID, comment, answers)
+	ret0, _ := ret[0].(models.WaitlistJoinResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JoinWaitlist indicates an expected call of JoinWaitlist.
+func (mr *MockProposalEventerMockRecorder) JoinWaitlist(ctx, proposalEventID, memberID, comment, answers interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinWaitlist", reflect.TypeOf((*MockProposalEventer)(nil).JoinWaitlist), ctx, proposalEventID, memberID, comment, answers)
+}
+
+// LeaveWaitlist mocks base method.
+func (m *MockProposalEventer) LeaveWaitlist(ctx context.Context, proposalEventID, memberID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LeaveWaitlist", ctx, proposalEventID, memberID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LeaveWaitlist indicates an expected call of LeaveWaitlist.
+func (mr *MockProposalEventerMockRecorder) LeaveWaitlist(ctx, proposalEventID, memberID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveWaitlist", reflect.TypeOf((*MockProposalEventer)(nil).LeaveWaitlist), ctx, proposalEventID, memberID)
+}
+
+// Response mocks base method.
+func (m *MockProposalEventer) Response(ctx context.Context, proposalEventID, responderID, timeSlotID uint, comment string, answers models.QuestionnaireAnswers) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Response", ctx, proposalEventID, responderID, timeSlotID, comment, answers)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Response indicates an expected call of Response.
+func (mr *MockProposalEventerMockRecorder) Response(ctx, proposalEventID, responderID, timeSlotID, comment, answers interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Response", reflect.TypeOf((*MockProposalEventer)(nil).Response), ctx, proposalEventID, responderID, timeSlotID, comment, answers)
 }
 
 // UpdateProposalEvent mocks base method.
-func (m *MockProposalEventer) UpdateProposalEvent(ctx context.Context, event models.ProposalEvent) error {
+func (m *MockProposalEventer) UpdateProposalEvent(ctx context.Context, event models.ProposalEvent, memberID uint) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateProposalEvent", ctx, event)
+	ret := m.ctrl.Call(m, "UpdateProposalEvent", ctx, event, memberID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateProposalEvent indicates an expected call of UpdateProposalEvent.
-func (mr *MockProposalEventerMockRecorder) UpdateProposalEvent(ctx, event interface{}) *gomock.Call {
+func (mr *MockProposalEventerMockRecorder) UpdateProposalEvent(ctx, event, memberID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProposalEvent", reflect.TypeOf((*MockProposalEventer)(nil).UpdateProposalEvent), ctx, event)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProposalEvent", reflect.TypeOf((*MockProposalEventer)(nil).UpdateProposalEvent), ctx, event, memberID)
 }
 
 // UpdateStatus mocks base method.
@@ -1578,407 +2715,1103 @@ func (m *MockTransactioner) UpdateAllNotFinishedTransactions(ctx context.Context
 	return ret0
 }
 
-// UpdateAllNotFinishedTransactions indicates an expected call of UpdateAllNotFinishedTransactions.
-func (mr *MockTransactionerMockRecorder) UpdateAllNotFinishedTransactions(ctx, eventID, eventType, newStatus interface{}) *gomock.Call {
+// UpdateAllNotFinishedTransactions indicates an expected call of UpdateAllNotFinishedTransactions.
+func (mr *MockTransactionerMockRecorder) UpdateAllNotFinishedTransactions(ctx, eventID, eventType, newStatus interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAllNotFinishedTransactions", reflect.TypeOf((*MockTransactioner)(nil).UpdateAllNotFinishedTransactions), ctx, eventID, eventType, newStatus)
+}
+
+// UpdateTransaction mocks base method.
+func (m *MockTransactioner) UpdateTransaction(ctx context.Context, transaction models.Transaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTransaction", ctx, transaction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTransaction indicates an expected call of UpdateTransaction.
+func (mr *MockTransactionerMockRecorder) UpdateTransaction(ctx, transaction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransaction", reflect.TypeOf((*MockTransactioner)(nil).UpdateTransaction), ctx, transaction)
+}
+
+// MockCommenter is a mock of Commenter interface.
+type MockCommenter struct {
+	ctrl     *gomock.Controller
+	recorder *MockCommenterMockRecorder
+}
+
+// MockCommenterMockRecorder is the mock recorder for MockCommenter.
+type MockCommenterMockRecorder struct {
+	mock *MockCommenter
+}
+
+// NewMockCommenter creates a new mock instance.
+func NewMockCommenter(ctrl *gomock.Controller) *MockCommenter {
+	mock := &MockCommenter{ctrl: ctrl}
+	mock.recorder = &MockCommenterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCommenter) EXPECT() *MockCommenterMockRecorder {
+	return m.recorder
+}
+
+// DeleteComment mocks base method.
+func (m *MockCommenter) DeleteComment(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteComment", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteComment indicates an expected call of DeleteComment.
+func (mr *MockCommenterMockRecorder) DeleteComment(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteComment", reflect.TypeOf((*MockCommenter)(nil).DeleteComment), ctx, id)
+}
+
+// GetAllCommentsInEvent mocks base method.
+func (m *MockCommenter) GetAllCommentsInEvent(ctx context.Context, eventID uint, eventType models.EventType) ([]models.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllCommentsInEvent", ctx, eventID, eventType)
+	ret0, _ := ret[0].([]models.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllCommentsInEvent indicates an expected call of GetAllCommentsInEvent.
+func (mr *MockCommenterMockRecorder) GetAllCommentsInEvent(ctx, eventID, eventType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllCommentsInEvent", reflect.TypeOf((*MockCommenter)(nil).GetAllCommentsInEvent), ctx, eventID, eventType)
+}
+
+// GetCommentByID mocks base method.
+func (m *MockCommenter) GetCommentByID(ctx context.Context, id uint) (models.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommentByID", ctx, id)
+	ret0, _ := ret[0].(models.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommentByID indicates an expected call of GetCommentByID.
+func (mr *MockCommenterMockRecorder) GetCommentByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentByID", reflect.TypeOf((*MockCommenter)(nil).GetCommentByID), ctx, id)
+}
+
+// UpdateComment mocks base method.
+func (m *MockCommenter) UpdateComment(ctx context.Context, comment models.Comment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateComment", ctx, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateComment indicates an expected call of UpdateComment.
+func (mr *MockCommenterMockRecorder) UpdateComment(ctx, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateComment", reflect.TypeOf((*MockCommenter)(nil).UpdateComment), ctx, comment)
+}
+
+// WriteComment mocks base method.
+func (m *MockCommenter) WriteComment(ctx context.Context, comment models.Comment) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteComment", ctx, comment)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WriteComment indicates an expected call of WriteComment.
+func (mr *MockCommenterMockRecorder) WriteComment(ctx, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteComment", reflect.TypeOf((*MockCommenter)(nil).WriteComment), ctx, comment)
+}
+
+// MockTagger is a mock of Tagger interface.
+type MockTagger struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaggerMockRecorder
+}
+
+// MockTaggerMockRecorder is the mock recorder for MockTagger.
+type MockTaggerMockRecorder struct {
+	mock *MockTagger
+}
+
+// NewMockTagger creates a new mock instance.
+func NewMockTagger(ctrl *gomock.Controller) *MockTagger {
+	mock := &MockTagger{ctrl: ctrl}
+	mock.recorder = &MockTaggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTagger) EXPECT() *MockTaggerMockRecorder {
+	return m.recorder
+}
+
+// GetTagsByEvent mocks base method.
+func (m *MockTagger) GetTagsByEvent(ctx context.Context, eventID uint, eventType models.EventType) ([]models.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTagsByEvent", ctx, eventID, eventType)
+	ret0, _ := ret[0].([]models.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTagsByEvent indicates an expected call of GetTagsByEvent.
+func (mr *MockTaggerMockRecorder) GetTagsByEvent(ctx, eventID, eventType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTagsByEvent", reflect.TypeOf((*MockTagger)(nil).GetTagsByEvent), ctx, eventID, eventType)
+}
+
+// UpsertTags mocks base method.
+func (m *MockTagger) UpsertTags(ctx context.Context, eventID uint, eventType models.EventType, memberID uint, tags []models.Tag) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertTags", ctx, eventID, eventType, memberID, tags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertTags indicates an expected call of UpsertTags.
+func (mr *MockTaggerMockRecorder) UpsertTags(ctx, eventID, eventType, memberID, tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertTags", reflect.TypeOf((*MockTagger)(nil).UpsertTags), ctx, eventID, eventType, memberID, tags)
+}
+
+// MockUserSearcher is a mock of UserSearcher interface.
+type MockUserSearcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserSearcherMockRecorder
+}
+
+// MockUserSearcherMockRecorder is the mock recorder for MockUserSearcher.
+type MockUserSearcherMockRecorder struct {
+	mock *MockUserSearcher
+}
+
+// NewMockUserSearcher creates a new mock instance.
+func NewMockUserSearcher(ctrl *gomock.Controller) *MockUserSearcher {
+	mock := &MockUserSearcher{ctrl: ctrl}
+	mock.recorder = &MockUserSearcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserSearcher) EXPECT() *MockUserSearcherMockRecorder {
+	return m.recorder
+}
+
+// UpsertValues mocks base method.
+func (m *MockUserSearcher) UpsertValues(ctx context.Context, userId uint, tags []models.MemberSearch) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertValues", ctx, userId, tags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertValues indicates an expected call of UpsertValues.
+func (mr *MockUserSearcherMockRecorder) UpsertValues(ctx, userId, tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertValues", reflect.TypeOf((*MockUserSearcher)(nil).UpsertValues), ctx, userId, tags)
+}
+
+// MockTransactionNotifier is a mock of TransactionNotifier interface.
+type MockTransactionNotifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactionNotifierMockRecorder
+}
+
+// MockTransactionNotifierMockRecorder is the mock recorder for MockTransactionNotifier.
+type MockTransactionNotifierMockRecorder struct {
+	mock *MockTransactionNotifier
+}
+
+// NewMockTransactionNotifier creates a new mock instance.
+func NewMockTransactionNotifier(ctrl *gomock.Controller) *MockTransactionNotifier {
+	mock := &MockTransactionNotifier{ctrl: ctrl}
+	mock.recorder = &MockTransactionNotifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransactionNotifier) EXPECT() *MockTransactionNotifierMockRecorder {
+	return m.recorder
+}
+
+// GetUserNotifications mocks base method.
+func (m *MockTransactionNotifier) GetUserNotifications(ctx context.Context, userID uint) ([]models.TransactionNotification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserNotifications", ctx, userID)
+	ret0, _ := ret[0].([]models.TransactionNotification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserNotifications indicates an expected call of GetUserNotifications.
+func (mr *MockTransactionNotifierMockRecorder) GetUserNotifications(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserNotifications", reflect.TypeOf((*MockTransactionNotifier)(nil).GetUserNotifications), ctx, userID)
+}
+
+// Read mocks base method.
+func (m *MockTransactionNotifier) Read(ctx context.Context, id []uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockTransactionNotifierMockRecorder) Read(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockTransactionNotifier)(nil).Read), ctx, id)
+}
+
+// MockFiler is a mock of Filer interface.
+type MockFiler struct {
+	ctrl     *gomock.Controller
+	recorder *MockFilerMockRecorder
+}
+
+// MockFilerMockRecorder is the mock recorder for MockFiler.
+type MockFilerMockRecorder struct {
+	mock *MockFiler
+}
+
+// NewMockFiler creates a new mock instance.
+func NewMockFiler(ctrl *gomock.Controller) *MockFiler {
+	mock := &MockFiler{ctrl: ctrl}
+	mock.recorder = &MockFilerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFiler) EXPECT() *MockFilerMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockFiler) Delete(ctx context.Context, identifier string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, identifier)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockFilerMockRecorder) Delete(ctx, identifier interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockFiler)(nil).Delete), ctx, identifier)
+}
+
+// Get mocks base method.
+func (m *MockFiler) Get(ctx context.Context, identifier string) (io.Reader, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, identifier)
+	ret0, _ := ret[0].(io.Reader)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockFilerMockRecorder) Get(ctx, identifier interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockFiler)(nil).Get), ctx, identifier)
+}
+
+// Upload mocks base method.
+func (m *MockFiler) Upload(ctx context.Context, fileName string, fileData io.Reader) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upload", ctx, fileName, fileData)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upload indicates an expected call of Upload.
+func (mr *MockFilerMockRecorder) Upload(ctx, fileName, fileData interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockFiler)(nil).Upload), ctx, fileName, fileData)
+}
+
+// MockComplainer is a mock of Complainer interface.
+type MockComplainer struct {
+	ctrl     *gomock.Controller
+	recorder *MockComplainerMockRecorder
+}
+
+// MockComplainerMockRecorder is the mock recorder for MockComplainer.
+type MockComplainerMockRecorder struct {
+	mock *MockComplainer
+}
+
+// NewMockComplainer creates a new mock instance.
+func NewMockComplainer(ctrl *gomock.Controller) *MockComplainer {
+	mock := &MockComplainer{ctrl: ctrl}
+	mock.recorder = &MockComplainerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockComplainer) EXPECT() *MockComplainerMockRecorder {
+	return m.recorder
+}
+
+// BanEvent mocks base method.
+func (m *MockComplainer) BanEvent(ctx context.Context, eventID models.ID, eventType models.EventType) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BanEvent", ctx, eventID, eventType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BanEvent indicates an expected call of BanEvent.
+func (mr *MockComplainerMockRecorder) BanEvent(ctx, eventID, eventType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BanEvent", reflect.TypeOf((*MockComplainer)(nil).BanEvent), ctx, eventID, eventType)
+}
+
+// BanUser mocks base method.
+func (m *MockComplainer) BanUser(ctx context.Context, userID models.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BanUser", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BanUser indicates an expected call of BanUser.
+func (mr *MockComplainerMockRecorder) BanUser(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BanUser", reflect.TypeOf((*MockComplainer)(nil).BanUser), ctx, userID)
+}
+
+// Complain mocks base method.
+func (m *MockComplainer) Complain(ctx context.Context, complaint models.Complaint) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complain", ctx, complaint)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Complain indicates an expected call of Complain.
+func (mr *MockComplainerMockRecorder) Complain(ctx, complaint interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complain", reflect.TypeOf((*MockComplainer)(nil).Complain), ctx, complaint)
+}
+
+// GetAll mocks base method.
+func (m *MockComplainer) GetAll(ctx context.Context) ([]models.ComplaintsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].([]models.ComplaintsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockComplainerMockRecorder) GetAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockComplainer)(nil).GetAll), ctx)
+}
+
+// MockModerator is a mock of Moderator interface.
+type MockModerator struct {
+	ctrl     *gomock.Controller
+	recorder *MockModeratorMockRecorder
+}
+
+// MockModeratorMockRecorder is the mock recorder for MockModerator.
+type MockModeratorMockRecorder struct {
+	mock *MockModerator
+}
+
+// NewMockModerator creates a new mock instance.
+func NewMockModerator(ctrl *gomock.Controller) *MockModerator {
+	mock := &MockModerator{ctrl: ctrl}
+	mock.recorder = &MockModeratorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModerator) EXPECT() *MockModeratorMockRecorder {
+	return m.recorder
+}
+
+// CreateBlockedWord mocks base method.
+func (m *MockModerator) CreateBlockedWord(ctx context.Context, word models.BlockedWord) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBlockedWord", ctx, word)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBlockedWord indicates an expected call of CreateBlockedWord.
+func (mr *MockModeratorMockRecorder) CreateBlockedWord(ctx, word interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBlockedWord", reflect.TypeOf((*MockModerator)(nil).CreateBlockedWord), ctx, word)
+}
+
+// DeleteBlockedWord mocks base method.
+func (m *MockModerator) DeleteBlockedWord(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBlockedWord", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBlockedWord indicates an expected call of DeleteBlockedWord.
+func (mr *MockModeratorMockRecorder) DeleteBlockedWord(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBlockedWord", reflect.TypeOf((*MockModerator)(nil).DeleteBlockedWord), ctx, id)
+}
+
+// GetBlockedWords mocks base method.
+func (m *MockModerator) GetBlockedWords(ctx context.Context) ([]models.BlockedWord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockedWords", ctx)
+	ret0, _ := ret[0].([]models.BlockedWord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockedWords indicates an expected call of GetBlockedWords.
+func (mr *MockModeratorMockRecorder) GetBlockedWords(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockedWords", reflect.TypeOf((*MockModerator)(nil).GetBlockedWords), ctx)
+}
+
+// GetModerationQueue mocks base method.
+func (m *MockModerator) GetModerationQueue(ctx context.Context) ([]models.ModerationItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModerationQueue", ctx)
+	ret0, _ := ret[0].([]models.ModerationItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModerationQueue indicates an expected call of GetModerationQueue.
+func (mr *MockModeratorMockRecorder) GetModerationQueue(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModerationQueue", reflect.TypeOf((*MockModerator)(nil).GetModerationQueue), ctx)
+}
+
+// ResolveModerationItem mocks base method.
+func (m *MockModerator) ResolveModerationItem(ctx context.Context, id, adminID uint, approve bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveModerationItem", ctx, id, adminID, approve)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResolveModerationItem indicates an expected call of ResolveModerationItem.
+func (mr *MockModeratorMockRecorder) ResolveModerationItem(ctx, id, adminID, approve interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveModerationItem", reflect.TypeOf((*MockModerator)(nil).ResolveModerationItem), ctx, id, adminID, approve)
+}
+
+// MockEventTemplater is a mock of EventTemplater interface.
+type MockEventTemplater struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventTemplaterMockRecorder
+}
+
+// MockEventTemplaterMockRecorder is the mock recorder for MockEventTemplater.
+type MockEventTemplaterMockRecorder struct {
+	mock *MockEventTemplater
+}
+
+// NewMockEventTemplater creates a new mock instance.
+func NewMockEventTemplater(ctrl *gomock.Controller) *MockEventTemplater {
+	mock := &MockEventTemplater{ctrl: ctrl}
+	mock.recorder = &MockEventTemplaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventTemplater) EXPECT() *MockEventTemplaterMockRecorder {
+	return m.recorder
+}
+
+// CreateEventTemplate mocks base method.
+func (m *MockEventTemplater) CreateEventTemplate(ctx context.Context, template models.EventTemplate) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEventTemplate", ctx, template)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEventTemplate indicates an expected call of CreateEventTemplate.
+func (mr *MockEventTemplaterMockRecorder) CreateEventTemplate(ctx, template interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEventTemplate", reflect.TypeOf((*MockEventTemplater)(nil).CreateEventTemplate), ctx, template)
+}
+
+// DeleteEventTemplate mocks base method.
+func (m *MockEventTemplater) DeleteEventTemplate(ctx context.Context, id, memberID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEventTemplate", ctx, id, memberID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEventTemplate indicates an expected call of DeleteEventTemplate.
+func (mr *MockEventTemplaterMockRecorder) DeleteEventTemplate(ctx, id, memberID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventTemplate", reflect.TypeOf((*MockEventTemplater)(nil).DeleteEventTemplate), ctx, id, memberID)
+}
+
+// GetMemberEventTemplates mocks base method.
+func (m *MockEventTemplater) GetMemberEventTemplates(ctx context.Context, memberID uint) ([]models.EventTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMemberEventTemplates", ctx, memberID)
+	ret0, _ := ret[0].([]models.EventTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMemberEventTemplates indicates an expected call of GetMemberEventTemplates.
+func (mr *MockEventTemplaterMockRecorder) GetMemberEventTemplates(ctx, memberID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMemberEventTemplates", reflect.TypeOf((*MockEventTemplater)(nil).GetMemberEventTemplates), ctx, memberID)
+}
+
+// InstantiateEventTemplate mocks base method.
+func (m *MockEventTemplater) InstantiateEventTemplate(ctx context.Context, id, memberID uint, overrides []byte) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstantiateEventTemplate", ctx, id, memberID, overrides)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InstantiateEventTemplate indicates an expected call of InstantiateEventTemplate.
+func (mr *MockEventTemplaterMockRecorder) InstantiateEventTemplate(ctx, id, memberID, overrides interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstantiateEventTemplate", reflect.TypeOf((*MockEventTemplater)(nil).InstantiateEventTemplate), ctx, id, memberID, overrides)
+}
+
+// MockReporter is a mock of Reporter interface.
+type MockReporter struct {
+	ctrl     *gomock.Controller
+	recorder *MockReporterMockRecorder
+}
+
+// MockReporterMockRecorder is the mock recorder for MockReporter.
+type MockReporterMockRecorder struct {
+	mock *MockReporter
+}
+
+// NewMockReporter creates a new mock instance.
+func NewMockReporter(ctrl *gomock.Controller) *MockReporter {
+	mock := &MockReporter{ctrl: ctrl}
+	mock.recorder = &MockReporterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReporter) EXPECT() *MockReporterMockRecorder {
+	return m.recorder
+}
+
+// AddTransactionReport mocks base method.
+func (m *MockReporter) AddTransactionReport(ctx context.Context, transactionID, memberID uint, file io.Reader, fileType, filePath string) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTransactionReport", ctx, transactionID, memberID, file, fileType, filePath)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTransactionReport indicates an expected call of AddTransactionReport.
+func (mr *MockReporterMockRecorder) AddTransactionReport(ctx, transactionID, memberID, file, fileType, filePath interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTransactionReport", reflect.TypeOf((*MockReporter)(nil).AddTransactionReport), ctx, transactionID, memberID, file, fileType, filePath)
+}
+
+// GetProposalEventReports mocks base method.
+func (m *MockReporter) GetProposalEventReports(ctx context.Context, eventID, memberID uint) ([]models.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProposalEventReports", ctx, eventID, memberID)
+	ret0, _ := ret[0].([]models.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProposalEventReports indicates an expected call of GetProposalEventReports.
+func (mr *MockReporterMockRecorder) GetProposalEventReports(ctx, eventID, memberID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAllNotFinishedTransactions", reflect.TypeOf((*MockTransactioner)(nil).UpdateAllNotFinishedTransactions), ctx, eventID, eventType, newStatus)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProposalEventReports", reflect.TypeOf((*MockReporter)(nil).GetProposalEventReports), ctx, eventID, memberID)
 }
 
-// UpdateTransaction mocks base method.
-func (m *MockTransactioner) UpdateTransaction(ctx context.Context, transaction models.Transaction) error {
+// GetReportFile mocks base method.
+func (m *MockReporter) GetReportFile(ctx context.Context, id, memberID uint) (models.Report, io.Reader, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateTransaction", ctx, transaction)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetReportFile", ctx, id, memberID)
+	ret0, _ := ret[0].(models.Report)
+	ret1, _ := ret[1].(io.Reader)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// UpdateTransaction indicates an expected call of UpdateTransaction.
-func (mr *MockTransactionerMockRecorder) UpdateTransaction(ctx, transaction interface{}) *gomock.Call {
+// GetReportFile indicates an expected call of GetReportFile.
+func (mr *MockReporterMockRecorder) GetReportFile(ctx, id, memberID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransaction", reflect.TypeOf((*MockTransactioner)(nil).UpdateTransaction), ctx, transaction)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReportFile", reflect.TypeOf((*MockReporter)(nil).GetReportFile), ctx, id, memberID)
 }
 
-// MockCommenter is a mock of Commenter interface.
-type MockCommenter struct {
+// MockQuotaer is a mock of Quotaer interface.
+type MockQuotaer struct {
 	ctrl     *gomock.Controller
-	recorder *MockCommenterMockRecorder
+	recorder *MockQuotaerMockRecorder
 }
 
-// MockCommenterMockRecorder is the mock recorder for MockCommenter.
-type MockCommenterMockRecorder struct {
-	mock *MockCommenter
+// MockQuotaerMockRecorder is the mock recorder for MockQuotaer.
+type MockQuotaerMockRecorder struct {
+	mock *MockQuotaer
 }
 
-// NewMockCommenter creates a new mock instance.
-func NewMockCommenter(ctrl *gomock.Controller) *MockCommenter {
-	mock := &MockCommenter{ctrl: ctrl}
-	mock.recorder = &MockCommenterMockRecorder{mock}
+// NewMockQuotaer creates a new mock instance.
+func NewMockQuotaer(ctrl *gomock.Controller) *MockQuotaer {
+	mock := &MockQuotaer{ctrl: ctrl}
+	mock.recorder = &MockQuotaerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockCommenter) EXPECT() *MockCommenterMockRecorder {
+func (m *MockQuotaer) EXPECT() *MockQuotaerMockRecorder {
 	return m.recorder
 }
 
-// DeleteComment mocks base method.
-func (m *MockCommenter) DeleteComment(ctx context.Context, id uint) error {
+// DeleteMemberQuotaPolicy mocks base method.
+func (m *MockQuotaer) DeleteMemberQuotaPolicy(ctx context.Context, memberID uint) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteComment", ctx, id)
+	ret := m.ctrl.Call(m, "DeleteMemberQuotaPolicy", ctx, memberID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteComment indicates an expected call of DeleteComment.
-func (mr *MockCommenterMockRecorder) DeleteComment(ctx, id interface{}) *gomock.Call {
+// DeleteMemberQuotaPolicy indicates an expected call of DeleteMemberQuotaPolicy.
+func (mr *MockQuotaerMockRecorder) DeleteMemberQuotaPolicy(ctx, memberID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteComment", reflect.TypeOf((*MockCommenter)(nil).DeleteComment), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMemberQuotaPolicy", reflect.TypeOf((*MockQuotaer)(nil).DeleteMemberQuotaPolicy), ctx, memberID)
 }
 
-// GetAllCommentsInEvent mocks base method.
-func (m *MockCommenter) GetAllCommentsInEvent(ctx context.Context, eventID uint, eventType models.EventType) ([]models.Comment, error) {
+// GetMemberQuota mocks base method.
+func (m *MockQuotaer) GetMemberQuota(ctx context.Context, memberID uint) (models.MemberQuota, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllCommentsInEvent", ctx, eventID, eventType)
-	ret0, _ := ret[0].([]models.Comment)
+	ret := m.ctrl.Call(m, "GetMemberQuota", ctx, memberID)
+	ret0, _ := ret[0].(models.MemberQuota)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAllCommentsInEvent indicates an expected call of GetAllCommentsInEvent.
-func (mr *MockCommenterMockRecorder) GetAllCommentsInEvent(ctx, eventID, eventType interface{}) *gomock.Call {
+// GetMemberQuota indicates an expected call of GetMemberQuota.
+func (mr *MockQuotaerMockRecorder) GetMemberQuota(ctx, memberID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllCommentsInEvent", reflect.TypeOf((*MockCommenter)(nil).GetAllCommentsInEvent), ctx, eventID, eventType)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMemberQuota", reflect.TypeOf((*MockQuotaer)(nil).GetMemberQuota), ctx, memberID)
 }
 
-// GetCommentByID mocks base method.
-func (m *MockCommenter) GetCommentByID(ctx context.Context, id uint) (models.Comment, error) {
+// GetQuotaPolicies mocks base method.
+func (m *MockQuotaer) GetQuotaPolicies(ctx context.Context) ([]models.QuotaPolicy, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCommentByID", ctx, id)
-	ret0, _ := ret[0].(models.Comment)
+	ret := m.ctrl.Call(m, "GetQuotaPolicies", ctx)
+	ret0, _ := ret[0].([]models.QuotaPolicy)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetCommentByID indicates an expected call of GetCommentByID.
-func (mr *MockCommenterMockRecorder) GetCommentByID(ctx, id interface{}) *gomock.Call {
+// GetQuotaPolicies indicates an expected call of GetQuotaPolicies.
+func (mr *MockQuotaerMockRecorder) GetQuotaPolicies(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentByID", reflect.TypeOf((*MockCommenter)(nil).GetCommentByID), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuotaPolicies", reflect.TypeOf((*MockQuotaer)(nil).GetQuotaPolicies), ctx)
 }
 
-// UpdateComment mocks base method.
-func (m *MockCommenter) UpdateComment(ctx context.Context, comment models.Comment) error {
+// SaveQuotaPolicy mocks base method.
+func (m *MockQuotaer) SaveQuotaPolicy(ctx context.Context, policy models.QuotaPolicy) (uint, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateComment", ctx, comment)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "SaveQuotaPolicy", ctx, policy)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// UpdateComment indicates an expected call of UpdateComment.
-func (mr *MockCommenterMockRecorder) UpdateComment(ctx, comment interface{}) *gomock.Call {
+// SaveQuotaPolicy indicates an expected call of SaveQuotaPolicy.
+func (mr *MockQuotaerMockRecorder) SaveQuotaPolicy(ctx, policy interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateComment", reflect.TypeOf((*MockCommenter)(nil).UpdateComment), ctx, comment)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveQuotaPolicy", reflect.TypeOf((*MockQuotaer)(nil).SaveQuotaPolicy), ctx, policy)
 }
 
-// WriteComment mocks base method.
-func (m *MockCommenter) WriteComment(ctx context.Context, comment models.Comment) (uint, error) {
+// SetVerifiedOrganization mocks base method.
+func (m *MockQuotaer) SetVerifiedOrganization(ctx context.Context, memberID uint, verified bool) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "WriteComment", ctx, comment)
-	ret0, _ := ret[0].(uint)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "SetVerifiedOrganization", ctx, memberID, verified)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// WriteComment indicates an expected call of WriteComment.
-func (mr *MockCommenterMockRecorder) WriteComment(ctx, comment interface{}) *gomock.Call {
+// SetVerifiedOrganization indicates an expected call of SetVerifiedOrganization.
+func (mr *MockQuotaerMockRecorder) SetVerifiedOrganization(ctx, memberID, verified interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteComment", reflect.TypeOf((*MockCommenter)(nil).WriteComment), ctx, comment)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetVerifiedOrganization", reflect.TypeOf((*MockQuotaer)(nil).SetVerifiedOrganization), ctx, memberID, verified)
 }
 
-// MockTagger is a mock of Tagger interface.
-type MockTagger struct {
+// MockUniter is a mock of Uniter interface.
+type MockUniter struct {
 	ctrl     *gomock.Controller
-	recorder *MockTaggerMockRecorder
+	recorder *MockUniterMockRecorder
 }
 
-// MockTaggerMockRecorder is the mock recorder for MockTagger.
-type MockTaggerMockRecorder struct {
-	mock *MockTagger
+// MockUniterMockRecorder is the mock recorder for MockUniter.
+type MockUniterMockRecorder struct {
+	mock *MockUniter
 }
 
-// NewMockTagger creates a new mock instance.
-func NewMockTagger(ctrl *gomock.Controller) *MockTagger {
-	mock := &MockTagger{ctrl: ctrl}
-	mock.recorder = &MockTaggerMockRecorder{mock}
+// NewMockUniter creates a new mock instance.
+func NewMockUniter(ctrl *gomock.Controller) *MockUniter {
+	mock := &MockUniter{ctrl: ctrl}
+	mock.recorder = &MockUniterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockTagger) EXPECT() *MockTaggerMockRecorder {
+func (m *MockUniter) EXPECT() *MockUniterMockRecorder {
 	return m.recorder
 }
 
-// GetTagsByEvent mocks base method.
-func (m *MockTagger) GetTagsByEvent(ctx context.Context, eventID uint, eventType models.EventType) ([]models.Tag, error) {
+// GetUnits mocks base method.
+func (m *MockUniter) GetUnits(ctx context.Context) ([]models.UnitDefinition, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTagsByEvent", ctx, eventID, eventType)
-	ret0, _ := ret[0].([]models.Tag)
+	ret := m.ctrl.Call(m, "GetUnits", ctx)
+	ret0, _ := ret[0].([]models.UnitDefinition)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTagsByEvent indicates an expected call of GetTagsByEvent.
-func (mr *MockTaggerMockRecorder) GetTagsByEvent(ctx, eventID, eventType interface{}) *gomock.Call {
+// GetUnits indicates an expected call of GetUnits.
+func (mr *MockUniterMockRecorder) GetUnits(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTagsByEvent", reflect.TypeOf((*MockTagger)(nil).GetTagsByEvent), ctx, eventID, eventType)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnits", reflect.TypeOf((*MockUniter)(nil).GetUnits), ctx)
 }
 
-// UpsertTags mocks base method.
-func (m *MockTagger) UpsertTags(ctx context.Context, eventID uint, eventType models.EventType, tags []models.Tag) error {
+// MockEventUpdater is a mock of EventUpdater interface.
+type MockEventUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventUpdaterMockRecorder
+}
+
+// MockEventUpdaterMockRecorder is the mock recorder for MockEventUpdater.
+type MockEventUpdaterMockRecorder struct {
+	mock *MockEventUpdater
+}
+
+// NewMockEventUpdater creates a new mock instance.
+func NewMockEventUpdater(ctrl *gomock.Controller) *MockEventUpdater {
+	mock := &MockEventUpdater{ctrl: ctrl}
+	mock.recorder = &MockEventUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventUpdater) EXPECT() *MockEventUpdaterMockRecorder {
+	return m.recorder
+}
+
+// DeleteEventUpdate mocks base method.
+func (m *MockEventUpdater) DeleteEventUpdate(ctx context.Context, id, memberID uint) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpsertTags", ctx, eventID, eventType, tags)
+	ret := m.ctrl.Call(m, "DeleteEventUpdate", ctx, id, memberID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UpsertTags indicates an expected call of UpsertTags.
-func (mr *MockTaggerMockRecorder) UpsertTags(ctx, eventID, eventType, tags interface{}) *gomock.Call {
+// DeleteEventUpdate indicates an expected call of DeleteEventUpdate.
+func (mr *MockEventUpdaterMockRecorder) DeleteEventUpdate(ctx, id, memberID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertTags", reflect.TypeOf((*MockTagger)(nil).UpsertTags), ctx, eventID, eventType, tags)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventUpdate", reflect.TypeOf((*MockEventUpdater)(nil).DeleteEventUpdate), ctx, id, memberID)
 }
 
-// MockUserSearcher is a mock of UserSearcher interface.
-type MockUserSearcher struct {
-	ctrl     *gomock.Controller
-	recorder *MockUserSearcherMockRecorder
+// FollowEvent mocks base method.
+func (m *MockEventUpdater) FollowEvent(ctx context.Context, eventID uint, eventType models.EventType, memberID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FollowEvent", ctx, eventID, eventType, memberID)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// MockUserSearcherMockRecorder is the mock recorder for MockUserSearcher.
-type MockUserSearcherMockRecorder struct {
-	mock *MockUserSearcher
+// FollowEvent indicates an expected call of FollowEvent.
+func (mr *MockEventUpdaterMockRecorder) FollowEvent(ctx, eventID, eventType, memberID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FollowEvent", reflect.TypeOf((*MockEventUpdater)(nil).FollowEvent), ctx, eventID, eventType, memberID)
 }
 
-// NewMockUserSearcher creates a new mock instance.
-func NewMockUserSearcher(ctrl *gomock.Controller) *MockUserSearcher {
-	mock := &MockUserSearcher{ctrl: ctrl}
-	mock.recorder = &MockUserSearcherMockRecorder{mock}
-	return mock
+// PostEventUpdate mocks base method.
+func (m *MockEventUpdater) PostEventUpdate(ctx context.Context, eventID uint, eventType models.EventType, authorID uint, request models.EventUpdateCreateRequest) (uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostEventUpdate", ctx, eventID, eventType, authorID, request)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockUserSearcher) EXPECT() *MockUserSearcherMockRecorder {
-	return m.recorder
+// PostEventUpdate indicates an expected call of PostEventUpdate.
+func (mr *MockEventUpdaterMockRecorder) PostEventUpdate(ctx, eventID, eventType, authorID, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostEventUpdate", reflect.TypeOf((*MockEventUpdater)(nil).PostEventUpdate), ctx, eventID, eventType, authorID, request)
 }
 
-// UpsertValues mocks base method.
-func (m *MockUserSearcher) UpsertValues(ctx context.Context, userId uint, tags []models.MemberSearch) error {
+// UnfollowEvent mocks base method.
+func (m *MockEventUpdater) UnfollowEvent(ctx context.Context, eventID uint, eventType models.EventType, memberID uint) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpsertValues", ctx, userId, tags)
+	ret := m.ctrl.Call(m, "UnfollowEvent", ctx, eventID, eventType, memberID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UpsertValues indicates an expected call of UpsertValues.
-func (mr *MockUserSearcherMockRecorder) UpsertValues(ctx, userId, tags interface{}) *gomock.Call {
+// UnfollowEvent indicates an expected call of UnfollowEvent.
+func (mr *MockEventUpdaterMockRecorder) UnfollowEvent(ctx, eventID, eventType, memberID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertValues", reflect.TypeOf((*MockUserSearcher)(nil).UpsertValues), ctx, userId, tags)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnfollowEvent", reflect.TypeOf((*MockEventUpdater)(nil).UnfollowEvent), ctx, eventID, eventType, memberID)
 }
 
-// MockTransactionNotifier is a mock of TransactionNotifier interface.
-type MockTransactionNotifier struct {
+// MockIntakeLedgerer is a mock of IntakeLedgerer interface.
+type MockIntakeLedgerer struct {
 	ctrl     *gomock.Controller
-	recorder *MockTransactionNotifierMockRecorder
+	recorder *MockIntakeLedgererMockRecorder
 }
 
-// MockTransactionNotifierMockRecorder is the mock recorder for MockTransactionNotifier.
-type MockTransactionNotifierMockRecorder struct {
-	mock *MockTransactionNotifier
+// MockIntakeLedgererMockRecorder is the mock recorder for MockIntakeLedgerer.
+type MockIntakeLedgererMockRecorder struct {
+	mock *MockIntakeLedgerer
 }
 
-// NewMockTransactionNotifier creates a new mock instance.
-func NewMockTransactionNotifier(ctrl *gomock.Controller) *MockTransactionNotifier {
-	mock := &MockTransactionNotifier{ctrl: ctrl}
-	mock.recorder = &MockTransactionNotifierMockRecorder{mock}
+// NewMockIntakeLedgerer creates a new mock instance.
+func NewMockIntakeLedgerer(ctrl *gomock.Controller) *MockIntakeLedgerer {
+	mock := &MockIntakeLedgerer{ctrl: ctrl}
+	mock.recorder = &MockIntakeLedgererMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockTransactionNotifier) EXPECT() *MockTransactionNotifierMockRecorder {
+func (m *MockIntakeLedgerer) EXPECT() *MockIntakeLedgererMockRecorder {
 	return m.recorder
 }
 
-// GetUserNotifications mocks base method.
-func (m *MockTransactionNotifier) GetUserNotifications(ctx context.Context, userID uint) ([]models.TransactionNotification, error) {
+// CorrectIntake mocks base method.
+func (m *MockIntakeLedgerer) CorrectIntake(ctx context.Context, eventID, memberID uint, correction models.IntakeCorrectionRequest) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserNotifications", ctx, userID)
-	ret0, _ := ret[0].([]models.TransactionNotification)
+	ret := m.ctrl.Call(m, "CorrectIntake", ctx, eventID, memberID, correction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CorrectIntake indicates an expected call of CorrectIntake.
+func (mr *MockIntakeLedgererMockRecorder) CorrectIntake(ctx, eventID, memberID, correction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CorrectIntake", reflect.TypeOf((*MockIntakeLedgerer)(nil).CorrectIntake), ctx, eventID, memberID, correction)
+}
+
+// GetIntakeLedger mocks base method.
+func (m *MockIntakeLedgerer) GetIntakeLedger(ctx context.Context, eventID, memberID uint) ([]models.IntakeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIntakeLedger", ctx, eventID, memberID)
+	ret0, _ := ret[0].([]models.IntakeEntry)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUserNotifications indicates an expected call of GetUserNotifications.
-func (mr *MockTransactionNotifierMockRecorder) GetUserNotifications(ctx, userID interface{}) *gomock.Call {
+// GetIntakeLedger indicates an expected call of GetIntakeLedger.
+func (mr *MockIntakeLedgererMockRecorder) GetIntakeLedger(ctx, eventID, memberID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserNotifications", reflect.TypeOf((*MockTransactionNotifier)(nil).GetUserNotifications), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIntakeLedger", reflect.TypeOf((*MockIntakeLedgerer)(nil).GetIntakeLedger), ctx, eventID, memberID)
 }
 
-// Read mocks base method.
-func (m *MockTransactionNotifier) Read(ctx context.Context, id []uint) error {
+// ReverseIntake mocks base method.
+func (m *MockIntakeLedgerer) ReverseIntake(ctx context.Context, eventID, entryID, memberID uint, comment string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Read", ctx, id)
+	ret := m.ctrl.Call(m, "ReverseIntake", ctx, eventID, entryID, memberID, comment)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Read indicates an expected call of Read.
-func (mr *MockTransactionNotifierMockRecorder) Read(ctx, id interface{}) *gomock.Call {
+// ReverseIntake indicates an expected call of ReverseIntake.
+func (mr *MockIntakeLedgererMockRecorder) ReverseIntake(ctx, eventID, entryID, memberID, comment interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockTransactionNotifier)(nil).Read), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReverseIntake", reflect.TypeOf((*MockIntakeLedgerer)(nil).ReverseIntake), ctx, eventID, entryID, memberID, comment)
 }
 
-// MockFiler is a mock of Filer interface.
-type MockFiler struct {
+// MockDropPointer is a mock of DropPointer interface.
+type MockDropPointer struct {
 	ctrl     *gomock.Controller
-	recorder *MockFilerMockRecorder
+	recorder *MockDropPointerMockRecorder
 }
 
-// MockFilerMockRecorder is the mock recorder for MockFiler.
-type MockFilerMockRecorder struct {
-	mock *MockFiler
+// MockDropPointerMockRecorder is the mock recorder for MockDropPointer.
+type MockDropPointerMockRecorder struct {
+	mock *MockDropPointer
 }
 
-// NewMockFiler creates a new mock instance.
-func NewMockFiler(ctrl *gomock.Controller) *MockFiler {
-	mock := &MockFiler{ctrl: ctrl}
-	mock.recorder = &MockFilerMockRecorder{mock}
+// NewMockDropPointer creates a new mock instance.
+func NewMockDropPointer(ctrl *gomock.Controller) *MockDropPointer {
+	mock := &MockDropPointer{ctrl: ctrl}
+	mock.recorder = &MockDropPointerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockFiler) EXPECT() *MockFilerMockRecorder {
+func (m *MockDropPointer) EXPECT() *MockDropPointerMockRecorder {
 	return m.recorder
 }
 
-// Delete mocks base method.
-func (m *MockFiler) Delete(ctx context.Context, identifier string) error {
+// AddDropPoint mocks base method.
+func (m *MockDropPointer) AddDropPoint(ctx context.Context, eventID, memberID uint, point models.DropPoint) (uint, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, identifier)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "AddDropPoint", ctx, eventID, memberID, point)
+	ret0, _ := ret[0].(uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Delete indicates an expected call of Delete.
-func (mr *MockFilerMockRecorder) Delete(ctx, identifier interface{}) *gomock.Call {
+// AddDropPoint indicates an expected call of AddDropPoint.
+func (mr *MockDropPointerMockRecorder) AddDropPoint(ctx, eventID, memberID, point interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockFiler)(nil).Delete), ctx, identifier)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDropPoint", reflect.TypeOf((*MockDropPointer)(nil).AddDropPoint), ctx, eventID, memberID, point)
 }
 
-// Get mocks base method.
-func (m *MockFiler) Get(ctx context.Context, identifier string) (io.Reader, error) {
+// DeleteDropPoint mocks base method.
+func (m *MockDropPointer) DeleteDropPoint(ctx context.Context, eventID, pointID, memberID uint) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", ctx, identifier)
-	ret0, _ := ret[0].(io.Reader)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteDropPoint", ctx, eventID, pointID, memberID)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Get indicates an expected call of Get.
-func (mr *MockFilerMockRecorder) Get(ctx, identifier interface{}) *gomock.Call {
+// DeleteDropPoint indicates an expected call of DeleteDropPoint.
+func (mr *MockDropPointerMockRecorder) DeleteDropPoint(ctx, eventID, pointID, memberID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockFiler)(nil).Get), ctx, identifier)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDropPoint", reflect.TypeOf((*MockDropPointer)(nil).DeleteDropPoint), ctx, eventID, pointID, memberID)
 }
 
-// Upload mocks base method.
-func (m *MockFiler) Upload(ctx context.Context, fileName string, fileData io.Reader) (string, error) {
+// GetDropPointArrivals mocks base method.
+func (m *MockDropPointer) GetDropPointArrivals(ctx context.Context, eventID, pointID, memberID uint, day time.Time) ([]models.DropPointArrival, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Upload", ctx, fileName, fileData)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "GetDropPointArrivals", ctx, eventID, pointID, memberID, day)
+	ret0, _ := ret[0].([]models.DropPointArrival)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Upload indicates an expected call of Upload.
-func (mr *MockFilerMockRecorder) Upload(ctx, fileName, fileData interface{}) *gomock.Call {
+// GetDropPointArrivals indicates an expected call of GetDropPointArrivals.
+func (mr *MockDropPointerMockRecorder) GetDropPointArrivals(ctx, eventID, pointID, memberID, day interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockFiler)(nil).Upload), ctx, fileName, fileData)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDropPointArrivals", reflect.TypeOf((*MockDropPointer)(nil).GetDropPointArrivals), ctx, eventID, pointID, memberID, day)
 }
 
-// MockComplainer is a mock of Complainer interface.
-type MockComplainer struct {
+// MockCoOrganizerer is a mock of CoOrganizerer interface.
+type MockCoOrganizerer struct {
 	ctrl     *gomock.Controller
-	recorder *MockComplainerMockRecorder
+	recorder *MockCoOrganizererMockRecorder
 }
 
-// MockComplainerMockRecorder is the mock recorder for MockComplainer.
-type MockComplainerMockRecorder struct {
-	mock *MockComplainer
+// MockCoOrganizererMockRecorder is the mock recorder for MockCoOrganizerer.
+type MockCoOrganizererMockRecorder struct {
+	mock *MockCoOrganizerer
 }
 
-// NewMockComplainer creates a new mock instance.
-func NewMockComplainer(ctrl *gomock.Controller) *MockComplainer {
-	mock := &MockComplainer{ctrl: ctrl}
-	mock.recorder = &MockComplainerMockRecorder{mock}
+// NewMockCoOrganizerer creates a new mock instance.
+func NewMockCoOrganizerer(ctrl *gomock.Controller) *MockCoOrganizerer {
+	mock := &MockCoOrganizerer{ctrl: ctrl}
+	mock.recorder = &MockCoOrganizererMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockComplainer) EXPECT() *MockComplainerMockRecorder {
+func (m *MockCoOrganizerer) EXPECT() *MockCoOrganizererMockRecorder {
 	return m.recorder
 }
 
-// BanEvent mocks base method.
-func (m *MockComplainer) BanEvent(ctx context.Context, eventID models.ID, eventType models.EventType) error {
+// AddCoOrganizer mocks base method.
+func (m *MockCoOrganizerer) AddCoOrganizer(ctx context.Context, eventID uint, eventType models.EventType, authorID uint, request models.CoOrganizerRequest) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "BanEvent", ctx, eventID, eventType)
+	ret := m.ctrl.Call(m, "AddCoOrganizer", ctx, eventID, eventType, authorID, request)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// BanEvent indicates an expected call of BanEvent.
-func (mr *MockComplainerMockRecorder) BanEvent(ctx, eventID, eventType interface{}) *gomock.Call {
+// AddCoOrganizer indicates an expected call of AddCoOrganizer.
+func (mr *MockCoOrganizererMockRecorder) AddCoOrganizer(ctx, eventID, eventType, authorID, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BanEvent", reflect.TypeOf((*MockComplainer)(nil).BanEvent), ctx, eventID, eventType)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCoOrganizer", reflect.TypeOf((*MockCoOrganizerer)(nil).AddCoOrganizer), ctx, eventID, eventType, authorID, request)
 }
 
-// BanUser mocks base method.
-func (m *MockComplainer) BanUser(ctx context.Context, userID models.ID) error {
+// RemoveCoOrganizer mocks base method.
+func (m *MockCoOrganizerer) RemoveCoOrganizer(ctx context.Context, eventID uint, eventType models.EventType, memberID, requesterID uint) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "BanUser", ctx, userID)
+	ret := m.ctrl.Call(m, "RemoveCoOrganizer", ctx, eventID, eventType, memberID, requesterID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// BanUser indicates an expected call of BanUser.
-func (mr *MockComplainerMockRecorder) BanUser(ctx, userID interface{}) *gomock.Call {
+// RemoveCoOrganizer indicates an expected call of RemoveCoOrganizer.
+func (mr *MockCoOrganizererMockRecorder) RemoveCoOrganizer(ctx, eventID, eventType, memberID, requesterID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BanUser", reflect.TypeOf((*MockComplainer)(nil).BanUser), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCoOrganizer", reflect.TypeOf((*MockCoOrganizerer)(nil).RemoveCoOrganizer), ctx, eventID, eventType, memberID, requesterID)
 }
 
-// Complain mocks base method.
-func (m *MockComplainer) Complain(ctx context.Context, complaint models.Complaint) (int, error) {
+// MockScheduler is a mock of Scheduler interface.
+type MockScheduler struct {
+	ctrl     *gomock.Controller
+	recorder *MockSchedulerMockRecorder
+}
+
+// MockSchedulerMockRecorder is the mock recorder for MockScheduler.
+type MockSchedulerMockRecorder struct {
+	mock *MockScheduler
+}
+
+// NewMockScheduler creates a new mock instance.
+func NewMockScheduler(ctrl *gomock.Controller) *MockScheduler {
+	mock := &MockScheduler{ctrl: ctrl}
+	mock.recorder = &MockSchedulerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScheduler) EXPECT() *MockSchedulerMockRecorder {
+	return m.recorder
+}
+
+// GetJobStatuses mocks base method.
+func (m *MockScheduler) GetJobStatuses(ctx context.Context) []models.JobStatus {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Complain", ctx, complaint)
-	ret0, _ := ret[0].(int)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "GetJobStatuses", ctx)
+	ret0, _ := ret[0].([]models.JobStatus)
+	return ret0
 }
 
-// Complain indicates an expected call of Complain.
-func (mr *MockComplainerMockRecorder) Complain(ctx, complaint interface{}) *gomock.Call {
+// GetJobStatuses indicates an expected call of GetJobStatuses.
+func (mr *MockSchedulerMockRecorder) GetJobStatuses(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complain", reflect.TypeOf((*MockComplainer)(nil).Complain), ctx, complaint)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobStatuses", reflect.TypeOf((*MockScheduler)(nil).GetJobStatuses), ctx)
 }
 
-// GetAll mocks base method.
-func (m *MockComplainer) GetAll(ctx context.Context) ([]models.ComplaintsResponse, error) {
+// Stop mocks base method.
+func (m *MockScheduler) Stop(ctx context.Context) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll", ctx)
-	ret0, _ := ret[0].([]models.ComplaintsResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Stop", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetAll indicates an expected call of GetAll.
-func (mr *MockComplainerMockRecorder) GetAll(ctx interface{}) *gomock.Call {
+// Stop indicates an expected call of Stop.
+func (mr *MockSchedulerMockRecorder) Stop(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockComplainer)(nil).GetAll), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockScheduler)(nil).Stop), ctx)
 }
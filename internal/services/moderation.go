@@ -0,0 +1,256 @@
+package service
+
+import (
+	"Kurajj/internal/models"
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+)
+
+const (
+	spamLookbackPeriod = 24 * time.Hour
+	spamMinTextLength  = 15
+	spamFlagRepeats    = 1
+	spamRejectRepeats  = 3
+)
+
+var (
+	linkRegexp = regexp.MustCompile(
+		`(?i)(https?://|www\.)\S+|\b[\p{L}0-9-]+\.(com|net|org|ua|info|me|io|ru|site|online)\b|\bt\.me/\S+|(^|\s)@[a-z0-9_]{5,}`)
+	phoneRegexp = regexp.MustCompile(`\+?\d[\d\s\-().]{7,}\d`)
+)
+
+func NewModeration(repo Repositorier) *Moderation {
+	return &Moderation{repo: repo}
+}
+
+type Moderation struct {
+	repo Repositorier
+}
+
+// Check runs the texts through the blocked-word lists, off-platform contact detection
+// and the repeated-content heuristic. Rejected content is returned as an error
+// wrapping models.ErrContentRejected, so the caller only has to store the entity
+// and pass the result to Flag.
+func (m *Moderation) Check(ctx context.Context, authorID uint, texts ...string) (models.ModerationResult, error) {
+	result := models.ModerationResult{Decision: models.ModerationAllow}
+
+	blockedWords, err := m.repo.GetBlockedWords(ctx)
+	if err != nil {
+		return models.ModerationResult{}, err
+	}
+
+	recentTexts, err := m.repo.GetUserRecentTexts(ctx, authorID, time.Now().Add(-spamLookbackPeriod))
+	if err != nil {
+		return models.ModerationResult{}, err
+	}
+
+	for _, text := range texts {
+		if strings.TrimSpace(text) == "" {
+			continue
+		}
+
+		if word, found := findBlockedWord(text, blockedWords); found {
+			result.Add(models.ModerationReject, fmt.Sprintf("contains blocked word %q", word))
+		}
+
+		if linkRegexp.MatchString(text) {
+			result.Add(models.ModerationFlag, "contains a link or a messenger username")
+		}
+
+		if containsPhoneNumber(text) {
+			result.Add(models.ModerationFlag, "contains a phone number")
+		}
+
+		repeats := countRepeats(text, recentTexts)
+		switch {
+		case repeats >= spamRejectRepeats:
+			result.Add(models.ModerationReject, fmt.Sprintf("the same text was posted %d times in the last %s", repeats, spamLookbackPeriod))
+		case repeats >= spamFlagRepeats:
+			result.Add(models.ModerationFlag, "the same text was already posted recently")
+		}
+	}
+
+	if result.Decision == models.ModerationReject {
+		return result, fmt.Errorf("%w: %s", models.ErrContentRejected, strings.Join(result.Reasons, "; "))
+	}
+
+	return result, nil
+}
+
+// Flag puts an already stored entity into the admin review queue when the check flagged it.
+func (m *Moderation) Flag(ctx context.Context, result models.ModerationResult,
+	entityType models.ModerationEntityType, entityID, authorID uint, texts ...string) error {
+	if result.Decision != models.ModerationFlag {
+		return nil
+	}
+
+	_, err := m.repo.CreateModerationItem(ctx, models.ModerationItem{
+		EntityID:     entityID,
+		EntityType:   entityType,
+		AuthorID:     authorID,
+		Content:      strings.Join(texts, "\n"),
+		Reason:       strings.Join(result.Reasons, "; "),
+		Status:       models.ModerationPending,
+		CreationDate: time.Now(),
+	})
+
+	return err
+}
+
+func (m *Moderation) GetModerationQueue(ctx context.Context) ([]models.ModerationItem, error) {
+	return m.repo.GetModerationItems(ctx, models.ModerationPending)
+}
+
+func (m *Moderation) ResolveModerationItem(ctx context.Context, id, adminID uint, approve bool) error {
+	item, err := m.repo.GetModerationItemByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if item.Status != models.ModerationPending {
+		return fmt.Errorf("moderation item is already %s", item.Status)
+	}
+
+	status := models.ModerationApproved
+	if !approve {
+		status = models.ModerationRemoved
+		err = m.removeEntity(ctx, item)
+		if err != nil {
+			return err
+		}
+	}
+
+	return m.repo.UpdateModerationItem(ctx, id, map[string]any{
+		"status":      status,
+		"resolved_by": sql.NullInt64{Int64: int64(adminID), Valid: true},
+		"resolved_at": sql.NullTime{Time: time.Now(), Valid: true},
+	})
+}
+
+func (m *Moderation) removeEntity(ctx context.Context, item models.ModerationItem) error {
+	switch item.EntityType {
+	case models.ModeratedComment:
+		return m.repo.DeleteComment(ctx, item.EntityID)
+	case models.ModeratedProposalEvent:
+		return m.repo.BanEvent(ctx, models.ID(item.EntityID), models.ProposalEventType)
+	case models.ModeratedHelpEvent:
+		return m.repo.BanEvent(ctx, models.ID(item.EntityID), models.HelpEventType)
+	case models.ModeratedEventUpdate:
+		return m.repo.DeleteEventUpdate(ctx, item.EntityID)
+	default:
+		return fmt.Errorf("there is no %s moderation entity type", item.EntityType)
+	}
+}
+
+func (m *Moderation) GetBlockedWords(ctx context.Context) ([]models.BlockedWord, error) {
+	return m.repo.GetBlockedWords(ctx)
+}
+
+func (m *Moderation) CreateBlockedWord(ctx context.Context, word models.BlockedWord) (uint, error) {
+	word.Word = strings.ToLower(strings.TrimSpace(word.Word))
+	if word.Word == "" {
+		return 0, fmt.Errorf("blocked word cannot be empty")
+	}
+	if word.Language != models.Ukrainian && word.Language != models.English {
+		return 0, fmt.Errorf("there is no %s blocked word language", word.Language)
+	}
+
+	return m.repo.CreateBlockedWord(ctx, word)
+}
+
+func (m *Moderation) DeleteBlockedWord(ctx context.Context, id uint) error {
+	return m.repo.DeleteBlockedWord(ctx, id)
+}
+
+// findBlockedWord matches blocked words as whole words. A blocked word ending with
+// models.BlockedStemSuffix is a stem and matches the words it starts, so it catches
+// inflected forms. Blocked phrases are matched as a run of whole words.
+func findBlockedWord(text string, blockedWords []models.BlockedWord) (string, bool) {
+	words := splitWords(text)
+	for _, blockedWord := range blockedWords {
+		blocked := strings.ToLower(blockedWord.Word)
+		if strings.Contains(blocked, " ") {
+			if containsPhrase(words, splitWords(blocked)) {
+				return blockedWord.Word, true
+			}
+			continue
+		}
+		isStem := strings.HasSuffix(blocked, models.BlockedStemSuffix)
+		stem := strings.TrimSuffix(blocked, models.BlockedStemSuffix)
+		for _, word := range words {
+			if word == blocked || (isStem && stem != "" && strings.HasPrefix(word, stem)) {
+				return blockedWord.Word, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+func containsPhrase(words, phrase []string) bool {
+	joinedPhrase := strings.Join(phrase, " ")
+	for i := 0; i+len(phrase) <= len(words); i++ {
+		if strings.Join(words[i:i+len(phrase)], " ") == joinedPhrase {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsPhoneNumber(text string) bool {
+	for _, candidate := range phoneRegexp.FindAllString(text, -1) {
+		digits := 0
+		for _, r := range candidate {
+			if unicode.IsDigit(r) {
+				digits++
+			}
+		}
+		if digits >= 10 && digits <= 13 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func countRepeats(text string, recentTexts []string) int {
+	normalized := strings.Join(splitWords(text), " ")
+	if len([]rune(normalized)) < spamMinTextLength {
+		return 0
+	}
+
+	repeats := 0
+	for _, recentText := range recentTexts {
+		if strings.Join(splitWords(recentText), " ") == normalized {
+			repeats++
+		}
+	}
+
+	return repeats
+}
+
+func splitWords(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '\''
+	})
+}
+
+// changedEventTexts takes pairs of old and new values and returns the new ones
+// that were set and differ from the stored ones, so an update is not checked
+// against its own text.
+func changedEventTexts(oldAndNew ...string) []string {
+	changed := make([]string, 0)
+	for i := 0; i+1 < len(oldAndNew); i += 2 {
+		if oldAndNew[i+1] != "" && oldAndNew[i+1] != oldAndNew[i] {
+			changed = append(changed, oldAndNew[i+1])
+		}
+	}
+
+	return changed
+}
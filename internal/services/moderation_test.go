@@ -0,0 +1,184 @@
+package service_test
+
+import (
+	"Kurajj/internal/models"
+	service "Kurajj/internal/services"
+	mock_service "Kurajj/internal/services/mocks"
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestWriteCommentWithBlockedWord(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	commentService := service.NewComment(repo)
+
+	repo.EXPECT().GetMemberQuotaPolicies(context.TODO(), uint(1)).Return([]models.QuotaPolicy{}, nil)
+	repo.EXPECT().CountCommentsSince(context.TODO(), uint(1), gomock.Any()).Return(0, nil)
+	repo.EXPECT().GetBlockedWords(context.TODO()).Return([]models.BlockedWord{
+		{Word: "бляд*", Language: models.Ukrainian},
+	}, nil)
+	repo.EXPECT().GetUserRecentTexts(context.TODO(), uint(1), gomock.Any()).Return([]string{}, nil)
+
+	_, err := commentService.WriteComment(context.TODO(), models.Comment{
+		EventID:   1,
+		EventType: models.ProposalEventType,
+		Text:      "Блядь, знову ніхто не відповів",
+		UserID:    1,
+	})
+	assert.True(t, errors.Is(err, models.ErrContentRejected))
+}
+
+func TestWriteCommentWithPhoneNumber(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	commentService := service.NewComment(repo)
+
+	comment := models.Comment{
+		EventID:   1,
+		EventType: models.ProposalEventType,
+		Text:      "Пишіть мені напряму: +38 (067) 123-45-67",
+		UserID:    1,
+	}
+
+	repo.EXPECT().GetMemberQuotaPolicies(context.TODO(), uint(1)).Return([]models.QuotaPolicy{}, nil)
+	repo.EXPECT().CountCommentsSince(context.TODO(), uint(1), gomock.Any()).Return(0, nil)
+	repo.EXPECT().GetBlockedWords(context.TODO()).Return([]models.BlockedWord{}, nil)
+	repo.EXPECT().GetUserRecentTexts(context.TODO(), comment.UserID, gomock.Any()).Return([]string{}, nil)
+	repo.EXPECT().WriteComment(context.TODO(), comment).Return(uint(3), nil)
+	repo.EXPECT().CreateModerationItem(context.TODO(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, item models.ModerationItem) (uint, error) {
+			assert.Equal(t, models.ModeratedComment, item.EntityType)
+			assert.Equal(t, uint(3), item.EntityID)
+			assert.Equal(t, models.ModerationPending, item.Status)
+			return 1, nil
+		})
+
+	id, err := commentService.WriteComment(context.TODO(), comment)
+	assert.NoError(t, err)
+	assert.Equal(t, uint(3), id)
+}
+
+func TestWriteCommentWithRepeatedContent(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	commentService := service.NewComment(repo)
+
+	text := "Free delivery, contact me for details"
+	repo.EXPECT().GetMemberQuotaPolicies(context.TODO(), uint(1)).Return([]models.QuotaPolicy{}, nil)
+	repo.EXPECT().CountCommentsSince(context.TODO(), uint(1), gomock.Any()).Return(0, nil)
+	repo.EXPECT().GetBlockedWords(context.TODO()).Return([]models.BlockedWord{}, nil)
+	repo.EXPECT().GetUserRecentTexts(context.TODO(), uint(1), gomock.Any()).
+		Return([]string{text, "free delivery contact me for details!", "  FREE DELIVERY, contact me for details"}, nil)
+
+	_, err := commentService.WriteComment(context.TODO(), models.Comment{
+		EventID:   1,
+		EventType: models.ProposalEventType,
+		Text:      text,
+		UserID:    1,
+	})
+	assert.True(t, errors.Is(err, models.ErrContentRejected))
+}
+
+func TestWriteCommentAllowed(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	commentService := service.NewComment(repo)
+
+	comment := models.Comment{
+		EventID:   1,
+		EventType: models.HelpEventType,
+		Text:      "Дякую, завтра привезу ще дві коробки",
+		UserID:    1,
+	}
+
+	repo.EXPECT().GetMemberQuotaPolicies(context.TODO(), uint(1)).Return([]models.QuotaPolicy{}, nil)
+	repo.EXPECT().CountCommentsSince(context.TODO(), uint(1), gomock.Any()).Return(0, nil)
+	repo.EXPECT().GetBlockedWords(context.TODO()).Return([]models.BlockedWord{
+		{Word: "shit", Language: models.English},
+	}, nil)
+	repo.EXPECT().GetUserRecentTexts(context.TODO(), comment.UserID, gomock.Any()).Return([]string{}, nil)
+	repo.EXPECT().WriteComment(context.TODO(), comment).Return(uint(2), nil)
+
+	_, err := commentService.WriteComment(context.TODO(), comment)
+	assert.NoError(t, err)
+}
+
+func TestWriteCommentWithWordStartingWithBlockedWord(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	commentService := service.NewComment(repo)
+
+	comment := models.Comment{
+		EventID:   1,
+		EventType: models.HelpEventType,
+		Text:      "Shitake mushrooms and assholes",
+		UserID:    1,
+	}
+
+	repo.EXPECT().GetMemberQuotaPolicies(context.TODO(), uint(1)).Return([]models.QuotaPolicy{}, nil).Times(2)
+	repo.EXPECT().CountCommentsSince(context.TODO(), uint(1), gomock.Any()).Return(0, nil).Times(2)
+	repo.EXPECT().GetBlockedWords(context.TODO()).Return([]models.BlockedWord{
+		{Word: "shit", Language: models.English},
+		{Word: "asshole*", Language: models.English},
+	}, nil).Times(2)
+	repo.EXPECT().GetUserRecentTexts(context.TODO(), comment.UserID, gomock.Any()).Return([]string{}, nil).Times(2)
+
+	_, err := commentService.WriteComment(context.TODO(), comment)
+	assert.True(t, errors.Is(err, models.ErrContentRejected))
+
+	comment.Text = "Shitake mushrooms are ready"
+	repo.EXPECT().WriteComment(context.TODO(), comment).Return(uint(2), nil)
+
+	_, err = commentService.WriteComment(context.TODO(), comment)
+	assert.NoError(t, err)
+}
+
+func TestWriteCommentWithBlockedPhraseStartingWord(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	commentService := service.NewComment(repo)
+
+	comment := models.Comment{
+		EventID:   1,
+		EventType: models.HelpEventType,
+		Text:      "Thanks to the bad wordsmith",
+		UserID:    1,
+	}
+
+	repo.EXPECT().GetMemberQuotaPolicies(context.TODO(), uint(1)).Return([]models.QuotaPolicy{}, nil).Times(2)
+	repo.EXPECT().CountCommentsSince(context.TODO(), uint(1), gomock.Any()).Return(0, nil).Times(2)
+	repo.EXPECT().GetBlockedWords(context.TODO()).Return([]models.BlockedWord{
+		{Word: "bad word", Language: models.English},
+	}, nil).Times(2)
+	repo.EXPECT().GetUserRecentTexts(context.TODO(), comment.UserID, gomock.Any()).Return([]string{}, nil).Times(2)
+	repo.EXPECT().WriteComment(context.TODO(), comment).Return(uint(2), nil)
+
+	_, err := commentService.WriteComment(context.TODO(), comment)
+	assert.NoError(t, err)
+
+	comment.Text = "Thanks to the BAD, word"
+	_, err = commentService.WriteComment(context.TODO(), comment)
+	assert.True(t, errors.Is(err, models.ErrContentRejected))
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"Kurajj/internal/models"
+	"context"
+	"fmt"
+	"github.com/samber/lo"
+)
+
+// preparePledges turns the responder's pledges into transaction needs in the units of the event needs.
+// Every pledge should be for a different need of the event and should not exceed what is left of the need.
+func preparePledges(registry models.UnitRegistry, eventNeeds []models.Need,
+	pledges []models.NeedPledge) ([]models.Need, error) {
+	needs := make([]models.Need, 0, len(pledges))
+	pledged := make(map[uint]bool, len(pledges))
+	for _, pledge := range pledges {
+		eventNeed, ok := lo.Find(eventNeeds, func(need models.Need) bool {
+			return need.ID == pledge.NeedID
+		})
+		if !ok {
+			return nil, fmt.Errorf("%w: the event has no need %d", models.ErrInvalidPledge, pledge.NeedID)
+		}
+		if pledged[pledge.NeedID] {
+			return nil, fmt.Errorf("%w: need %s is pledged twice", models.ErrInvalidPledge, eventNeed.Title)
+		}
+		pledged[pledge.NeedID] = true
+		need, err := pledgeNeed(registry, eventNeed, pledge)
+		if err != nil {
+			return nil, err
+		}
+
+		needs = append(needs, need)
+	}
+
+	return needs, nil
+}
+
+// pledgeNeed returns the transaction need of the pledge, money is pledged in whole minor units.
+func pledgeNeed(registry models.UnitRegistry, eventNeed models.Need, pledge models.NeedPledge) (models.Need, error) {
+	if eventNeed.IsMoney() {
+		amount, err := models.MinorUnitsIn(pledge.Amount, pledge.Unit)
+		if err != nil {
+			return models.Need{}, err
+		}
+		if amount == 0 {
+			return models.Need{}, fmt.Errorf("%w: amount should be positive", models.ErrInvalidPledge)
+		}
+		if amount > eventNeed.RemainingMinor() {
+			return models.Need{}, fmt.Errorf("%w: %s, %d %s left", models.ErrPledgeExceedsNeed,
+				eventNeed.Title, eventNeed.RemainingMinor(), eventNeed.Unit)
+		}
+		return eventNeed.PledgeMinor(amount), nil
+	}
+
+	amount, err := registry.Convert(pledge.Amount, pledge.Unit, eventNeed.Unit)
+	if err != nil {
+		return models.Need{}, err
+	}
+	if amount <= 0 {
+		return models.Need{}, fmt.Errorf("%w: amount should be positive", models.ErrInvalidPledge)
+	}
+	if amount > eventNeed.Remaining() {
+		return models.Need{}, fmt.Errorf("%w: %s, %.2f %s left", models.ErrPledgeExceedsNeed,
+			eventNeed.Title, eventNeed.Remaining(), eventNeed.Unit)
+	}
+
+	return eventNeed.Pledge(amount), nil
+}
+
+// confirmNeedsReceived stores the amounts the event author received when completing the transaction.
+// The amounts reported by the responder are kept for the needs the author does not confirm.
+func (h *HelpEvent) confirmNeedsReceived(ctx context.Context, transactionID uint, needs []models.Need) error {
+	if len(needs) == 0 {
+		return nil
+	}
+
+	for _, need := range needs {
+		if need.Received < 0 || need.ReceivedMinor < 0 {
+			return fmt.Errorf("%w: received amount cannot be negative", models.ErrInvalidPledge)
+		}
+	}
+
+	return h.repo.ConfirmNeedsReceived(ctx, transactionID, needs)
+}
+
+// convertReceived converts the received amounts reported in any unit of the need's dimension to the units
+// of the transaction needs, money to whole minor units.
+func (h *HelpEvent) convertReceived(ctx context.Context, transactionID uint, needs []models.Need) error {
+	if len(needs) == 0 {
+		return nil
+	}
+	registry, err := h.units.registry(ctx)
+	if err != nil {
+		return err
+	}
+	transactionNeeds, err := h.repo.GetTransactionNeeds(ctx, models.ID(transactionID))
+	if err != nil {
+		return err
+	}
+
+	for i, need := range needs {
+		transactionNeed, ok := lo.Find(transactionNeeds, func(n models.Need) bool {
+			return n.ID == need.ID
+		})
+		if !ok {
+			return fmt.Errorf("%w: need %d is not in the transaction", models.ErrInvalidPledge, need.ID)
+		}
+		if transactionNeed.IsMoney() {
+			needs[i].ReceivedMinor, err = models.MinorUnitsIn(need.Received, need.Unit)
+			needs[i].Received = 0
+		} else {
+			needs[i].Received, err = registry.Convert(need.Received, need.Unit, transactionNeed.Unit)
+		}
+		if err != nil {
+			return err
+		}
+		needs[i].Unit = transactionNeed.Unit
+	}
+
+	return nil
+}
@@ -0,0 +1,181 @@
+package service_test
+
+import (
+	"Kurajj/internal/models"
+	service "Kurajj/internal/services"
+	mock_service "Kurajj/internal/services/mocks"
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var pledgedHelpEvent = models.HelpEvent{
+	ID:        1,
+	CreatedBy: 2,
+	Status:    models.Active,
+	Needs: []models.Need{
+		{ID: 10, Title: "Water", Amount: 100, ReceivedTotal: 20, Reserved: 50, HelpEventID: 1, Unit: models.Liter},
+		{ID: 11, Title: "Bread", Amount: 10, HelpEventID: 1, Unit: models.Kilogram},
+		{ID: 13, Title: "Generator", Type: models.MoneyNeed, AmountMinor: 1<<53 + 1, ReservedMinor: 1, HelpEventID: 1,
+			Unit: models.MoneyUnit, Currency: "UAH"},
+	},
+}
+
+func TestCreateRequestWithPledges(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().
+		GetEventByID(context.TODO(), models.ID(1)).
+		Return(pledgedHelpEvent, nil)
+	repo.EXPECT().
+		GetMemberQuotaPolicies(context.TODO(), uint(3)).
+		Return([]models.QuotaPolicy{}, nil)
+	repo.EXPECT().
+		CountPendingResponses(context.TODO(), uint(3)).
+		Return(0, nil)
+	repo.EXPECT().
+		GetUnits(context.TODO()).
+		Return(seededUnits, nil)
+	repo.EXPECT().
+		CreateTransactionWithPledges(context.TODO(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, transaction models.Transaction, pledges []models.Need) (uint, error) {
+			assert.Equal(t, uint(3), transaction.CreatorID)
+			assert.Len(t, pledges, 1)
+			assert.Equal(t, uint(10), *pledges[0].EventNeedID)
+			assert.Equal(t, 0.5, pledges[0].Amount)
+			return 5, nil
+		})
+	repo.EXPECT().
+		CreateNotification(context.TODO(), gomock.Any())
+
+	helpEventService := service.NewHelpEvent(repo)
+
+	transactionID, err := helpEventService.CreateRequest(context.TODO(), 3, models.TransactionAcceptCreateRequest{
+		ID:      1,
+		Pledges: []models.NeedPledge{{NeedID: 10, Amount: 500, Unit: "milliliter"}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, uint(5), transactionID)
+}
+
+func TestCreateRequestWithMoneyPledge(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().
+		GetEventByID(context.TODO(), models.ID(1)).
+		Return(pledgedHelpEvent, nil)
+	repo.EXPECT().
+		GetMemberQuotaPolicies(context.TODO(), uint(3)).
+		Return([]models.QuotaPolicy{}, nil)
+	repo.EXPECT().
+		CountPendingResponses(context.TODO(), uint(3)).
+		Return(0, nil)
+	repo.EXPECT().
+		GetUnits(context.TODO()).
+		Return(seededUnits, nil)
+	repo.EXPECT().
+		CreateTransactionWithPledges(context.TODO(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, transaction models.Transaction, pledges []models.Need) (uint, error) {
+			assert.Len(t, pledges, 1)
+			assert.Equal(t, uint(13), *pledges[0].EventNeedID)
+			assert.Equal(t, int64(1<<53), pledges[0].AmountMinor)
+			assert.Zero(t, pledges[0].Amount)
+			return 5, nil
+		})
+	repo.EXPECT().
+		CreateNotification(context.TODO(), gomock.Any())
+
+	helpEventService := service.NewHelpEvent(repo)
+
+	_, err := helpEventService.CreateRequest(context.TODO(), 3, models.TransactionAcceptCreateRequest{
+		ID:      1,
+		Pledges: []models.NeedPledge{{NeedID: 13, Amount: 1 << 53}},
+	})
+	assert.NoError(t, err)
+}
+
+func TestCreateRequestWithInvalidPledges(t *testing.T) {
+	tests := []struct {
+		name    string
+		pledges []models.NeedPledge
+		err     error
+	}{
+		{
+			name:    "exceeds the remaining need",
+			pledges: []models.NeedPledge{{NeedID: 10, Amount: 31}},
+			err:     models.ErrPledgeExceedsNeed,
+		},
+		{
+			name:    "unknown need",
+			pledges: []models.NeedPledge{{NeedID: 12, Amount: 1}},
+			err:     models.ErrInvalidPledge,
+		},
+		{
+			name:    "same need twice",
+			pledges: []models.NeedPledge{{NeedID: 11, Amount: 1}, {NeedID: 11, Amount: 2}},
+			err:     models.ErrInvalidPledge,
+		},
+		{
+			name:    "incompatible unit",
+			pledges: []models.NeedPledge{{NeedID: 10, Amount: 1, Unit: models.Kilogram}},
+			err:     models.ErrInvalidUnit,
+		},
+		{
+			name:    "fraction of minor units",
+			pledges: []models.NeedPledge{{NeedID: 13, Amount: 100.5}},
+			err:     models.ErrInvalidMoney,
+		},
+		{
+			name:    "money exceeds the remaining need",
+			pledges: []models.NeedPledge{{NeedID: 13, Amount: 1<<53 + 2}},
+			err:     models.ErrPledgeExceedsNeed,
+		},
+		{
+			name:    "money in another unit",
+			pledges: []models.NeedPledge{{NeedID: 13, Amount: 1, Unit: models.Kilogram}},
+			err:     models.ErrInvalidUnit,
+		},
+		{
+			name:    "non positive amount",
+			pledges: []models.NeedPledge{{NeedID: 11, Amount: 0}},
+			err:     models.ErrInvalidPledge,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock_service.NewMockRepositorier(mockCtrl)
+
+			repo.EXPECT().
+				GetEventByID(context.TODO(), models.ID(1)).
+				Return(pledgedHelpEvent, nil)
+			repo.EXPECT().
+				GetMemberQuotaPolicies(context.TODO(), uint(3)).
+				Return([]models.QuotaPolicy{}, nil)
+			repo.EXPECT().
+				CountPendingResponses(context.TODO(), uint(3)).
+				Return(0, nil)
+			repo.EXPECT().
+				GetUnits(context.TODO()).
+				Return(seededUnits, nil)
+
+			helpEventService := service.NewHelpEvent(repo)
+
+			_, err := helpEventService.CreateRequest(context.TODO(), 3, models.TransactionAcceptCreateRequest{
+				ID:      1,
+				Pledges: tt.pledges,
+			})
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
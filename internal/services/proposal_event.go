@@ -5,30 +5,22 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	"github.com/google/uuid"
-	"github.com/robfig/cron/v3"
 	"github.com/samber/lo"
 	"io"
 	"time"
 	_ "time/tzdata"
 )
 
-func NewProposalEvent(repo Repositorier) ProposalEventer {
-	proposalEvent := &ProposalEvent{
-		repo: repo, Transaction: NewTransaction(repo), MaxEventsPerUser: 5}
-	proposalEventCron := cron.New()
-	_, err := proposalEventCron.AddFunc("@every 1m", proposalEvent.provisionEvents)
-	if err != nil {
-		fmt.Println(err)
-	}
-	proposalEventCron.Start()
-	return proposalEvent
+func NewProposalEvent(repo Repositorier) *ProposalEvent {
+	return &ProposalEvent{
+		repo: repo, Transaction: NewTransaction(repo), moderation: NewModeration(repo), quota: NewQuota(repo)}
 }
 
 type ProposalEvent struct {
 	*Transaction
-	repo             Repositorier
-	MaxEventsPerUser uint
+	repo       Repositorier
+	moderation *Moderation
+	quota      *Quota
 }
 
 func (p *ProposalEvent) GetProposalEventStatistics(ctx context.Context, fromStart int, creatorID uint) (models.ProposalEventStatistics, error) {
@@ -151,51 +143,61 @@ func getTransactionsByStatus(transactions []models.Transaction, transactionStatu
 }
 
 func (p *ProposalEvent) GetProposalEventBySearch(ctx context.Context, search models.ProposalEventSearchInternal) (models.ProposalEventPagination, error) {
+	err := models.ValidateGeoSearch(search.Near, search.RadiusKm, search.SortField)
+	if err != nil {
+		return models.ProposalEventPagination{}, err
+	}
+
 	return p.repo.GetProposalEventsWithSearchAndSort(ctx, search)
 }
 
+// GetMemberProposalEvents returns a page of the published proposal events created by the member.
+func (p *ProposalEvent) GetMemberProposalEvents(ctx context.Context, memberID uint,
+	pagination models.PaginationRequest) (models.ProposalEventPagination, error) {
+	return p.GetProposalEventBySearch(ctx, models.ProposalEventSearchInternal{
+		CreatorID:  &memberID,
+		Pagination: pagination,
+	})
+}
+
 func (p *ProposalEvent) UpdateStatus(ctx context.Context, status models.TransactionStatus, transactionID, userID uint, file io.Reader, fileType string, createdFilePath string) error {
 	transaction, err := p.GetTransactionByID(ctx, transactionID)
 	if err != nil {
 		return err
 	}
 
-	if transaction.TransactionStatus == models.Completed || transaction.TransactionStatus == models.Aborted {
+	if lo.Contains(models.FinishedTransactionStatuses, transaction.TransactionStatus) {
 		return fmt.Errorf("transaction cannot be changed when it it in %s state", transaction.TransactionStatus)
 	}
 
 	if transaction.TransactionStatus == status {
 		return fmt.Errorf("transaction already has %s status", status)
 	}
+	if transaction.CreatorID != userID {
+		err = p.checkTransactionManager(ctx, transaction.EventID, userID)
+		if err != nil {
+			return err
+		}
+	}
 
 	transaction.TransactionStatus = status
 	transaction.ResponderStatus = status
 
 	if status == models.Completed {
-		fileUniqueID, err := uuid.NewUUID()
+		report, err := saveTransactionReport(ctx, p.repo, transaction, userID, file, fileType, createdFilePath)
 		if err != nil {
 			return err
 		}
-		fileName := fmt.Sprintf("%s.%s", fileUniqueID.String(), fileType)
-		filePath, err := p.repo.Upload(ctx, fileName, file)
-		if err != nil {
-			return err
-		}
-		transaction.ReportURL = filePath
-		if fileName == "" && fileType == "" {
-			transaction.ReportURL = createdFilePath
-		}
+		transaction.ReportURL = report.S3Path
 	}
 
-	if status == models.Completed || status == models.Canceled || status == models.Interrupted || status == models.Aborted {
+	if lo.Contains(slotReleasingStatuses, status) {
 		transaction.CompetitionDate = sql.NullTime{
 			Time:  time.Now(),
 			Valid: true,
 		}
-	}
 
-	if status != models.InProcess {
-		err = p.repo.UpdateRemainingHelps(ctx, models.ID(transaction.EventID), true, 1)
+		err = p.releaseTransactionSlot(ctx, transaction)
 		if err != nil {
 			return err
 		}
@@ -224,7 +226,8 @@ func (p *ProposalEvent) UpdateStatus(ctx context.Context, status models.Transact
 	return nil
 }
 
-func (p *ProposalEvent) Response(ctx context.Context, proposalEventID, responderID uint, comment string) error {
+func (p *ProposalEvent) Response(ctx context.Context, proposalEventID, responderID, timeSlotID uint, comment string,
+	answers models.QuestionnaireAnswers) error {
 	proposalEvent, err := p.repo.GetEvent(ctx, proposalEventID)
 	if err != nil {
 		return err
@@ -232,53 +235,73 @@ func (p *ProposalEvent) Response(ctx context.Context, proposalEventID, responder
 	if proposalEvent.AuthorID == responderID {
 		return fmt.Errorf("event creator cannot response his/her own events")
 	}
-	for _, transaction := range proposalEvent.Transactions {
-		if transaction.CreatorID == responderID && lo.Contains([]models.TransactionStatus{
-			models.Accepted,
-			models.InProcess,
-			models.Waiting,
-		}, transaction.TransactionStatus) {
-			return fmt.Errorf("user already has transaction in this event")
-		}
+	if proposalEvent.Status == models.InActive {
+		return models.ErrNotFound
 	}
-
-	err = p.repo.UpdateRemainingHelps(ctx, models.ID(proposalEventID), false, 1)
+	if hasActiveTransaction(proposalEvent, responderID) {
+		return fmt.Errorf("user already has transaction in this event")
+	}
+	err = p.quota.CheckPendingResponses(ctx, responderID)
+	if err != nil {
+		return err
+	}
+	err = checkTimeSlot(proposalEvent, timeSlotID)
+	if err != nil {
+		return err
+	}
+	err = proposalEvent.Questionnaire.CheckAnswers(answers)
 	if err != nil {
 		return err
 	}
 
-	id, err := p.CreateTransaction(ctx, models.Transaction{
-		CreatorID:         responderID,
-		EventID:           proposalEventID,
-		Comment:           comment,
-		EventType:         models.ProposalEventType,
-		CreationDate:      time.Now(),
-		TransactionStatus: models.Waiting,
-		ResponderStatus:   models.NotStarted,
-	})
+	if proposalEvent.RemainingHelps <= 0 {
+		return models.ErrNoFreeSlots
+	}
+
+	transaction := models.Transaction{
+		CreatorID:            responderID,
+		EventID:              proposalEventID,
+		Comment:              comment,
+		EventType:            models.ProposalEventType,
+		CreationDate:         time.Now(),
+		TransactionStatus:    models.Waiting,
+		ResponderStatus:      models.NotStarted,
+		QuestionnaireAnswers: answers,
+	}
+	if timeSlotID != 0 {
+		transaction.TimeSlotID = sql.NullInt64{Int64: int64(timeSlotID), Valid: true}
+	}
+	id, err := p.repo.CreateTransactionWithSlot(ctx, transaction)
 	if err != nil {
 		return err
 	}
 
-	err = p.createNotification(ctx, models.TransactionNotification{
+	return notifyOrganizers(ctx, p.repo, models.TransactionNotification{
 		EventType:     models.ProposalEventType,
 		EventID:       proposalEventID,
 		Action:        models.Created,
 		TransactionID: id,
 		IsRead:        false,
 		CreationTime:  time.Now(),
-		MemberID:      proposalEvent.AuthorID,
-	})
-
-	return err
+	}, proposalEvent.AuthorID, proposalEvent.CoOrganizers)
 }
 
+// Accept accepts or declines the response on behalf of the event author or its transaction manager.
 func (p *ProposalEvent) Accept(ctx context.Context, request models.AcceptRequest) error {
 	status := models.Canceled
 	if request.Accept {
 		status = models.Accepted
 	}
-	err := p.UpdateTransaction(ctx, models.Transaction{
+	transaction, err := p.GetTransactionByID(ctx, request.TransactionID)
+	if err != nil {
+		return err
+	}
+	err = p.checkTransactionManager(ctx, transaction.EventID, request.MemberID)
+	if err != nil {
+		return err
+	}
+
+	err = p.UpdateTransaction(ctx, models.Transaction{
 		ID:                request.TransactionID,
 		TransactionStatus: status,
 	})
@@ -286,9 +309,11 @@ func (p *ProposalEvent) Accept(ctx context.Context, request models.AcceptRequest
 		return err
 	}
 
-	transaction, err := p.GetTransactionByID(ctx, request.TransactionID)
-	if err != nil {
-		return err
+	if status == models.Canceled {
+		err = p.releaseTransactionSlot(ctx, transaction)
+		if err != nil {
+			return err
+		}
 	}
 
 	err = p.createNotification(ctx, models.TransactionNotification{
@@ -311,22 +336,86 @@ func (p *ProposalEvent) GetUserProposalEvents(ctx context.Context, userID uint)
 
 func (p *ProposalEvent) CreateEvent(ctx context.Context, event models.ProposalEvent) (uint, error) {
 	userID := event.AuthorID
-	events, err := p.GetUserProposalEvents(ctx, userID)
+	var err error
+	if event.Status != models.InActive {
+		err = p.quota.CheckActiveEvents(ctx, userID, models.ProposalEventType)
+		if err != nil {
+			return 0, err
+		}
+	}
+	if event.PublishAt.Valid {
+		err = validatePublishDate(event.Status, event.PublishAt.Time, event.EndDate)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	event.RecurrenceRule, err = normalizeRecurrenceRule(event.RecurrenceRule)
+	if err != nil {
+		return 0, err
+	}
+	err = prepareTimeSlots(&event)
+	if err != nil {
+		return 0, err
+	}
+	err = event.Questionnaire.Validate()
+	if err != nil {
+		return 0, err
+	}
+	err = event.Location.ValidateCoordinates()
 	if err != nil {
 		return 0, err
 	}
-	currentEventsCount := uint(0)
-	for _, event := range events {
-		if event.Status == models.Active {
-			currentEventsCount += 1
-		}
 
-		if currentEventsCount >= p.MaxEventsPerUser {
-			return 0, fmt.Errorf("user cannot create more than %d events", p.MaxEventsPerUser)
-		}
+	moderationResult, err := p.moderation.Check(ctx, userID, event.Title, event.Description)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := p.repo.CreateProposalEvent(ctx, event)
+	if err != nil {
+		return 0, err
+	}
+
+	return id, p.moderation.Flag(ctx, moderationResult, models.ModeratedProposalEvent, id, userID,
+		event.Title, event.Description)
+}
 
+// CloneEvent copies the author's event into a new draft with reset counters.
+// The copy ends at the requested end date and gets the requested time slots instead of the source ones.
+func (p *ProposalEvent) CloneEvent(ctx context.Context, id, authorID uint, request models.CloneEventRequest) (uint, error) {
+	event, err := p.repo.GetEvent(ctx, id)
+	if err != nil {
+		return 0, err
 	}
-	return p.repo.CreateProposalEvent(ctx, event)
+	if event.AuthorID != authorID {
+		return 0, models.ErrNotFound
+	}
+	err = request.Validate(time.Now())
+	if err != nil {
+		return 0, err
+	}
+	if (len(event.TimeSlots) == 0) != (len(request.TimeSlots) == 0) {
+		return 0, fmt.Errorf("%w: time slots are required exactly when the event has them", models.ErrInvalidClone)
+	}
+
+	return p.CreateEvent(ctx, models.ProposalEvent{
+		AuthorID:              authorID,
+		Title:                 event.Title,
+		Description:           event.Description,
+		Location:              cloneLocation(event.Location),
+		CreationDate:          time.Now(),
+		EndDate:               request.EndDate,
+		Status:                models.InActive,
+		ImagePath:             event.ImagePath,
+		MaxConcurrentRequests: event.MaxConcurrentRequests,
+		RemainingHelps:        int(event.MaxConcurrentRequests),
+		Tags:                  cloneTags(event.Tags),
+		RecurrenceRule:        event.RecurrenceRule,
+		Occurrence:            1,
+		TimeSlots:             request.InternalTimeSlots(),
+		Questionnaire:         event.Questionnaire,
+	})
 }
 
 func (p *ProposalEvent) GetEvent(ctx context.Context, id uint) (models.ProposalEvent, error) {
@@ -341,52 +430,183 @@ func (p *ProposalEvent) GetEvents(ctx context.Context) ([]models.ProposalEvent,
 	return p.repo.GetEvents(ctx)
 }
 
-func (p *ProposalEvent) UpdateProposalEvent(ctx context.Context, newEvent models.ProposalEvent) error {
+// UpdateProposalEvent updates the event on behalf of its author or an editor among its co-organizers.
+func (p *ProposalEvent) UpdateProposalEvent(ctx context.Context, newEvent models.ProposalEvent, memberID uint) error {
 	oldEvent, err := p.repo.GetEvent(ctx, newEvent.ID)
 	if err != nil {
 		return err
 	}
-	if newEvent.MaxConcurrentRequests-oldEvent.MaxConcurrentRequests != 0 && newEvent.MaxConcurrentRequests != 0 {
-		newEvent.RemainingHelps = p.calculateRemainingHelps(oldEvent, newEvent)
+	if !oldEvent.Organizes(memberID, models.EditorRole) {
+		return models.ErrNotFound
 	}
-	return p.repo.UpdateEvent(ctx, newEvent)
+	capacityChanged := newEvent.MaxConcurrentRequests != 0 && newEvent.MaxConcurrentRequests != oldEvent.MaxConcurrentRequests
+	if capacityChanged && len(oldEvent.TimeSlots) != 0 {
+		return fmt.Errorf("%w: capacity of an event with time slots is the sum of their capacities", models.ErrInvalidTimeSlot)
+	}
+	capacity := newEvent.MaxConcurrentRequests
+	// free slots move with the capacity in the DB, so slots taken meanwhile are not overwritten
+	newEvent.MaxConcurrentRequests = 0
+	newEvent.RemainingHelps = 0
+
+	newEvent.RecurrenceRule, err = normalizeRecurrenceRule(newEvent.RecurrenceRule)
+	if err != nil {
+		return err
+	}
+
+	if newEvent.PublishAt.Valid {
+		endDate := newEvent.EndDate
+		if endDate.IsZero() {
+			endDate = oldEvent.EndDate
+		}
+		err = validatePublishDate(oldEvent.Status, newEvent.PublishAt.Time, endDate)
+		if err != nil {
+			return err
+		}
+	}
+	if oldEvent.Status != models.Active && newEvent.Status == models.Active {
+		err = p.quota.CheckActiveEvents(ctx, oldEvent.AuthorID, models.ProposalEventType)
+		if err != nil {
+			return err
+		}
+	}
+	isPublished := oldEvent.Status == models.InActive && newEvent.Status == models.Active
+
+	changedTexts := changedEventTexts(oldEvent.Title, newEvent.Title, oldEvent.Description, newEvent.Description)
+	moderationResult := models.ModerationResult{Decision: models.ModerationAllow}
+	if len(changedTexts) != 0 {
+		moderationResult, err = p.moderation.Check(ctx, oldEvent.AuthorID, changedTexts...)
+		if err != nil {
+			return err
+		}
+	}
+
+	if capacityChanged {
+		err = p.repo.ChangeCapacity(ctx, oldEvent.ID, capacity)
+		if err != nil {
+			return err
+		}
+	}
+	err = p.repo.UpdateEvent(ctx, newEvent)
+	if err != nil {
+		return err
+	}
+
+	err = p.moderation.Flag(ctx, moderationResult, models.ModeratedProposalEvent, oldEvent.ID, oldEvent.AuthorID,
+		changedTexts...)
+	if err != nil {
+		return err
+	}
+
+	if isPublished {
+		return notifySearchMatches(ctx, p.repo, models.ProposalEventType, oldEvent.ID, oldEvent.AuthorID,
+			oldEvent.Tags, oldEvent.Location)
+	}
+
+	return nil
 }
 
-func (p *ProposalEvent) calculateRemainingHelps(oldEvent, newEvent models.ProposalEvent) int {
-	return int(newEvent.MaxConcurrentRequests-oldEvent.MaxConcurrentRequests) + oldEvent.RemainingHelps
+// publishScheduledEvents makes drafts active once their publish date comes.
+// A draft stays scheduled while its author has no room for one more active event.
+func (p *ProposalEvent) publishScheduledEvents(ctx context.Context, now time.Time) error {
+	events, err := p.repo.GetScheduledProposalEvents(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	var lastErr error
+	for _, e := range events {
+		err = p.UpdateProposalEvent(ctx, models.ProposalEvent{ID: e.ID, Status: models.Active}, e.AuthorID)
+		if err != nil {
+			lastErr = fmt.Errorf("event %d: %w", e.ID, err)
+		}
+	}
+	return lastErr
 }
 
 func (p *ProposalEvent) DeleteEvent(ctx context.Context, id uint) error {
 	return p.repo.DeleteEvent(ctx, id)
 }
 
+// checkTransactionManager checks that the member is the author of the event or manages its transactions.
+func (p *ProposalEvent) checkTransactionManager(ctx context.Context, eventID, memberID uint) error {
+	event, err := p.repo.GetEvent(ctx, eventID)
+	if err != nil {
+		return err
+	}
+	if !event.Organizes(memberID, models.TransactionManagerRole) {
+		return models.ErrNotFound
+	}
+
+	return nil
+}
+
 func (p *ProposalEvent) createNotification(ctx context.Context, notification models.TransactionNotification) error {
 	_, err := p.repo.CreateNotification(ctx, notification)
 	return err
 }
 
-func (p *ProposalEvent) provisionEvents() {
-	ctx := context.Background()
-	events, err := p.repo.GetEvents(ctx)
-	if err != nil {
-		fmt.Println(err)
-	}
-	//loc, err := time.LoadLocation("Europe/Kiev")
-	//if err != nil {
-	//	fmt.Println(err)
-	//	return
-	//}
+// expireEvents finishes active events whose end date has passed, or moves recurring ones
+// to their next occurrence. Events are processed in batches of batchSize.
+func (p *ProposalEvent) expireEvents(ctx context.Context, now time.Time, batchSize int) error {
+	var lastErr error
+	var afterID uint
+	for {
+		events, err := p.repo.GetExpiredProposalEvents(ctx, now, afterID, batchSize)
+		if err != nil {
+			return err
+		}
 
-	currentTime := time.Now().Add(3 * time.Hour)
-	for _, e := range events {
-		endDate := e.EndDate
-		fmt.Println(currentTime, endDate)
-		if e.Status == models.Active && currentTime.After(endDate) {
-			e.Status = models.Done
-			err = p.repo.UpdateEvent(ctx, e)
+		for _, e := range events {
+			afterID = e.ID
+			err = p.expireEvent(ctx, e, now)
 			if err != nil {
-				fmt.Println(err)
+				lastErr = fmt.Errorf("event %d: %w", e.ID, err)
 			}
 		}
+		if len(events) < batchSize {
+			return lastErr
+		}
 	}
 }
+
+// expireEvent settles the unfinished transactions and the waitlist of the event. Recurring events
+// move to their next occurrence only after the finished one is settled.
+func (p *ProposalEvent) expireEvent(ctx context.Context, e models.ProposalEvent, now time.Time) error {
+	if occurrence, nextEndDate, ok := e.NextOccurrence(now); ok {
+		err := p.settleExpiredProposalEvent(ctx, e)
+		if err != nil {
+			return err
+		}
+		return p.repo.StartNextOccurrence(ctx, e.ID, occurrence, nextEndDate)
+	}
+
+	err := p.repo.UpdateEvent(ctx, models.ProposalEvent{ID: e.ID, Status: models.Done})
+	if err != nil {
+		return err
+	}
+
+	return p.settleExpiredProposalEvent(ctx, e)
+}
+
+func (p *ProposalEvent) settleExpiredProposalEvent(ctx context.Context, e models.ProposalEvent) error {
+	err := p.settleExpiredEvent(ctx, e.ID, models.ProposalEventType, e.AuthorID)
+	if err != nil {
+		return err
+	}
+
+	return p.repo.ExpireWaitlist(ctx, e.ID)
+}
+
+// normalizeRecurrenceRule validates the recurrence rule of a proposal event
+// and brings it to the form it is stored in.
+func normalizeRecurrenceRule(rule string) (string, error) {
+	if rule == "" {
+		return "", nil
+	}
+	recurrenceRule, err := models.ParseRecurrenceRule(rule)
+	if err != nil {
+		return "", err
+	}
+
+	return recurrenceRule.String(), nil
+}
@@ -37,6 +37,14 @@ func TestCreateProposalEvent(t *testing.T) {
 		},
 	}
 
+	repo.EXPECT().
+		GetMemberQuotaPolicies(context.TODO(), proposalEvent.AuthorID).Return([]models.QuotaPolicy{}, nil)
+	repo.EXPECT().
+		CountActiveEvents(context.TODO(), proposalEvent.AuthorID, models.ProposalEventType).Return(0, nil)
+	repo.EXPECT().
+		GetBlockedWords(context.TODO()).Return([]models.BlockedWord{}, nil)
+	repo.EXPECT().
+		GetUserRecentTexts(context.TODO(), proposalEvent.AuthorID, gomock.Any()).Return([]string{}, nil)
 	repo.EXPECT().
 		CreateProposalEvent(context.TODO(), proposalEvent)
 
@@ -68,6 +76,14 @@ func BenchmarkCreateProposalEvent(b *testing.B) {
 		},
 	}
 
+	repo.EXPECT().
+		GetMemberQuotaPolicies(context.TODO(), proposalEvent.AuthorID).Return([]models.QuotaPolicy{}, nil).Times(b.N)
+	repo.EXPECT().
+		CountActiveEvents(context.TODO(), proposalEvent.AuthorID, models.ProposalEventType).Return(0, nil).Times(b.N)
+	repo.EXPECT().
+		GetBlockedWords(context.TODO()).Return([]models.BlockedWord{}, nil).Times(b.N)
+	repo.EXPECT().
+		GetUserRecentTexts(context.TODO(), proposalEvent.AuthorID, gomock.Any()).Return([]string{}, nil).Times(b.N)
 	repo.EXPECT().
 		CreateProposalEvent(context.TODO(), proposalEvent).Times(b.N)
 
@@ -108,14 +124,38 @@ func TestUpdateProposalEvent(t *testing.T) {
 	proposalEventService := service.NewProposalEvent(repo)
 
 	repo.EXPECT().GetEvent(context.TODO(), oldEvent.ID).Return(oldEvent, nil)
+	repo.EXPECT().ChangeCapacity(context.TODO(), oldEvent.ID, uint(15)).Return(nil)
 	newEvent := event
-	newEvent.RemainingHelps = 10
+	newEvent.MaxConcurrentRequests = 0
+	newEvent.RemainingHelps = 0
 	repo.EXPECT().UpdateEvent(context.TODO(), newEvent).Return(nil)
 
-	err := proposalEventService.UpdateProposalEvent(context.TODO(), event)
+	err := proposalEventService.UpdateProposalEvent(context.TODO(), event, 1)
 	assert.NoError(t, err)
 }
 
+func TestUpdateProposalEventCapacityBelowTakenSlots(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().GetEvent(context.TODO(), uint(1)).Return(models.ProposalEvent{
+		ID:                    1,
+		AuthorID:              1,
+		Status:                models.Active,
+		MaxConcurrentRequests: 10,
+		RemainingHelps:        2,
+	}, nil)
+	repo.EXPECT().ChangeCapacity(context.TODO(), uint(1), uint(5)).Return(models.ErrCapacityBelowTakenSlots)
+
+	proposalEventService := service.NewProposalEvent(repo)
+	err := proposalEventService.UpdateProposalEvent(context.TODO(),
+		models.ProposalEvent{ID: 1, MaxConcurrentRequests: 5}, 1)
+
+	assert.ErrorIs(t, err, models.ErrCapacityBelowTakenSlots)
+}
+
 func TestGetProposalEventStatistics(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
@@ -199,6 +239,14 @@ func TestAcceptWithCancelStatus(t *testing.T) {
 		ResponderStatus:   models.Canceled,
 	}, nil)
 
+	repo.EXPECT().
+		GetEvent(context.TODO(), uint(2)).
+		Return(models.ProposalEvent{ID: 2, AuthorID: 1}, nil)
+
+	repo.EXPECT().
+		ReleaseTransactionSlotToWaitlist(context.TODO(), uint(1), gomock.Any(), gomock.Any()).
+		Return(models.WaitlistEntry{}, models.ErrNotFound)
+
 	monkey.Patch(time.Now, func() time.Time {
 		return time.Date(2009, 11, 17, 20, 34, 58, 651387237, time.UTC)
 	})
@@ -252,7 +300,7 @@ func TestResponseWhenRequesterHasTransaction(t *testing.T) {
 
 	proposalEventService := service.NewProposalEvent(repo)
 
-	err := proposalEventService.Response(context.TODO(), 1, 1, "")
+	err := proposalEventService.Response(context.TODO(), 1, 1, 0, "", nil)
 	assert.Error(t, err)
 	assert.EqualError(t, err, "event creator cannot response his/her own events")
 }
@@ -279,19 +327,22 @@ func TestResponse(t *testing.T) {
 			MaxConcurrentRequests: 10,
 			RemainingHelps:        5,
 		}, nil)
-
 	repo.EXPECT().
-		UpdateRemainingHelps(context.TODO(), models.ID(1), false, 1)
+		GetMemberQuotaPolicies(context.TODO(), uint(1)).
+		Return([]models.QuotaPolicy{}, nil)
+	repo.EXPECT().
+		CountPendingResponses(context.TODO(), uint(1)).
+		Return(0, nil)
 
 	repo.EXPECT().
-		CreateTransaction(context.TODO(), gomock.Any())
+		CreateTransactionWithSlot(context.TODO(), gomock.Any())
 
 	repo.EXPECT().
 		CreateNotification(context.TODO(), gomock.Any())
 
 	proposalEventService := service.NewProposalEvent(repo)
 
-	err := proposalEventService.Response(context.TODO(), 1, 1, "")
+	err := proposalEventService.Response(context.TODO(), 1, 1, 0, "", nil)
 	assert.NoError(t, err)
 }
 
@@ -320,7 +371,7 @@ func TestResponseWhenRequesterIsEventCreator(t *testing.T) {
 
 	proposalEventService := service.NewProposalEvent(repo)
 
-	err := proposalEventService.Response(context.TODO(), 1, 1, "")
+	err := proposalEventService.Response(context.TODO(), 1, 1, 0, "", nil)
 	assert.Error(t, err)
 	assert.EqualError(t, err, "event creator cannot response his/her own events")
 }
@@ -357,7 +408,7 @@ func TestResponseWhenRequesterAlreadyHasTransaction(t *testing.T) {
 
 	proposalEventService := service.NewProposalEvent(repo)
 
-	err := proposalEventService.Response(context.TODO(), uint(1), uint(2), "")
+	err := proposalEventService.Response(context.TODO(), uint(1), uint(2), 0, "", nil)
 	assert.Error(t, err)
 	assert.EqualError(t, err, "user already has transaction in this event")
 }
@@ -383,6 +434,10 @@ func TestAcceptWithAcceptStatus(t *testing.T) {
 		ResponderStatus:   models.Accepted,
 	}, nil)
 
+	repo.EXPECT().
+		GetEvent(context.TODO(), uint(2)).
+		Return(models.ProposalEvent{ID: 2, AuthorID: 1}, nil)
+
 	monkey.Patch(time.Now, func() time.Time {
 		return time.Date(2009, 11, 17, 20, 34, 58, 651387237, time.UTC)
 	})
@@ -422,3 +477,26 @@ func generateZeroRequests(now time.Time, size int) []models.Request {
 
 	return requests
 }
+
+func TestGetMemberProposalEventsFiltersByCreator(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+	pagination := models.PaginationRequest{PageNumber: 1, PageSize: 10}
+
+	repo.EXPECT().
+		GetProposalEventsWithSearchAndSort(context.TODO(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, search models.ProposalEventSearchInternal) (models.ProposalEventPagination, error) {
+			assert.Equal(t, uint(7), *search.CreatorID)
+			assert.Empty(t, search.State)
+			assert.Equal(t, pagination, search.Pagination)
+			return models.ProposalEventPagination{Events: []models.ProposalEvent{{ID: 3, AuthorID: 7}}}, nil
+		})
+
+	proposalEventService := service.NewProposalEvent(repo)
+	events, err := proposalEventService.GetMemberProposalEvents(context.TODO(), 7, pagination)
+
+	assert.NoError(t, err)
+	assert.Equal(t, uint(7), events.Events[0].AuthorID)
+}
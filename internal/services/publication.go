@@ -0,0 +1,64 @@
+package service
+
+import (
+	"Kurajj/internal/models"
+	"context"
+	"fmt"
+	"time"
+)
+
+// validatePublishDate checks the date a draft is scheduled to be published at.
+func validatePublishDate(status models.EventStatus, publishAt, endDate time.Time) error {
+	if status != models.InActive {
+		return fmt.Errorf("%w: only drafts can be scheduled", models.ErrInvalidPublishDate)
+	}
+	if !publishAt.After(time.Now()) || (!endDate.IsZero() && !publishAt.Before(endDate)) {
+		return fmt.Errorf("%w: it should be in the future and before the end date", models.ErrInvalidPublishDate)
+	}
+
+	return nil
+}
+
+// notifySearchMatches notifies members whose saved search values match the tags or the location
+// of the published event. The author is not notified about their own event.
+func notifySearchMatches(ctx context.Context, repo Repositorier, eventType models.EventType, eventID, authorID uint,
+	tags []models.Tag, location models.Address) error {
+	return notifyMatchingMembers(ctx, repo, models.TransactionNotification{
+		EventType: eventType,
+		EventID:   eventID,
+		Action:    models.SearchMatched,
+	}, authorID, tags, location)
+}
+
+// notifyMatchingMembers sends the notification to every member whose saved search values match
+// the tags or the location of the event, except the author.
+func notifyMatchingMembers(ctx context.Context, repo Repositorier, notification models.TransactionNotification,
+	authorID uint, tags []models.Tag, location models.Address) error {
+	tags = append(tags, models.Tag{
+		Title: "location",
+		Values: []models.TagValue{
+			{Value: location.Region},
+			{Value: location.City},
+			{Value: location.District},
+		},
+	})
+
+	memberIDs, err := repo.GetMembersBySearchValues(ctx, notification.EventType, tags)
+	if err != nil {
+		return err
+	}
+
+	for _, memberID := range memberIDs {
+		if memberID == authorID {
+			continue
+		}
+		notification.MemberID = memberID
+		notification.CreationTime = time.Now()
+		_, err = repo.CreateNotification(ctx, notification)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
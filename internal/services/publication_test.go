@@ -0,0 +1,105 @@
+package service_test
+
+import (
+	"Kurajj/internal/models"
+	service "Kurajj/internal/services"
+	mock_service "Kurajj/internal/services/mocks"
+	"context"
+	"database/sql"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestCreateDraftProposalEventIgnoresEventsLimit(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	proposalEvent := models.ProposalEvent{
+		Title:       "Title",
+		Description: "Description",
+		AuthorID:    1,
+		Status:      models.InActive,
+		EndDate:     time.Now().Add(48 * time.Hour),
+		PublishAt:   sql.NullTime{Time: time.Now().Add(24 * time.Hour), Valid: true},
+	}
+
+	repo.EXPECT().
+		GetBlockedWords(context.TODO()).Return([]models.BlockedWord{}, nil)
+	repo.EXPECT().
+		GetUserRecentTexts(context.TODO(), proposalEvent.AuthorID, gomock.Any()).Return([]string{}, nil)
+	repo.EXPECT().
+		CreateProposalEvent(context.TODO(), proposalEvent)
+
+	proposalEventService := service.NewProposalEvent(repo)
+
+	_, err := proposalEventService.CreateEvent(context.TODO(), proposalEvent)
+	assert.NoError(t, err)
+}
+
+func TestCreateHelpEventWithPastPublishDate(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	helpEventService := service.NewHelpEvent(repo)
+
+	_, err := helpEventService.CreateHelpEvent(context.TODO(), &models.HelpEvent{
+		Title:     "Title",
+		CreatedBy: 1,
+		Status:    models.InActive,
+		PublishAt: sql.NullTime{Time: time.Now().Add(-time.Hour), Valid: true},
+	})
+	assert.ErrorIs(t, err, models.ErrInvalidPublishDate)
+}
+
+func TestPublishDraftNotifiesSearchMatches(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	tags := []models.Tag{
+		{Title: "transport", Values: []models.TagValue{{Value: "car"}}},
+	}
+	repo.EXPECT().
+		GetEvent(context.TODO(), uint(1)).
+		Return(models.ProposalEvent{
+			ID:       1,
+			AuthorID: 2,
+			Status:   models.InActive,
+			Tags:     tags,
+			Location: models.Address{City: "Kharkiv"},
+		}, nil)
+	repo.EXPECT().
+		GetMemberQuotaPolicies(context.TODO(), uint(2)).
+		Return([]models.QuotaPolicy{}, nil)
+	repo.EXPECT().
+		CountActiveEvents(context.TODO(), uint(2), models.ProposalEventType).
+		Return(0, nil)
+	repo.EXPECT().
+		UpdateEvent(context.TODO(), models.ProposalEvent{ID: 1, Status: models.Active})
+	repo.EXPECT().
+		GetMembersBySearchValues(context.TODO(), models.ProposalEventType, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ models.EventType, searchTags []models.Tag) ([]uint, error) {
+			assert.Equal(t, tags[0], searchTags[0])
+			assert.Equal(t, "location", searchTags[1].Title)
+			return []uint{2, 5}, nil
+		})
+	repo.EXPECT().
+		CreateNotification(context.TODO(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, notification models.TransactionNotification) (uint, error) {
+			assert.Equal(t, models.SearchMatched, notification.Action)
+			assert.Equal(t, uint(5), notification.MemberID)
+			return 1, nil
+		})
+
+	proposalEventService := service.NewProposalEvent(repo)
+
+	err := proposalEventService.UpdateProposalEvent(context.TODO(), models.ProposalEvent{ID: 1, Status: models.Active}, 2)
+	assert.NoError(t, err)
+}
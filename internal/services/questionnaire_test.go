@@ -0,0 +1,103 @@
+package service_test
+
+import (
+	"Kurajj/internal/models"
+	service "Kurajj/internal/services"
+	mock_service "Kurajj/internal/services/mocks"
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var questionnaire = models.Questionnaire{
+	{ID: "car", Label: "Do you have a car", Type: models.YesNoQuestion, Required: true},
+}
+
+func TestCreateProposalEventWithInvalidQuestionnaire(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+	proposalEventService := service.NewProposalEvent(repo)
+
+	_, err := proposalEventService.CreateEvent(context.TODO(), models.ProposalEvent{
+		Title:         "Transfer",
+		AuthorID:      1,
+		Status:        models.InActive,
+		Questionnaire: models.Questionnaire{{ID: "car", Label: "Car", Type: "date"}},
+	})
+	assert.ErrorIs(t, err, models.ErrInvalidQuestionnaire)
+}
+
+func TestResponseStoresAnswers(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().
+		GetEvent(context.TODO(), uint(1)).
+		Return(models.ProposalEvent{
+			ID:             1,
+			AuthorID:       2,
+			Status:         models.Active,
+			RemainingHelps: 1,
+			Questionnaire:  questionnaire,
+		}, nil).
+		Times(2)
+	repo.EXPECT().
+		GetMemberQuotaPolicies(context.TODO(), uint(3)).
+		Return([]models.QuotaPolicy{}, nil).
+		Times(2)
+	repo.EXPECT().
+		CountPendingResponses(context.TODO(), uint(3)).
+		Return(0, nil).
+		Times(2)
+	repo.EXPECT().
+		CreateTransactionWithSlot(context.TODO(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, transaction models.Transaction) (uint, error) {
+			assert.Equal(t, models.QuestionnaireAnswers{"car": true}, transaction.QuestionnaireAnswers)
+			return 1, nil
+		})
+	repo.EXPECT().
+		CreateNotification(context.TODO(), gomock.Any())
+
+	proposalEventService := service.NewProposalEvent(repo)
+
+	err := proposalEventService.Response(context.TODO(), 1, 3, 0, "", nil)
+	assert.ErrorIs(t, err, models.ErrInvalidAnswers)
+
+	err = proposalEventService.Response(context.TODO(), 1, 3, 0, "", models.QuestionnaireAnswers{"car": true})
+	assert.NoError(t, err)
+}
+
+func TestCreateHelpRequestWithInvalidAnswers(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().
+		GetEventByID(context.TODO(), models.ID(1)).
+		Return(models.HelpEvent{
+			ID:            1,
+			CreatedBy:     2,
+			Status:        models.Active,
+			Questionnaire: questionnaire,
+		}, nil)
+	repo.EXPECT().
+		GetMemberQuotaPolicies(context.TODO(), uint(3)).
+		Return([]models.QuotaPolicy{}, nil)
+	repo.EXPECT().
+		CountPendingResponses(context.TODO(), uint(3)).
+		Return(0, nil)
+
+	helpEventService := service.NewHelpEvent(repo)
+
+	_, err := helpEventService.CreateRequest(context.TODO(), 3, models.TransactionAcceptCreateRequest{
+		ID:      1,
+		Answers: models.QuestionnaireAnswers{"car": "yes"},
+	})
+	assert.ErrorIs(t, err, models.ErrInvalidAnswers)
+}
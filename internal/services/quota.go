@@ -0,0 +1,151 @@
+package service
+
+import (
+	"Kurajj/internal/models"
+	"context"
+	"fmt"
+	"github.com/samber/lo"
+	"time"
+)
+
+// defaultQuotaPolicy is applied when there is no policy for the member in the database.
+var defaultQuotaPolicy = models.QuotaPolicy{
+	Tier:                models.DefaultQuotaTier,
+	MaxActiveEvents:     5,
+	MaxPendingResponses: 10,
+	MaxDailyComments:    50,
+}
+
+func NewQuota(repo Repositorier) *Quota {
+	return &Quota{repo: repo}
+}
+
+type Quota struct {
+	repo Repositorier
+}
+
+func (q *Quota) GetQuotaPolicies(ctx context.Context) ([]models.QuotaPolicy, error) {
+	return q.repo.GetQuotaPolicies(ctx)
+}
+
+func (q *Quota) SaveQuotaPolicy(ctx context.Context, policy models.QuotaPolicy) (uint, error) {
+	return q.repo.SaveQuotaPolicy(ctx, policy)
+}
+
+func (q *Quota) DeleteMemberQuotaPolicy(ctx context.Context, memberID uint) error {
+	return q.repo.DeleteMemberQuotaPolicy(ctx, memberID)
+}
+
+func (q *Quota) SetVerifiedOrganization(ctx context.Context, memberID uint, verified bool) error {
+	return q.repo.SetVerifiedOrganization(ctx, memberID, verified)
+}
+
+func (q *Quota) GetMemberQuota(ctx context.Context, memberID uint) (models.MemberQuota, error) {
+	policy, err := q.memberQuotaPolicy(ctx, memberID)
+	if err != nil {
+		return models.MemberQuota{}, err
+	}
+
+	memberQuota := models.MemberQuota{Policy: policy.Response()}
+	memberQuota.ActiveProposalEvents, err = q.repo.CountActiveEvents(ctx, memberID, models.ProposalEventType)
+	if err != nil {
+		return models.MemberQuota{}, err
+	}
+	memberQuota.ActiveHelpEvents, err = q.repo.CountActiveEvents(ctx, memberID, models.HelpEventType)
+	if err != nil {
+		return models.MemberQuota{}, err
+	}
+	memberQuota.PendingResponses, err = q.repo.CountPendingResponses(ctx, memberID)
+	if err != nil {
+		return models.MemberQuota{}, err
+	}
+	memberQuota.CommentsInLast24Hours, err = q.repo.CountCommentsSince(ctx, memberID, time.Now().Add(-24*time.Hour))
+	if err != nil {
+		return models.MemberQuota{}, err
+	}
+
+	return memberQuota, nil
+}
+
+// CheckActiveEvents returns ErrQuotaExceeded when the member cannot have one more active event of the type.
+// Drafts do not count toward the limit.
+func (q *Quota) CheckActiveEvents(ctx context.Context, memberID uint, eventType models.EventType) error {
+	policy, err := q.memberQuotaPolicy(ctx, memberID)
+	if err != nil || policy.MaxActiveEvents == 0 {
+		return err
+	}
+
+	count, err := q.repo.CountActiveEvents(ctx, memberID, eventType)
+	if err != nil {
+		return err
+	}
+	if count >= policy.MaxActiveEvents {
+		return fmt.Errorf("%w: %s tier allows at most %d active %s events",
+			models.ErrQuotaExceeded, policy.Tier, policy.MaxActiveEvents, eventType)
+	}
+
+	return nil
+}
+
+// CheckPendingResponses returns ErrQuotaExceeded when the member cannot respond to one more event
+// until some of the responses are accepted.
+func (q *Quota) CheckPendingResponses(ctx context.Context, memberID uint) error {
+	policy, err := q.memberQuotaPolicy(ctx, memberID)
+	if err != nil || policy.MaxPendingResponses == 0 {
+		return err
+	}
+
+	count, err := q.repo.CountPendingResponses(ctx, memberID)
+	if err != nil {
+		return err
+	}
+	if count >= policy.MaxPendingResponses {
+		return fmt.Errorf("%w: %s tier allows at most %d responses waiting for an answer",
+			models.ErrQuotaExceeded, policy.Tier, policy.MaxPendingResponses)
+	}
+
+	return nil
+}
+
+// CheckDailyComments returns ErrQuotaExceeded when the member has written too many comments in the last 24 hours.
+func (q *Quota) CheckDailyComments(ctx context.Context, memberID uint) error {
+	policy, err := q.memberQuotaPolicy(ctx, memberID)
+	if err != nil || policy.MaxDailyComments == 0 {
+		return err
+	}
+
+	count, err := q.repo.CountCommentsSince(ctx, memberID, time.Now().Add(-24*time.Hour))
+	if err != nil {
+		return err
+	}
+	if count >= policy.MaxDailyComments {
+		return fmt.Errorf("%w: %s tier allows at most %d comments a day",
+			models.ErrQuotaExceeded, policy.Tier, policy.MaxDailyComments)
+	}
+
+	return nil
+}
+
+// memberQuotaPolicy picks the member's override first, then the verified organization policy
+// and then the default one.
+func (q *Quota) memberQuotaPolicy(ctx context.Context, memberID uint) (models.QuotaPolicy, error) {
+	policies, err := q.repo.GetMemberQuotaPolicies(ctx, memberID)
+	if err != nil {
+		return models.QuotaPolicy{}, err
+	}
+
+	for _, tier := range []models.QuotaTier{
+		models.MemberOverrideQuotaTier,
+		models.VerifiedOrganizationQuotaTier,
+		models.DefaultQuotaTier,
+	} {
+		policy, ok := lo.Find(policies, func(policy models.QuotaPolicy) bool {
+			return policy.Tier == tier
+		})
+		if ok {
+			return policy, nil
+		}
+	}
+
+	return defaultQuotaPolicy, nil
+}
@@ -0,0 +1,114 @@
+package service_test
+
+import (
+	"Kurajj/internal/models"
+	service "Kurajj/internal/services"
+	mock_service "Kurajj/internal/services/mocks"
+	"context"
+	"database/sql"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestGetMemberQuotaPrefersOverride(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().
+		GetMemberQuotaPolicies(context.TODO(), uint(1)).
+		Return([]models.QuotaPolicy{
+			{ID: 1, Tier: models.DefaultQuotaTier, MaxActiveEvents: 5},
+			{ID: 2, Tier: models.VerifiedOrganizationQuotaTier, MaxActiveEvents: 50},
+			{ID: 3, Tier: models.MemberOverrideQuotaTier, MemberID: sql.NullInt64{Int64: 1, Valid: true}, MaxActiveEvents: 2},
+		}, nil)
+	repo.EXPECT().
+		CountActiveEvents(context.TODO(), uint(1), models.ProposalEventType).Return(1, nil)
+	repo.EXPECT().
+		CountActiveEvents(context.TODO(), uint(1), models.EventType(models.HelpEventType)).Return(0, nil)
+	repo.EXPECT().
+		CountPendingResponses(context.TODO(), uint(1)).Return(3, nil)
+	repo.EXPECT().
+		CountCommentsSince(context.TODO(), uint(1), gomock.Any()).Return(4, nil)
+
+	quota, err := service.NewQuota(repo).GetMemberQuota(context.TODO(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, models.MemberOverrideQuotaTier, quota.Policy.Tier)
+	assert.Equal(t, 2, quota.Policy.MaxActiveEvents)
+	assert.Equal(t, 1, quota.ActiveProposalEvents)
+	assert.Equal(t, 3, quota.PendingResponses)
+	assert.Equal(t, 4, quota.CommentsInLast24Hours)
+}
+
+func TestCheckActiveEventsExceeded(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().
+		GetMemberQuotaPolicies(context.TODO(), uint(1)).
+		Return([]models.QuotaPolicy{
+			{Tier: models.DefaultQuotaTier, MaxActiveEvents: 5},
+			{Tier: models.VerifiedOrganizationQuotaTier, MaxActiveEvents: 50},
+		}, nil)
+	repo.EXPECT().
+		CountActiveEvents(context.TODO(), uint(1), models.EventType(models.HelpEventType)).Return(50, nil)
+
+	err := service.NewQuota(repo).CheckActiveEvents(context.TODO(), 1, models.HelpEventType)
+	assert.ErrorIs(t, err, models.ErrQuotaExceeded)
+}
+
+func TestCheckActiveEventsWithoutLimit(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().
+		GetMemberQuotaPolicies(context.TODO(), uint(1)).
+		Return([]models.QuotaPolicy{
+			{Tier: models.MemberOverrideQuotaTier, MemberID: sql.NullInt64{Int64: 1, Valid: true}},
+		}, nil)
+
+	err := service.NewQuota(repo).CheckActiveEvents(context.TODO(), 1, models.ProposalEventType)
+	assert.NoError(t, err)
+}
+
+func TestCheckDailyCommentsFallsBackToDefault(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().
+		GetMemberQuotaPolicies(context.TODO(), uint(1)).
+		Return([]models.QuotaPolicy{}, nil)
+	repo.EXPECT().
+		CountCommentsSince(context.TODO(), uint(1), gomock.Any()).Return(50, nil)
+
+	err := service.NewQuota(repo).CheckDailyComments(context.TODO(), 1)
+	assert.ErrorIs(t, err, models.ErrQuotaExceeded)
+}
+
+func TestReactivateProposalEventOverQuota(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().
+		GetEvent(context.TODO(), uint(1)).
+		Return(models.ProposalEvent{ID: 1, AuthorID: 2, Status: models.Done}, nil)
+	repo.EXPECT().
+		GetMemberQuotaPolicies(context.TODO(), uint(2)).
+		Return([]models.QuotaPolicy{{Tier: models.DefaultQuotaTier, MaxActiveEvents: 5}}, nil)
+	repo.EXPECT().
+		CountActiveEvents(context.TODO(), uint(2), models.ProposalEventType).Return(5, nil)
+
+	err := service.NewProposalEvent(repo).
+		UpdateProposalEvent(context.TODO(), models.ProposalEvent{ID: 1, Status: models.Active}, 2)
+	assert.ErrorIs(t, err, models.ErrQuotaExceeded)
+}
@@ -0,0 +1,150 @@
+package service_test
+
+import (
+	"Kurajj/internal/models"
+	service "Kurajj/internal/services"
+	mock_service "Kurajj/internal/services/mocks"
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestCreateRecurringProposalEvent(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	proposalEvent := models.ProposalEvent{
+		Title:                 "Free rides",
+		Description:           "Saturday mornings",
+		AuthorID:              1,
+		Status:                models.Active,
+		EndDate:               time.Date(2026, 10, 24, 9, 0, 0, 0, time.UTC),
+		MaxConcurrentRequests: 3,
+		RecurrenceRule:        "freq=weekly;count=4",
+	}
+
+	repo.EXPECT().
+		GetMemberQuotaPolicies(context.TODO(), proposalEvent.AuthorID).Return([]models.QuotaPolicy{}, nil)
+	repo.EXPECT().
+		CountActiveEvents(context.TODO(), proposalEvent.AuthorID, models.ProposalEventType).Return(0, nil)
+	repo.EXPECT().
+		GetBlockedWords(context.TODO()).Return([]models.BlockedWord{}, nil)
+	repo.EXPECT().
+		GetUserRecentTexts(context.TODO(), proposalEvent.AuthorID, gomock.Any()).Return([]string{}, nil)
+	repo.EXPECT().
+		CreateProposalEvent(context.TODO(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, event models.ProposalEvent) (uint, error) {
+			assert.Equal(t, "FREQ=WEEKLY;INTERVAL=1;COUNT=4", event.RecurrenceRule)
+			return 1, nil
+		})
+
+	proposalEventService := service.NewProposalEvent(repo)
+
+	_, err := proposalEventService.CreateEvent(context.TODO(), proposalEvent)
+	assert.NoError(t, err)
+}
+
+func TestCreateProposalEventWithInvalidRecurrenceRule(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	proposalEvent := models.ProposalEvent{
+		Title:          "Free rides",
+		AuthorID:       1,
+		RecurrenceRule: "FREQ=HOURLY;COUNT=4",
+	}
+
+	repo.EXPECT().
+		GetMemberQuotaPolicies(context.TODO(), proposalEvent.AuthorID).Return([]models.QuotaPolicy{}, nil)
+	repo.EXPECT().
+		CountActiveEvents(context.TODO(), proposalEvent.AuthorID, models.ProposalEventType).Return(0, nil)
+
+	proposalEventService := service.NewProposalEvent(repo)
+
+	_, err := proposalEventService.CreateEvent(context.TODO(), proposalEvent)
+	assert.ErrorIs(t, err, models.ErrInvalidRecurrenceRule)
+}
+
+func TestResponseToNextOccurrence(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().
+		GetEvent(context.TODO(), uint(1)).
+		Return(models.ProposalEvent{
+			ID:                    1,
+			AuthorID:              2,
+			Status:                models.Active,
+			MaxConcurrentRequests: 1,
+			RemainingHelps:        1,
+			RecurrenceRule:        "FREQ=WEEKLY;INTERVAL=1",
+			Occurrence:            2,
+			Transactions: []models.Transaction{
+				{ID: 1, CreatorID: 3, EventID: 1, TransactionStatus: models.InProcess, Occurrence: 1},
+			},
+		}, nil)
+	repo.EXPECT().
+		GetMemberQuotaPolicies(context.TODO(), uint(3)).
+		Return([]models.QuotaPolicy{}, nil)
+	repo.EXPECT().
+		CountPendingResponses(context.TODO(), uint(3)).
+		Return(0, nil)
+	repo.EXPECT().
+		CreateTransactionWithSlot(context.TODO(), gomock.Any()).
+		Return(uint(2), nil)
+	repo.EXPECT().
+		CreateNotification(context.TODO(), gomock.Any())
+
+	proposalEventService := service.NewProposalEvent(repo)
+
+	err := proposalEventService.Response(context.TODO(), 1, 3, 0, "", nil)
+	assert.NoError(t, err)
+}
+
+func TestExpireRecurringEventSettlesFinishedOccurrence(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+	now := time.Date(2026, 10, 8, 12, 0, 0, 0, time.UTC)
+
+	repo.EXPECT().
+		GetExpiredProposalEvents(context.TODO(), now, uint(0), 10).
+		Return([]models.ProposalEvent{{
+			ID:             1,
+			AuthorID:       2,
+			Status:         models.Active,
+			EndDate:        time.Date(2026, 10, 8, 9, 0, 0, 0, time.UTC),
+			RecurrenceRule: "FREQ=WEEKLY;INTERVAL=1",
+			Occurrence:     1,
+		}}, nil)
+	repo.EXPECT().
+		GetCurrentEventTransactions(context.TODO(), uint(1), models.ProposalEventType).
+		Return([]models.Transaction{{ID: 5, CreatorID: 3, EventID: 1, TransactionStatus: models.InProcess, Occurrence: 1}}, nil)
+	repo.EXPECT().
+		GetEventCoOrganizers(context.TODO(), uint(1), models.ProposalEventType)
+	settled := repo.EXPECT().
+		UpdateAllNotFinishedTransactions(context.TODO(), uint(1), models.ProposalEventType, models.Interrupted)
+	repo.EXPECT().
+		ReleaseTransactionSlot(context.TODO(), uint(5))
+	repo.EXPECT().
+		CreateNotification(context.TODO(), gomock.Any()).
+		Times(3)
+	waitlistExpired := repo.EXPECT().
+		ExpireWaitlist(context.TODO(), uint(1)).
+		After(settled)
+	repo.EXPECT().
+		StartNextOccurrence(context.TODO(), uint(1), 2, time.Date(2026, 10, 15, 9, 0, 0, 0, time.UTC)).
+		After(waitlistExpired)
+
+	err := service.ExpireProposalEvents(service.NewProposalEvent(repo), context.TODO(), now, 10)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,159 @@
+package service
+
+import (
+	"Kurajj/internal/models"
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"io"
+	"time"
+)
+
+func NewReport(repo Repositorier) *Report {
+	return &Report{repo: repo}
+}
+
+type Report struct {
+	repo Repositorier
+}
+
+// AddTransactionReport attaches one more report file to the transaction. Only its participants can do it.
+func (r *Report) AddTransactionReport(ctx context.Context, transactionID, memberID uint, file io.Reader,
+	fileType, filePath string) (uint, error) {
+	transaction, err := r.repo.GetTransactionByID(ctx, transactionID)
+	if err != nil {
+		return 0, err
+	}
+	if !isTransactionParticipant(transaction, memberID) {
+		return 0, models.ErrNotFound
+	}
+	if transaction.TransactionStatus == models.Aborted || transaction.TransactionStatus == models.Canceled {
+		return 0, fmt.Errorf("reports cannot be added when transaction is in %s state", transaction.TransactionStatus)
+	}
+
+	report, err := saveTransactionReport(ctx, r.repo, transaction, memberID, file, fileType, filePath)
+	return report.ID, err
+}
+
+// GetProposalEventReports returns all reports of the event to its author and the co-organizers managing
+// its transactions, and only own reports to participants.
+func (r *Report) GetProposalEventReports(ctx context.Context, eventID, memberID uint) ([]models.Report, error) {
+	proposalEvent, err := r.repo.GetEvent(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	reports, err := r.repo.GetEventReports(ctx, eventID, models.ProposalEventType)
+	if err != nil {
+		return nil, err
+	}
+	if proposalEvent.Organizes(memberID, models.TransactionManagerRole) {
+		return reports, nil
+	}
+
+	return lo.Filter(reports, func(report models.Report, _ int) bool {
+		return report.MemberID == memberID || report.TransactionCreatorID == memberID
+	}), nil
+}
+
+// GetReportFile returns the report with its content to the participants of the report's transaction
+// and to the co-organizers managing the transactions of its event.
+func (r *Report) GetReportFile(ctx context.Context, id, memberID uint) (models.Report, io.Reader, error) {
+	report, err := r.repo.GetReportByID(ctx, id)
+	if err != nil {
+		return models.Report{}, nil, err
+	}
+
+	transaction, err := r.repo.GetTransactionByID(ctx, report.TransactionID)
+	if err != nil {
+		return models.Report{}, nil, err
+	}
+	if report.MemberID != memberID && !isTransactionParticipant(transaction, memberID) {
+		managesTransactions, err := r.managesTransactions(ctx, transaction, memberID)
+		if err != nil {
+			return models.Report{}, nil, err
+		}
+		if !managesTransactions {
+			return models.Report{}, nil, models.ErrNotFound
+		}
+	}
+
+	file, err := r.repo.Get(ctx, report.S3Path)
+	if err != nil {
+		return models.Report{}, nil, err
+	}
+
+	return report, file, nil
+}
+
+// managesTransactions checks whether the member organizes the event of the transaction with the transaction manager role.
+func (r *Report) managesTransactions(ctx context.Context, transaction models.Transaction, memberID uint) (bool, error) {
+	switch transaction.EventType {
+	case models.ProposalEventType:
+		event, err := r.repo.GetEvent(ctx, transaction.EventID)
+		if err != nil {
+			return false, err
+		}
+		return event.Organizes(memberID, models.TransactionManagerRole), nil
+	case models.HelpEventType:
+		event, err := r.repo.GetEventByID(ctx, models.ID(transaction.EventID))
+		if err != nil {
+			return false, err
+		}
+		return event.Organizes(memberID, models.TransactionManagerRole), nil
+	default:
+		return false, nil
+	}
+}
+
+// isTransactionParticipant checks whether the member created the transaction or responds to it as the event author.
+func isTransactionParticipant(transaction models.Transaction, memberID uint) bool {
+	return transaction.CreatorID == memberID || transaction.Responder.ID == memberID
+}
+
+// saveTransactionReport uploads the report file and records it as a report of the transaction.
+// A file which was already uploaded can be given by filePath, but only when it is stored as a report
+// of the same transaction, so members cannot attach objects uploaded for somebody else.
+func saveTransactionReport(ctx context.Context, repo Repositorier, transaction models.Transaction, memberID uint,
+	file io.Reader, fileType, filePath string) (models.Report, error) {
+	if filePath != "" {
+		reports, err := repo.GetEventReports(ctx, transaction.EventID, transaction.EventType)
+		if err != nil {
+			return models.Report{}, err
+		}
+		report, ok := lo.Find(reports, func(report models.Report) bool {
+			return report.TransactionID == transaction.ID && report.S3Path == filePath
+		})
+		if !ok {
+			return models.Report{}, fmt.Errorf("%w: file path should be one of the transaction's reports", models.ErrInvalidReport)
+		}
+		fileType = report.FileType
+	} else {
+		fileUniqueID, err := uuid.NewUUID()
+		if err != nil {
+			return models.Report{}, err
+		}
+		fileName := fmt.Sprintf("%s.%s", fileUniqueID.String(), fileType)
+		filePath, err = repo.Upload(ctx, fileName, file)
+		if err != nil {
+			return models.Report{}, err
+		}
+	}
+
+	report := models.Report{
+		S3Path:        filePath,
+		EventType:     transaction.EventType,
+		TransactionID: transaction.ID,
+		MemberID:      memberID,
+		FileType:      fileType,
+		CreationDate:  time.Now(),
+	}
+	id, err := repo.CreateReport(ctx, report)
+	if err != nil {
+		return models.Report{}, err
+	}
+	report.ID = id
+
+	return report, nil
+}
@@ -0,0 +1,159 @@
+package service_test
+
+import (
+	"Kurajj/internal/models"
+	service "Kurajj/internal/services"
+	mock_service "Kurajj/internal/services/mocks"
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestUpdateStatusToCompletedSavesReport(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().
+		GetTransactionByID(context.TODO(), uint(1)).
+		Return(models.Transaction{
+			ID:                1,
+			CreatorID:         3,
+			EventID:           2,
+			EventType:         models.ProposalEventType,
+			TransactionStatus: models.InProcess,
+		}, nil)
+	repo.EXPECT().
+		GetEventReports(context.TODO(), uint(2), models.ProposalEventType).
+		Return([]models.Report{{ID: 3, TransactionID: 1, S3Path: "https://example.com/report.png", FileType: "png"}}, nil)
+	repo.EXPECT().
+		CreateReport(context.TODO(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, report models.Report) (uint, error) {
+			assert.Equal(t, "https://example.com/report.png", report.S3Path)
+			assert.Equal(t, uint(1), report.TransactionID)
+			assert.Equal(t, uint(3), report.MemberID)
+			assert.Equal(t, models.ProposalEventType, report.EventType)
+			return 4, nil
+		})
+	repo.EXPECT().
+		ReleaseTransactionSlotToWaitlist(context.TODO(), uint(1), gomock.Any(), gomock.Any()).
+		Return(models.WaitlistEntry{}, models.ErrNotFound)
+	repo.EXPECT().
+		UpdateTransactionByID(context.TODO(), uint(1), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uint, toUpdate map[string]any) error {
+			assert.Equal(t, "https://example.com/report.png", toUpdate["report_url"])
+			return nil
+		})
+	repo.EXPECT().
+		CreateNotification(context.TODO(), gomock.Any())
+
+	proposalEventService := service.NewProposalEvent(repo)
+
+	err := proposalEventService.UpdateStatus(context.TODO(), models.Completed, 1, 3, nil, "",
+		"https://example.com/report.png")
+	assert.NoError(t, err)
+}
+
+func TestAddTransactionReportWithFileOfAnotherTransaction(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().
+		GetTransactionByID(context.TODO(), uint(1)).
+		Return(models.Transaction{
+			ID:                1,
+			CreatorID:         3,
+			EventID:           2,
+			EventType:         models.ProposalEventType,
+			TransactionStatus: models.InProcess,
+		}, nil)
+	repo.EXPECT().
+		GetEventReports(context.TODO(), uint(2), models.ProposalEventType).
+		Return([]models.Report{{ID: 3, TransactionID: 5, S3Path: "https://example.com/report.png", FileType: "png"}}, nil)
+
+	reportService := service.NewReport(repo)
+
+	_, err := reportService.AddTransactionReport(context.TODO(), 1, 3, nil, "", "https://example.com/report.png")
+	assert.ErrorIs(t, err, models.ErrInvalidReport)
+}
+
+func TestGetProposalEventReportsForParticipant(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().
+		GetEvent(context.TODO(), uint(1)).
+		Return(models.ProposalEvent{ID: 1, AuthorID: 2}, nil)
+	repo.EXPECT().
+		GetEventReports(context.TODO(), uint(1), models.ProposalEventType).
+		Return([]models.Report{
+			{ID: 1, TransactionID: 5, MemberID: 2, TransactionCreatorID: 3},
+			{ID: 2, TransactionID: 6, MemberID: 4, TransactionCreatorID: 4},
+			{ID: 3, TransactionID: 5, MemberID: 3, TransactionCreatorID: 3},
+		}, nil)
+
+	reportService := service.NewReport(repo)
+
+	reports, err := reportService.GetProposalEventReports(context.TODO(), 1, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint{1, 3}, lo.Map(reports, func(report models.Report, _ int) uint {
+		return report.ID
+	}))
+}
+
+func TestGetReportFileOfAnotherTransaction(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().
+		GetReportByID(context.TODO(), uint(1)).
+		Return(models.Report{ID: 1, TransactionID: 5, MemberID: 3}, nil)
+	repo.EXPECT().
+		GetTransactionByID(context.TODO(), uint(5)).
+		Return(models.Transaction{ID: 5, EventID: 1, EventType: models.ProposalEventType, CreatorID: 3,
+			Responder: models.User{ID: 2}}, nil)
+	repo.EXPECT().
+		GetEvent(context.TODO(), uint(1)).
+		Return(models.ProposalEvent{ID: 1, AuthorID: 2, CoOrganizers: []models.EventCoOrganizer{
+			{EventID: 1, EventType: models.ProposalEventType, MemberID: 4, Role: models.EditorRole},
+		}}, nil)
+
+	reportService := service.NewReport(repo)
+
+	_, _, err := reportService.GetReportFile(context.TODO(), 1, 4)
+	assert.ErrorIs(t, err, models.ErrNotFound)
+}
+
+func TestGetProposalEventReportsForTransactionManager(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().
+		GetEvent(context.TODO(), uint(1)).
+		Return(models.ProposalEvent{ID: 1, AuthorID: 2, CoOrganizers: []models.EventCoOrganizer{
+			{EventID: 1, EventType: models.ProposalEventType, MemberID: 5, Role: models.TransactionManagerRole},
+		}}, nil)
+	repo.EXPECT().
+		GetEventReports(context.TODO(), uint(1), models.ProposalEventType).
+		Return([]models.Report{
+			{ID: 1, TransactionID: 5, MemberID: 2, TransactionCreatorID: 3},
+			{ID: 2, TransactionID: 6, MemberID: 4, TransactionCreatorID: 4},
+		}, nil)
+
+	reportService := service.NewReport(repo)
+
+	reports, err := reportService.GetProposalEventReports(context.TODO(), 1, 5)
+	assert.NoError(t, err)
+	assert.Len(t, reports, 2)
+}
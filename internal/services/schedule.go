@@ -0,0 +1,186 @@
+package service
+
+import (
+	"Kurajj/configs"
+	"Kurajj/internal/models"
+	zlog "Kurajj/pkg/logger"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/robfig/cron/v3"
+	"sync"
+	"time"
+)
+
+// Job is a background job run by the schedule. The returned error is kept as the job's last error.
+type Job func(ctx context.Context) error
+
+func NewSchedule(repo Repositorier) *Schedule {
+	return &Schedule{repo: repo, cron: cron.New(), jobs: make(map[string]*scheduledJob)}
+}
+
+// Schedule runs background jobs and keeps the status of their latest runs.
+// A job is never run concurrently with itself, a run is skipped while the previous one is in progress.
+// When several replicas are deployed only the elected leader runs the jobs, the others skip them.
+type Schedule struct {
+	repo  Repositorier
+	cron  *cron.Cron
+	mu    sync.Mutex
+	names []string
+	jobs  map[string]*scheduledJob
+}
+
+type scheduledJob struct {
+	job    Job
+	status models.JobStatus
+}
+
+func (s *Schedule) AddJob(name, spec string, job Job) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.jobs[name]; ok {
+		return fmt.Errorf("job %s is already added", name)
+	}
+
+	_, err := s.cron.AddFunc(spec, func() {
+		err := s.RunJob(context.Background(), name)
+		if errors.Is(err, models.ErrJobRunning) {
+			zlog.Log.Info("job is skipped, its previous run is still in progress", "job", name)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	s.names = append(s.names, name)
+	s.jobs[name] = &scheduledJob{
+		job:    job,
+		status: models.JobStatus{Name: name, Schedule: spec},
+	}
+	return nil
+}
+
+// RunJob runs the job right away and returns its error.
+// The job is skipped without an error when another replica is the leader.
+func (s *Schedule) RunJob(ctx context.Context, name string) error {
+	s.mu.Lock()
+	job, ok := s.jobs[name]
+	if !ok {
+		s.mu.Unlock()
+		return models.ErrNotFound
+	}
+	if job.status.Running {
+		s.mu.Unlock()
+		return fmt.Errorf("%w: %s", models.ErrJobRunning, name)
+	}
+	startedAt := time.Now()
+	job.status.Running = true
+	job.status.LastStartedAt = &startedAt
+	s.mu.Unlock()
+
+	isLeader, err := s.repo.IsLeader(ctx)
+	if err == nil && !isLeader {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		job.status.Running = false
+		job.status.Skips++
+		zlog.Log.Info("job is skipped, another replica is the leader", "job", name)
+		return nil
+	}
+	if err == nil {
+		err = job.job(ctx)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	finishedAt := time.Now()
+	job.status.Running = false
+	job.status.LastFinishedAt = &finishedAt
+	job.status.LastDuration = finishedAt.Sub(startedAt).String()
+	job.status.Runs++
+	if err != nil {
+		job.status.Failures++
+		job.status.LastError = err.Error()
+		job.status.LastErrorAt = &finishedAt
+		zlog.Log.Error(err, "job failed", "job", name)
+	}
+	return err
+}
+
+func (s *Schedule) GetJobStatuses(ctx context.Context) []models.JobStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	statuses := make([]models.JobStatus, len(s.names))
+	for i, name := range s.names {
+		statuses[i] = s.jobs[name].status
+	}
+
+	return statuses
+}
+
+func (s *Schedule) Start() {
+	s.cron.Start()
+}
+
+// Stop stops running the jobs, waits for the running ones to finish and resigns the leadership,
+// so another replica takes the jobs over without waiting for the leader's connection to drop.
+func (s *Schedule) Stop(ctx context.Context) error {
+	select {
+	case <-s.cron.Stop().Done():
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	return s.repo.ResignLeadership(ctx)
+}
+
+// newEventSchedule adds the jobs which keep events up to date.
+func newEventSchedule(repo Repositorier, config *configs.Scheduler, proposalEvent *ProposalEvent, helpEvent *HelpEvent) *Schedule {
+	schedule := NewSchedule(repo)
+	// every job compares stored dates with the same wall clock
+	now := jobClock(config)
+	atNow := func(job func(ctx context.Context, now time.Time) error) Job {
+		return func(ctx context.Context) error {
+			return job(ctx, now())
+		}
+	}
+	expireEvents := func(expire func(ctx context.Context, now time.Time, batchSize int) error) Job {
+		return atNow(func(ctx context.Context, now time.Time) error {
+			return expire(ctx, now, config.BatchSize)
+		})
+	}
+
+	for _, job := range []struct {
+		name string
+		job  Job
+	}{
+		{"proposal-events-expiry", expireEvents(proposalEvent.expireEvents)},
+		{"proposal-events-publishing", atNow(proposalEvent.publishScheduledEvents)},
+		{"waitlist-promotions-expiry", atNow(proposalEvent.expireWaitlistPromotions)},
+		{"time-slot-reminders", atNow(proposalEvent.remindTimeSlots)},
+		{"help-events-expiry", expireEvents(helpEvent.expireEvents)},
+		{"help-events-publishing", atNow(helpEvent.publishScheduledEvents)},
+	} {
+		err := schedule.AddJob(job.name, "@every 1m", job.job)
+		if err != nil {
+			zlog.Log.Error(err, "could not schedule job", "job", job.name)
+		}
+	}
+
+	return schedule
+}
+
+// jobClock is the wall clock of the location the scheduled jobs compare event dates with.
+func jobClock(config *configs.Scheduler) func() time.Time {
+	return func() time.Time {
+		return wallClockNow(config.Location())
+	}
+}
+
+// wallClockNow returns the current wall clock time of the location labelled as UTC.
+// Event dates are stored without time zone and are read back the same way.
+func wallClockNow(loc *time.Location) time.Time {
+	now := time.Now().In(loc)
+	return time.Date(now.Year(), now.Month(), now.Day(),
+		now.Hour(), now.Minute(), now.Second(), now.Nanosecond(), time.UTC)
+}
@@ -0,0 +1,117 @@
+package service_test
+
+import (
+	"Kurajj/internal/models"
+	service "Kurajj/internal/services"
+	mock_service "Kurajj/internal/services/mocks"
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestRunJobKeepsLastError(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+	repo.EXPECT().IsLeader(context.TODO()).Return(true, nil).Times(2)
+
+	schedule := service.NewSchedule(repo)
+	fail := true
+	err := schedule.AddJob("test-job", "@every 1m", func(ctx context.Context) error {
+		if fail {
+			return errors.New("boom")
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+
+	err = schedule.RunJob(context.TODO(), "test-job")
+	assert.EqualError(t, err, "boom")
+
+	fail = false
+	err = schedule.RunJob(context.TODO(), "test-job")
+	assert.NoError(t, err)
+
+	statuses := schedule.GetJobStatuses(context.TODO())
+	assert.Len(t, statuses, 1)
+	assert.Equal(t, "test-job", statuses[0].Name)
+	assert.Equal(t, 2, statuses[0].Runs)
+	assert.Equal(t, 1, statuses[0].Failures)
+	assert.Equal(t, "boom", statuses[0].LastError)
+	assert.False(t, statuses[0].Running)
+	assert.NotNil(t, statuses[0].LastFinishedAt)
+}
+
+func TestRunJobOnFollower(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+	repo.EXPECT().IsLeader(context.TODO()).Return(false, nil)
+
+	schedule := service.NewSchedule(repo)
+	err := schedule.AddJob("test-job", "@every 1m", func(ctx context.Context) error {
+		t.Fatal("job should run only on the leader")
+		return nil
+	})
+	assert.NoError(t, err)
+
+	err = schedule.RunJob(context.TODO(), "test-job")
+	assert.NoError(t, err)
+
+	statuses := schedule.GetJobStatuses(context.TODO())
+	assert.Equal(t, 0, statuses[0].Runs)
+	assert.Equal(t, 1, statuses[0].Skips)
+	assert.False(t, statuses[0].Running)
+}
+
+func TestRunJobWhenLeaderIsUnknown(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+	repo.EXPECT().IsLeader(context.TODO()).Return(false, errors.New("connection refused"))
+
+	schedule := service.NewSchedule(repo)
+	err := schedule.AddJob("test-job", "@every 1m", func(ctx context.Context) error {
+		t.Fatal("job should not run without a leader")
+		return nil
+	})
+	assert.NoError(t, err)
+
+	err = schedule.RunJob(context.TODO(), "test-job")
+	assert.Error(t, err)
+	assert.Equal(t, 1, schedule.GetJobStatuses(context.TODO())[0].Failures)
+}
+
+func TestRunUnknownJob(t *testing.T) {
+	schedule := service.NewSchedule(nil)
+
+	err := schedule.RunJob(context.TODO(), "missing")
+	assert.ErrorIs(t, err, models.ErrNotFound)
+}
+
+func TestAddJobTwice(t *testing.T) {
+	schedule := service.NewSchedule(nil)
+	job := func(ctx context.Context) error { return nil }
+
+	assert.NoError(t, schedule.AddJob("test-job", "@every 1m", job))
+	assert.Error(t, schedule.AddJob("test-job", "@every 1m", job))
+}
+
+func TestStopResignsLeadership(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+	repo.EXPECT().ResignLeadership(context.TODO())
+
+	schedule := service.NewSchedule(repo)
+	schedule.Start()
+
+	err := schedule.Stop(context.TODO())
+	assert.NoError(t, err)
+}
@@ -6,6 +6,7 @@ import (
 	"Kurajj/internal/repository"
 	"context"
 	"io"
+	"time"
 )
 
 //go:generate mockgen -source=service.go -destination=mocks/service.go
@@ -22,6 +23,17 @@ type Repositorier interface {
 	repository.Notifier
 	repository.HelpEventer
 	repository.Complainer
+	repository.Moderator
+	repository.Waitlister
+	repository.EventTemplater
+	repository.Reporter
+	repository.Quotaer
+	repository.Uniter
+	repository.EventUpdater
+	repository.IntakeLedgerer
+	repository.DropPointer
+	repository.CoOrganizerer
+	repository.LeaderElector
 }
 
 type HelpEventer interface {
@@ -31,9 +43,13 @@ type HelpEventer interface {
 	CreateRequest(ctx context.Context, userID models.ID, transactionInfo models.TransactionAcceptCreateRequest) (uint, error)
 	UpdateTransactionStatus(ctx context.Context, transaction models.HelpEventTransaction, file io.Reader, fileType, createFilePath string) error
 	GetUserHelpEvents(ctx context.Context, userID models.ID) ([]models.HelpEvent, error)
+	GetHelpEvents(ctx context.Context, pagination models.PaginationRequest) (models.HelpEventPagination, error)
+	GetMemberHelpEvents(ctx context.Context, memberID uint, pagination models.PaginationRequest) (models.HelpEventPagination, error)
 	GetHelpEventBySearch(ctx context.Context, search models.HelpSearchInternal) (models.HelpEventPagination, error)
-	UpdateHelpEvent(ctx context.Context, event models.HelpEvent) error
+	UpdateHelpEvent(ctx context.Context, event models.HelpEvent, memberID uint) error
+	DeleteHelpEvent(ctx context.Context, id, memberID uint) error
 	GetHelpEventStatistics(ctx context.Context, fromStart int, creatorID uint) (models.HelpEventStatistics, error)
+	CloneHelpEvent(ctx context.Context, id models.ID, authorID uint, request models.CloneEventRequest) (uint, error)
 }
 
 type ProposalEventer interface {
@@ -41,14 +57,22 @@ type ProposalEventer interface {
 	GetEvent(ctx context.Context, id uint) (models.ProposalEvent, error)
 	GetProposalEventByTransactionID(ctx context.Context, transactionID models.ID) (models.ProposalEvent, error)
 	GetEvents(ctx context.Context) ([]models.ProposalEvent, error)
-	UpdateProposalEvent(ctx context.Context, event models.ProposalEvent) error
+	UpdateProposalEvent(ctx context.Context, event models.ProposalEvent, memberID uint) error
 	DeleteEvent(ctx context.Context, id uint) error
-	Response(ctx context.Context, proposalEventID, responderID uint, comment string) error
+	Response(ctx context.Context, proposalEventID, responderID, timeSlotID uint, comment string,
+		answers models.QuestionnaireAnswers) error
 	Accept(ctx context.Context, request models.AcceptRequest) error
 	UpdateStatus(ctx context.Context, status models.TransactionStatus, transactionID, userID uint, file io.Reader, fileType, filePath string) error
 	GetUserProposalEvents(ctx context.Context, userID uint) ([]models.ProposalEvent, error)
 	GetProposalEventBySearch(ctx context.Context, search models.ProposalEventSearchInternal) (models.ProposalEventPagination, error)
+	GetMemberProposalEvents(ctx context.Context, memberID uint, pagination models.PaginationRequest) (models.ProposalEventPagination, error)
 	GetProposalEventStatistics(ctx context.Context, fromStart int, creatorID uint) (models.ProposalEventStatistics, error)
+	JoinWaitlist(ctx context.Context, proposalEventID, memberID uint, comment string,
+		answers models.QuestionnaireAnswers) (models.WaitlistJoinResponse, error)
+	LeaveWaitlist(ctx context.Context, proposalEventID, memberID uint) error
+	ConfirmWaitlistSlot(ctx context.Context, proposalEventID, memberID uint) error
+	GetWaitlist(ctx context.Context, proposalEventID uint) ([]models.WaitlistEntry, error)
+	CloneEvent(ctx context.Context, id, authorID uint, request models.CloneEventRequest) (uint, error)
 }
 
 type AdminCRUDer interface {
@@ -80,7 +104,7 @@ type Commenter interface {
 }
 
 type Tagger interface {
-	UpsertTags(ctx context.Context, eventID uint, eventType models.EventType, tags []models.Tag) error
+	UpsertTags(ctx context.Context, eventID uint, eventType models.EventType, memberID uint, tags []models.Tag) error
 	GetTagsByEvent(ctx context.Context, eventID uint, eventType models.EventType) ([]models.Tag, error)
 }
 
@@ -106,6 +130,70 @@ type Complainer interface {
 	BanEvent(ctx context.Context, eventID models.ID, eventType models.EventType) error
 }
 
+type Moderator interface {
+	GetModerationQueue(ctx context.Context) ([]models.ModerationItem, error)
+	ResolveModerationItem(ctx context.Context, id, adminID uint, approve bool) error
+	GetBlockedWords(ctx context.Context) ([]models.BlockedWord, error)
+	CreateBlockedWord(ctx context.Context, word models.BlockedWord) (uint, error)
+	DeleteBlockedWord(ctx context.Context, id uint) error
+}
+
+type EventTemplater interface {
+	CreateEventTemplate(ctx context.Context, template models.EventTemplate) (uint, error)
+	GetMemberEventTemplates(ctx context.Context, memberID uint) ([]models.EventTemplate, error)
+	DeleteEventTemplate(ctx context.Context, id, memberID uint) error
+	InstantiateEventTemplate(ctx context.Context, id, memberID uint, overrides []byte) (uint, error)
+}
+
+type Reporter interface {
+	AddTransactionReport(ctx context.Context, transactionID, memberID uint, file io.Reader, fileType, filePath string) (uint, error)
+	GetProposalEventReports(ctx context.Context, eventID, memberID uint) ([]models.Report, error)
+	GetReportFile(ctx context.Context, id, memberID uint) (models.Report, io.Reader, error)
+}
+
+type Quotaer interface {
+	GetQuotaPolicies(ctx context.Context) ([]models.QuotaPolicy, error)
+	SaveQuotaPolicy(ctx context.Context, policy models.QuotaPolicy) (uint, error)
+	DeleteMemberQuotaPolicy(ctx context.Context, memberID uint) error
+	SetVerifiedOrganization(ctx context.Context, memberID uint, verified bool) error
+	GetMemberQuota(ctx context.Context, memberID uint) (models.MemberQuota, error)
+}
+
+type Uniter interface {
+	GetUnits(ctx context.Context) ([]models.UnitDefinition, error)
+}
+
+type EventUpdater interface {
+	PostEventUpdate(ctx context.Context, eventID uint, eventType models.EventType, authorID uint,
+		request models.EventUpdateCreateRequest) (uint, error)
+	DeleteEventUpdate(ctx context.Context, id, memberID uint) error
+	FollowEvent(ctx context.Context, eventID uint, eventType models.EventType, memberID uint) error
+	UnfollowEvent(ctx context.Context, eventID uint, eventType models.EventType, memberID uint) error
+}
+
+type IntakeLedgerer interface {
+	GetIntakeLedger(ctx context.Context, eventID, memberID uint) ([]models.IntakeEntry, error)
+	CorrectIntake(ctx context.Context, eventID, memberID uint, correction models.IntakeCorrectionRequest) error
+	ReverseIntake(ctx context.Context, eventID, entryID, memberID uint, comment string) error
+}
+
+type DropPointer interface {
+	AddDropPoint(ctx context.Context, eventID, memberID uint, point models.DropPoint) (uint, error)
+	DeleteDropPoint(ctx context.Context, eventID, pointID, memberID uint) error
+	GetDropPointArrivals(ctx context.Context, eventID, pointID, memberID uint, day time.Time) ([]models.DropPointArrival, error)
+}
+
+type CoOrganizerer interface {
+	AddCoOrganizer(ctx context.Context, eventID uint, eventType models.EventType, authorID uint,
+		request models.CoOrganizerRequest) error
+	RemoveCoOrganizer(ctx context.Context, eventID uint, eventType models.EventType, memberID, requesterID uint) error
+}
+
+type Scheduler interface {
+	GetJobStatuses(ctx context.Context) []models.JobStatus
+	Stop(ctx context.Context) error
+}
+
 type Service struct {
 	Authenticator
 	AdminCRUDer
@@ -118,24 +206,50 @@ type Service struct {
 	HelpEventer
 	Filer
 	Complainer
+	Moderator
+	EventTemplater
+	Reporter
+	Quotaer
+	Uniter
+	EventUpdater
+	IntakeLedgerer
+	DropPointer
+	CoOrganizerer
+	Scheduler
 }
 
 func New(repo Repositorier,
 	authConfig *configs.AuthenticationConfig,
 	emailConfig *configs.Email,
 	messageConfig *configs.MessageConfirm,
+	schedulerConfig *configs.Scheduler,
 ) *Service {
+	proposalEvent := NewProposalEvent(repo)
+	helpEvent := NewHelpEvent(repo)
+	helpEvent.now = jobClock(schedulerConfig)
+	schedule := newEventSchedule(repo, schedulerConfig, proposalEvent, helpEvent)
+	schedule.Start()
 	return &Service{
 		NewAuthentication(repo, authConfig, emailConfig, messageConfig),
 		NewAdmin(repo, authConfig, emailConfig),
-		NewProposalEvent(repo),
+		proposalEvent,
 		NewTransaction(repo),
 		NewComment(repo),
 		NewTag(repo),
 		NewUserSearch(repo),
 		NewTransactionNotification(repo),
-		NewHelpEvent(repo),
+		helpEvent,
 		NewFile(repo),
 		NewComplaint(repo),
+		NewModeration(repo),
+		NewEventTemplate(repo, proposalEvent, helpEvent),
+		NewReport(repo),
+		NewQuota(repo),
+		NewUnit(repo),
+		NewEventUpdate(repo),
+		helpEvent,
+		helpEvent,
+		NewCoOrganizer(repo),
+		schedule,
 	}
 }
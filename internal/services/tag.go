@@ -3,6 +3,9 @@ package service
 import (
 	"Kurajj/internal/models"
 	"context"
+	"errors"
+	"fmt"
+	"gorm.io/gorm"
 )
 
 func NewTag(repo Repositorier) *Tag {
@@ -19,6 +22,44 @@ func (t *Tag) GetTagsByEvent(ctx context.Context, eventID uint, eventType models
 	return t.repo.GetTagsByEvent(ctx, eventID, eventType)
 }
 
-func (t *Tag) UpsertTags(ctx context.Context, eventID uint, eventType models.EventType, tags []models.Tag) error {
+// UpsertTags replaces the tags of the event, only its author and editors can do it.
+func (t *Tag) UpsertTags(ctx context.Context, eventID uint, eventType models.EventType, memberID uint,
+	tags []models.Tag) error {
+	err := t.checkEventEditor(ctx, eventID, eventType, memberID)
+	if err != nil {
+		return err
+	}
+
 	return t.repo.UpsertTags(ctx, eventType, eventID, tags)
 }
+
+func (t *Tag) checkEventEditor(ctx context.Context, eventID uint, eventType models.EventType, memberID uint) error {
+	organizes := false
+	switch eventType {
+	case models.HelpEventType:
+		event, err := t.repo.GetEventByID(ctx, models.ID(eventID))
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.ErrNotFound
+		}
+		if err != nil {
+			return err
+		}
+		organizes = event.Organizes(memberID, models.EditorRole)
+	case models.ProposalEventType:
+		event, err := t.repo.GetEvent(ctx, eventID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.ErrNotFound
+		}
+		if err != nil {
+			return err
+		}
+		organizes = event.Organizes(memberID, models.EditorRole)
+	default:
+		return fmt.Errorf("%w: unknown event type %s", models.ErrInvalidTag, eventType)
+	}
+	if !organizes {
+		return models.ErrNotFound
+	}
+
+	return nil
+}
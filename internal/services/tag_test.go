@@ -0,0 +1,64 @@
+package service_test
+
+import (
+	"Kurajj/internal/models"
+	service "Kurajj/internal/services"
+	mock_service "Kurajj/internal/services/mocks"
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var taggedHelpEvent = models.HelpEvent{
+	ID:        1,
+	CreatedBy: 2,
+	CoOrganizers: []models.EventCoOrganizer{
+		{MemberID: 3, Role: models.EditorRole},
+		{MemberID: 4, Role: models.TransactionManagerRole},
+	},
+}
+
+func TestUpsertTagsByEditor(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+	tags := []models.Tag{{Title: "needs", EventID: 1, EventType: models.HelpEventType,
+		Values: []models.TagValue{{Value: "water"}}}}
+
+	repo.EXPECT().
+		GetEventByID(context.TODO(), models.ID(1)).
+		Return(taggedHelpEvent, nil).
+		Times(2)
+	repo.EXPECT().
+		UpsertTags(context.TODO(), models.EventType(models.HelpEventType), uint(1), tags).
+		Times(2)
+
+	tag := service.NewTag(repo)
+
+	assert.NoError(t, tag.UpsertTags(context.TODO(), 1, models.HelpEventType, 2, tags))
+	assert.NoError(t, tag.UpsertTags(context.TODO(), 1, models.HelpEventType, 3, tags))
+}
+
+func TestUpsertTagsOfAnotherMembersEvent(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().
+		GetEventByID(context.TODO(), models.ID(1)).
+		Return(taggedHelpEvent, nil).
+		Times(2)
+	repo.EXPECT().
+		GetEvent(context.TODO(), uint(7)).
+		Return(models.ProposalEvent{ID: 7, AuthorID: 2}, nil)
+
+	tag := service.NewTag(repo)
+
+	assert.ErrorIs(t, tag.UpsertTags(context.TODO(), 1, models.HelpEventType, 4, nil), models.ErrNotFound)
+	assert.ErrorIs(t, tag.UpsertTags(context.TODO(), 1, models.HelpEventType, 5, nil), models.ErrNotFound)
+	assert.ErrorIs(t, tag.UpsertTags(context.TODO(), 7, models.ProposalEventType, 5, nil), models.ErrNotFound)
+	assert.ErrorIs(t, tag.UpsertTags(context.TODO(), 1, "unknown", 2, nil), models.ErrInvalidTag)
+}
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"Kurajj/internal/models"
+	"context"
+	"fmt"
+	"time"
+)
+
+// timeSlotReminderLead is how long before the time slot its participants are reminded.
+const timeSlotReminderLead = time.Hour
+
+// prepareTimeSlots validates time slots of a new event. The event's capacity becomes the sum
+// of the slots' capacities, so its free slots keep matching the free places of time slots.
+func prepareTimeSlots(event *models.ProposalEvent) error {
+	if len(event.TimeSlots) == 0 {
+		return nil
+	}
+	if event.RecurrenceRule != "" {
+		return fmt.Errorf("%w: recurring events cannot have time slots", models.ErrInvalidTimeSlot)
+	}
+
+	capacity := 0
+	for _, timeSlot := range event.TimeSlots {
+		if timeSlot.Capacity <= 0 {
+			return fmt.Errorf("%w: capacity should be positive", models.ErrInvalidTimeSlot)
+		}
+		if !timeSlot.StartTime.Before(timeSlot.EndTime) {
+			return fmt.Errorf("%w: it should start before it ends", models.ErrInvalidTimeSlot)
+		}
+		if !event.EndDate.IsZero() && timeSlot.StartTime.After(event.EndDate) {
+			return fmt.Errorf("%w: it should start before the event ends", models.ErrInvalidTimeSlot)
+		}
+		capacity += timeSlot.Capacity
+	}
+	event.MaxConcurrentRequests = uint(capacity)
+	event.RemainingHelps = capacity
+
+	return nil
+}
+
+// checkTimeSlot makes sure the responder chose a time slot with free places when the event has time slots.
+func checkTimeSlot(event models.ProposalEvent, timeSlotID uint) error {
+	if len(event.TimeSlots) == 0 {
+		if timeSlotID != 0 {
+			return fmt.Errorf("%w: the event has no time slots", models.ErrInvalidTimeSlot)
+		}
+		return nil
+	}
+
+	for _, timeSlot := range event.TimeSlots {
+		if timeSlot.ID != timeSlotID {
+			continue
+		}
+		if timeSlot.Available() == 0 {
+			return models.ErrTimeSlotFull
+		}
+		return nil
+	}
+
+	return fmt.Errorf("%w: choose one of the event's time slots", models.ErrInvalidTimeSlot)
+}
+
+// remindTimeSlots notifies both parties of every booking whose time slot starts within timeSlotReminderLead.
+func (p *ProposalEvent) remindTimeSlots(ctx context.Context, now time.Time) error {
+	bookings, err := p.repo.GetTimeSlotBookingsToRemind(ctx, now, now.Add(timeSlotReminderLead))
+	if err != nil {
+		return err
+	}
+
+	var lastErr error
+	for _, booking := range bookings {
+		err = p.remindTimeSlot(ctx, booking)
+		if err != nil {
+			lastErr = fmt.Errorf("transaction %d: %w", booking.TransactionID, err)
+		}
+	}
+	return lastErr
+}
+
+func (p *ProposalEvent) remindTimeSlot(ctx context.Context, booking models.TimeSlotBooking) error {
+	marked, err := p.repo.MarkTimeSlotReminded(ctx, booking.TransactionID)
+	if err != nil || !marked {
+		return err
+	}
+	coOrganizers, err := p.repo.GetEventCoOrganizers(ctx, booking.EventID, models.ProposalEventType)
+	if err != nil {
+		return err
+	}
+
+	notification := models.TransactionNotification{
+		EventType:     models.ProposalEventType,
+		EventID:       booking.EventID,
+		Action:        models.TimeSlotReminder,
+		TransactionID: booking.TransactionID,
+		IsRead:        false,
+		CreationTime:  time.Now(),
+		MemberID:      booking.ResponderID,
+		Summary:       fmt.Sprintf("starts at %s", booking.StartTime.Format("2006-01-02 15:04")),
+	}
+	err = p.createNotification(ctx, notification)
+	if err != nil {
+		return err
+	}
+
+	return notifyOrganizers(ctx, p.repo, notification, booking.AuthorID, coOrganizers)
+}
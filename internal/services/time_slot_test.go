@@ -0,0 +1,172 @@
+package service_test
+
+import (
+	"Kurajj/internal/models"
+	service "Kurajj/internal/services"
+	mock_service "Kurajj/internal/services/mocks"
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestCreateProposalEventWithTimeSlots(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	start := time.Date(2026, 11, 2, 10, 0, 0, 0, time.UTC)
+	proposalEvent := models.ProposalEvent{
+		Title:    "Consultations",
+		AuthorID: 1,
+		Status:   models.InActive,
+		EndDate:  start.AddDate(0, 0, 7),
+		TimeSlots: []models.TimeSlot{
+			{StartTime: start, EndTime: start.Add(time.Hour), Capacity: 2},
+			{StartTime: start.Add(time.Hour), EndTime: start.Add(2 * time.Hour), Capacity: 3},
+		},
+	}
+
+	repo.EXPECT().
+		GetBlockedWords(context.TODO()).Return([]models.BlockedWord{}, nil)
+	repo.EXPECT().
+		GetUserRecentTexts(context.TODO(), proposalEvent.AuthorID, gomock.Any()).Return([]string{}, nil)
+	repo.EXPECT().
+		CreateProposalEvent(context.TODO(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, event models.ProposalEvent) (uint, error) {
+			assert.Equal(t, uint(5), event.MaxConcurrentRequests)
+			assert.Equal(t, 5, event.RemainingHelps)
+			assert.Len(t, event.TimeSlots, 2)
+			return 1, nil
+		})
+
+	proposalEventService := service.NewProposalEvent(repo)
+
+	_, err := proposalEventService.CreateEvent(context.TODO(), proposalEvent)
+	assert.NoError(t, err)
+}
+
+func TestCreateProposalEventWithInvalidTimeSlot(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	start := time.Date(2026, 11, 2, 10, 0, 0, 0, time.UTC)
+	proposalEvent := models.ProposalEvent{
+		Title:    "Consultations",
+		AuthorID: 1,
+		Status:   models.InActive,
+		TimeSlots: []models.TimeSlot{
+			{StartTime: start, EndTime: start.Add(-time.Hour), Capacity: 2},
+		},
+	}
+
+	proposalEventService := service.NewProposalEvent(repo)
+
+	_, err := proposalEventService.CreateEvent(context.TODO(), proposalEvent)
+	assert.ErrorIs(t, err, models.ErrInvalidTimeSlot)
+}
+
+func TestResponseToTimeSlot(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().
+		GetEvent(context.TODO(), uint(1)).
+		Return(models.ProposalEvent{
+			ID:             1,
+			AuthorID:       2,
+			Status:         models.Active,
+			RemainingHelps: 3,
+			TimeSlots: []models.TimeSlot{
+				{ID: 7, EventID: 1, Capacity: 1, Booked: 1},
+				{ID: 8, EventID: 1, Capacity: 2},
+			},
+		}, nil).
+		Times(2)
+	repo.EXPECT().
+		GetMemberQuotaPolicies(context.TODO(), uint(3)).
+		Return([]models.QuotaPolicy{}, nil).
+		Times(2)
+	repo.EXPECT().
+		CountPendingResponses(context.TODO(), uint(3)).
+		Return(0, nil).
+		Times(2)
+	repo.EXPECT().
+		CreateTransactionWithSlot(context.TODO(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, transaction models.Transaction) (uint, error) {
+			assert.True(t, transaction.TimeSlotID.Valid)
+			assert.Equal(t, int64(8), transaction.TimeSlotID.Int64)
+			return 1, nil
+		})
+	repo.EXPECT().
+		CreateNotification(context.TODO(), gomock.Any())
+
+	proposalEventService := service.NewProposalEvent(repo)
+
+	err := proposalEventService.Response(context.TODO(), 1, 3, 7, "", nil)
+	assert.ErrorIs(t, err, models.ErrTimeSlotFull)
+
+	err = proposalEventService.Response(context.TODO(), 1, 3, 8, "", nil)
+	assert.NoError(t, err)
+}
+
+func TestResponseWithoutTimeSlot(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().
+		GetEvent(context.TODO(), uint(1)).
+		Return(models.ProposalEvent{
+			ID:             1,
+			AuthorID:       2,
+			Status:         models.Active,
+			RemainingHelps: 1,
+			TimeSlots:      []models.TimeSlot{{ID: 7, EventID: 1, Capacity: 1}},
+		}, nil)
+	repo.EXPECT().
+		GetMemberQuotaPolicies(context.TODO(), uint(3)).
+		Return([]models.QuotaPolicy{}, nil)
+	repo.EXPECT().
+		CountPendingResponses(context.TODO(), uint(3)).
+		Return(0, nil)
+
+	proposalEventService := service.NewProposalEvent(repo)
+
+	err := proposalEventService.Response(context.TODO(), 1, 3, 0, "", nil)
+	assert.ErrorIs(t, err, models.ErrInvalidTimeSlot)
+}
+
+func TestUpdateCapacityOfProposalEventWithTimeSlots(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	startTime := time.Date(2026, 12, 1, 10, 0, 0, 0, time.UTC)
+	repo.EXPECT().
+		GetEvent(context.TODO(), uint(1)).
+		Return(models.ProposalEvent{
+			ID:                    1,
+			AuthorID:              2,
+			Status:                models.Active,
+			MaxConcurrentRequests: 2,
+			RemainingHelps:        2,
+			TimeSlots: []models.TimeSlot{
+				{ID: 3, EventID: 1, StartTime: startTime, EndTime: startTime.Add(time.Hour), Capacity: 2},
+			},
+		}, nil)
+
+	proposalEventService := service.NewProposalEvent(repo)
+
+	err := proposalEventService.UpdateProposalEvent(context.TODO(),
+		models.ProposalEvent{ID: 1, MaxConcurrentRequests: 5}, 2)
+	assert.ErrorIs(t, err, models.ErrInvalidTimeSlot)
+}
@@ -23,6 +23,10 @@ func (t *Transaction) GetGlobalStatistics(ctx context.Context, fromStart int) (m
 	}
 
 	statistics := t.generateStatistics(currentTransactions, previousTransactions)
+	statistics.MoneyRaised, err = t.repo.GetMoneyRaised(ctx, 0, statistics.StartDate, statistics.EndDate)
+	if err != nil {
+		return models.GlobalStatistics{}, err
+	}
 	return statistics, nil
 }
 
@@ -153,6 +157,63 @@ func (t *Transaction) GetAllEventTransactions(ctx context.Context,
 	return t.repo.GetAllEventTransactions(ctx, eventID, eventType)
 }
 
+// settleExpiredEvent interrupts unfinished transactions of the expired event, gives their slots and pledges back,
+// notifies both parties of every interrupted transaction and sends the closing summary to the organizers.
+func (t *Transaction) settleExpiredEvent(ctx context.Context, eventID uint, eventType models.EventType, authorID uint) error {
+	transactions, err := t.repo.GetCurrentEventTransactions(ctx, eventID, eventType)
+	if err != nil {
+		return err
+	}
+	coOrganizers, err := t.repo.GetEventCoOrganizers(ctx, eventID, eventType)
+	if err != nil {
+		return err
+	}
+
+	err = t.repo.UpdateAllNotFinishedTransactions(ctx, eventID, eventType, models.Interrupted)
+	if err != nil {
+		return err
+	}
+
+	for _, transaction := range transactions {
+		if eventType == models.ProposalEventType {
+			_, err = t.repo.ReleaseTransactionSlot(ctx, transaction.ID)
+		} else {
+			_, err = t.repo.ReleaseTransactionPledges(ctx, transaction.ID)
+		}
+		if err != nil {
+			return err
+		}
+
+		notification := models.TransactionNotification{
+			EventType:     eventType,
+			EventID:       eventID,
+			Action:        models.Updated,
+			TransactionID: transaction.ID,
+			NewStatus:     models.Interrupted,
+			IsRead:        false,
+			CreationTime:  time.Now(),
+			MemberID:      transaction.CreatorID,
+		}
+		_, err = t.repo.CreateNotification(ctx, notification)
+		if err != nil {
+			return err
+		}
+		err = notifyOrganizers(ctx, t.repo, notification, authorID, coOrganizers)
+		if err != nil {
+			return err
+		}
+	}
+
+	return notifyOrganizers(ctx, t.repo, models.TransactionNotification{
+		EventType:    eventType,
+		EventID:      eventID,
+		Action:       models.EventExpired,
+		IsRead:       false,
+		CreationTime: time.Now(),
+		Summary:      fmt.Sprintf("%d unfinished transactions were interrupted.", len(transactions)),
+	}, authorID, coOrganizers)
+}
+
 func NewTransaction(repo Repositorier) *Transaction {
 	return &Transaction{repo: repo}
 }
@@ -0,0 +1,137 @@
+package service_test
+
+import (
+	"Kurajj/internal/models"
+	service "Kurajj/internal/services"
+	mock_service "Kurajj/internal/services/mocks"
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestExpireProposalEventInterruptsTransactions(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+	now := time.Date(2026, 10, 8, 12, 0, 0, 0, time.UTC)
+
+	repo.EXPECT().
+		GetExpiredProposalEvents(context.TODO(), now, uint(0), 10).
+		Return([]models.ProposalEvent{{ID: 1, AuthorID: 2, Status: models.Active}}, nil)
+	repo.EXPECT().
+		UpdateEvent(context.TODO(), models.ProposalEvent{ID: 1, Status: models.Done})
+	repo.EXPECT().
+		GetCurrentEventTransactions(context.TODO(), uint(1), models.ProposalEventType).
+		Return([]models.Transaction{
+			{ID: 5, CreatorID: 3, EventID: 1, TransactionStatus: models.InProcess},
+			{ID: 6, CreatorID: 4, EventID: 1, TransactionStatus: models.Waiting},
+		}, nil)
+	repo.EXPECT().
+		GetEventCoOrganizers(context.TODO(), uint(1), models.ProposalEventType).
+		Return([]models.EventCoOrganizer{{EventID: 1, MemberID: 7, Role: models.TransactionManagerRole}}, nil)
+	repo.EXPECT().
+		UpdateAllNotFinishedTransactions(context.TODO(), uint(1), models.ProposalEventType, models.Interrupted)
+	repo.EXPECT().
+		ReleaseTransactionSlot(context.TODO(), uint(5)).Return(true, nil)
+	repo.EXPECT().
+		ReleaseTransactionSlot(context.TODO(), uint(6)).Return(true, nil)
+	repo.EXPECT().
+		ExpireWaitlist(context.TODO(), uint(1))
+
+	notified := make(map[uint][]models.TransactionNotification)
+	repo.EXPECT().
+		CreateNotification(context.TODO(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, notification models.TransactionNotification) (uint, error) {
+			notified[notification.MemberID] = append(notified[notification.MemberID], notification)
+			return 1, nil
+		}).
+		Times(8)
+
+	err := service.ExpireProposalEvents(service.NewProposalEvent(repo), context.TODO(), now, 10)
+	assert.NoError(t, err)
+
+	for _, responderID := range []uint{3, 4} {
+		assert.Len(t, notified[responderID], 1)
+		assert.Equal(t, models.Interrupted, notified[responderID][0].NewStatus)
+	}
+	for _, organizerID := range []uint{2, 7} {
+		assert.Len(t, notified[organizerID], 3)
+		assert.Equal(t, []uint{5, 6}, []uint{notified[organizerID][0].TransactionID, notified[organizerID][1].TransactionID})
+		assert.Equal(t, models.EventExpired, notified[organizerID][2].Action)
+		assert.Equal(t, "2 unfinished transactions were interrupted.", notified[organizerID][2].Summary)
+	}
+}
+
+func TestExpireHelpEventInterruptsTransactions(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+	now := time.Date(2026, 10, 8, 12, 0, 0, 0, time.UTC)
+
+	repo.EXPECT().
+		GetExpiredHelpEvents(context.TODO(), now, models.ID(0), 10).
+		Return([]models.HelpEvent{{ID: 1, CreatedBy: 2, Status: models.Active}}, nil)
+	repo.EXPECT().
+		UpdateHelpEvent(context.TODO(), models.HelpEvent{ID: 1, Status: models.Done})
+	repo.EXPECT().
+		GetCurrentEventTransactions(context.TODO(), uint(1), models.EventType(models.HelpEventType)).
+		Return([]models.Transaction{{ID: 5, CreatorID: 3, EventID: 1, TransactionStatus: models.InProcess}}, nil)
+	repo.EXPECT().
+		GetEventCoOrganizers(context.TODO(), uint(1), models.EventType(models.HelpEventType))
+	repo.EXPECT().
+		UpdateAllNotFinishedTransactions(context.TODO(), uint(1), models.EventType(models.HelpEventType), models.Interrupted)
+	repo.EXPECT().
+		ReleaseTransactionPledges(context.TODO(), uint(5)).Return(true, nil)
+
+	notified := make(map[uint][]models.TransactionNotification)
+	repo.EXPECT().
+		CreateNotification(context.TODO(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, notification models.TransactionNotification) (uint, error) {
+			notified[notification.MemberID] = append(notified[notification.MemberID], notification)
+			return 1, nil
+		}).
+		Times(3)
+
+	err := service.ExpireHelpEvents(service.NewHelpEvent(repo), context.TODO(), now, 10)
+	assert.NoError(t, err)
+
+	assert.Len(t, notified[3], 1)
+	assert.Equal(t, models.EventType(models.HelpEventType), notified[3][0].EventType)
+	assert.Equal(t, models.Interrupted, notified[3][0].NewStatus)
+	assert.Len(t, notified[2], 2)
+	assert.Equal(t, uint(5), notified[2][0].TransactionID)
+	assert.Equal(t, "1 unfinished transactions were interrupted.", notified[2][1].Summary)
+}
+
+func TestExpireHelpEventReleasesPledgesOnce(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+	now := time.Date(2026, 10, 8, 12, 0, 0, 0, time.UTC)
+
+	repo.EXPECT().
+		GetExpiredHelpEvents(context.TODO(), now, models.ID(0), 10).
+		Return([]models.HelpEvent{{ID: 1, CreatedBy: 2, Status: models.Active}}, nil)
+	repo.EXPECT().
+		UpdateHelpEvent(context.TODO(), models.HelpEvent{ID: 1, Status: models.Done})
+	repo.EXPECT().
+		GetCurrentEventTransactions(context.TODO(), uint(1), models.EventType(models.HelpEventType)).
+		Return([]models.Transaction{{ID: 5, CreatorID: 3, EventID: 1, TransactionStatus: models.InProcess, SlotReleased: true}}, nil)
+	repo.EXPECT().
+		GetEventCoOrganizers(context.TODO(), uint(1), models.EventType(models.HelpEventType))
+	repo.EXPECT().
+		UpdateAllNotFinishedTransactions(context.TODO(), uint(1), models.EventType(models.HelpEventType), models.Interrupted)
+	repo.EXPECT().
+		ReleaseTransactionPledges(context.TODO(), uint(5)).Return(false, nil)
+	repo.EXPECT().
+		CreateNotification(context.TODO(), gomock.Any()).
+		Times(3)
+
+	err := service.ExpireHelpEvents(service.NewHelpEvent(repo), context.TODO(), now, 10)
+	assert.NoError(t, err)
+}
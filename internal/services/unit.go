@@ -0,0 +1,48 @@
+package service
+
+import (
+	"Kurajj/internal/models"
+	"context"
+)
+
+func NewUnit(repo Repositorier) *Unit {
+	return &Unit{repo: repo}
+}
+
+type Unit struct {
+	repo Repositorier
+}
+
+func (u *Unit) GetUnits(ctx context.Context) ([]models.UnitDefinition, error) {
+	return u.repo.GetUnits(ctx)
+}
+
+func (u *Unit) registry(ctx context.Context) (models.UnitRegistry, error) {
+	units, err := u.repo.GetUnits(ctx)
+	if err != nil {
+		return models.UnitRegistry{}, err
+	}
+
+	return models.NewUnitRegistry(units), nil
+}
+
+// normalizeNeeds converts the amounts of the event needs to the base units of their dimensions,
+// pledges and receipts in any unit of the dimension are converted to them later.
+func (u *Unit) normalizeNeeds(ctx context.Context, needs []models.Need) error {
+	if len(needs) == 0 {
+		return nil
+	}
+	registry, err := u.registry(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i := range needs {
+		needs[i].Amount, needs[i].Unit, err = registry.ToBase(needs[i].Amount, needs[i].Unit)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
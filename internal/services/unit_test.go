@@ -0,0 +1,79 @@
+package service_test
+
+import (
+	"Kurajj/internal/models"
+	service "Kurajj/internal/services"
+	mock_service "Kurajj/internal/services/mocks"
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var seededUnits = []models.UnitDefinition{
+	{Code: models.Kilogram, Title: "kilogram", Dimension: models.Mass, Factor: 1, IsBase: true},
+	{Code: "gram", Title: "gram", Dimension: models.Mass, Factor: 0.001},
+	{Code: models.Liter, Title: "liter", Dimension: models.Volume, Factor: 1, IsBase: true},
+	{Code: "milliliter", Title: "milliliter", Dimension: models.Volume, Factor: 0.001},
+	{Code: models.Item, Title: "item", Dimension: models.Count, Factor: 1, IsBase: true},
+	{Code: "dozen", Title: "box of 12", Dimension: models.Count, Factor: 12},
+	{Code: models.MoneyUnit, Title: "minor units of the currency", Dimension: models.Money, Factor: 1, IsBase: true},
+}
+
+func TestCreateHelpEventConvertsNeedsToBaseUnits(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().
+		GetUnits(context.TODO()).
+		Return(seededUnits, nil)
+	repo.EXPECT().
+		GetBlockedWords(context.TODO()).Return([]models.BlockedWord{}, nil)
+	repo.EXPECT().
+		GetUserRecentTexts(context.TODO(), uint(2), gomock.Any()).Return([]string{}, nil)
+	repo.EXPECT().
+		CreateEvent(context.TODO(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, event *models.HelpEvent) (uint, error) {
+			assert.Equal(t, []models.Need{
+				{Title: "Flour", Type: models.GoodsNeed, Amount: 0.5, Unit: models.Kilogram},
+				{Title: "Eggs", Type: models.GoodsNeed, Amount: 36, Unit: models.Item},
+			}, event.Needs)
+			return 1, nil
+		})
+
+	helpEventService := service.NewHelpEvent(repo)
+
+	_, err := helpEventService.CreateHelpEvent(context.TODO(), &models.HelpEvent{
+		Title:     "Bakery",
+		CreatedBy: 2,
+		Status:    models.InActive,
+		Needs: []models.Need{
+			{Title: "Flour", Amount: 500, Unit: "gram"},
+			{Title: "Eggs", Amount: 3, Unit: "dozen"},
+		},
+	})
+	assert.NoError(t, err)
+}
+
+func TestCreateHelpEventWithUnknownUnit(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().
+		GetUnits(context.TODO()).
+		Return(seededUnits, nil)
+
+	helpEventService := service.NewHelpEvent(repo)
+
+	_, err := helpEventService.CreateHelpEvent(context.TODO(), &models.HelpEvent{
+		Title:     "Bakery",
+		CreatedBy: 2,
+		Status:    models.InActive,
+		Needs:     []models.Need{{Title: "Flour", Amount: 1, Unit: "bag"}},
+	})
+	assert.ErrorIs(t, err, models.ErrInvalidUnit)
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"Kurajj/internal/models"
+	"context"
+	"fmt"
+	"github.com/samber/lo"
+	"strings"
+)
+
+// urgencyChanges validates the new urgencies of the event and of its needs
+// and returns the needs whose urgency changes.
+func urgencyChanges(oldEvent models.HelpEvent, urgency models.Urgency, needs []models.Need) ([]models.Need, error) {
+	err := urgency.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	changed := make([]models.Need, 0, len(needs))
+	for _, need := range needs {
+		err = models.ValidateUrgencies([]models.Urgency{need.Urgency})
+		if err != nil {
+			return nil, err
+		}
+		oldNeed, ok := lo.Find(oldEvent.Needs, func(n models.Need) bool {
+			return n.ID == need.ID
+		})
+		if !ok {
+			return nil, fmt.Errorf("%w: the event has no need %d", models.ErrInvalidUrgency, need.ID)
+		}
+		if oldNeed.Urgency != need.Urgency {
+			changed = append(changed, models.Need{ID: need.ID, Urgency: need.Urgency})
+		}
+	}
+
+	return changed, nil
+}
+
+// notifyUrgencyChanged tells members whose saved search values match the event that its urgency
+// or the urgency of some of its needs changed.
+func (h *HelpEvent) notifyUrgencyChanged(ctx context.Context, event models.HelpEvent, urgency models.Urgency,
+	needs []models.Need) error {
+	summary := fmt.Sprintf("urgency changed to %s", urgency)
+	if urgency == "" || urgency == event.Urgency {
+		titles := make([]string, 0, len(needs))
+		for _, need := range needs {
+			oldNeed, _ := lo.Find(event.Needs, func(n models.Need) bool {
+				return n.ID == need.ID
+			})
+			titles = append(titles, oldNeed.Title)
+		}
+		summary = fmt.Sprintf("urgency of %s changed", strings.Join(titles, ", "))
+	}
+
+	return notifyMatchingMembers(ctx, h.repo, models.TransactionNotification{
+		EventType: models.HelpEventType,
+		EventID:   event.ID,
+		Action:    models.UrgencyChanged,
+		Summary:   summary,
+	}, event.CreatedBy, event.Tags, event.Location)
+}
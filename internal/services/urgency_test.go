@@ -0,0 +1,113 @@
+package service_test
+
+import (
+	"Kurajj/internal/models"
+	service "Kurajj/internal/services"
+	mock_service "Kurajj/internal/services/mocks"
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var urgentHelpEvent = models.HelpEvent{
+	ID:        1,
+	CreatedBy: 2,
+	Status:    models.Active,
+	Urgency:   models.NormalUrgency,
+	Needs: []models.Need{
+		{ID: 10, Title: "Water", Amount: 10, Unit: models.Liter, Urgency: models.NormalUrgency},
+		{ID: 11, Title: "Bread", Amount: 5, Unit: models.Kilogram, Urgency: models.FastUrgency},
+	},
+	Location: models.Address{City: "Kharkiv"},
+}
+
+func TestUpdateHelpEventUrgencyNotifiesSearchMatches(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().
+		GetEventByID(context.TODO(), models.ID(1)).
+		Return(urgentHelpEvent, nil)
+	repo.EXPECT().
+		UpdateHelpEvent(context.TODO(), models.HelpEvent{ID: 1, Urgency: models.VeryFastUrgency})
+	repo.EXPECT().
+		UpdateNeeds(context.TODO(), models.Need{ID: 10, Urgency: models.FastUrgency})
+	repo.EXPECT().
+		GetMembersBySearchValues(context.TODO(), models.EventType(models.HelpEventType), gomock.Any()).
+		Return([]uint{2, 5}, nil)
+	repo.EXPECT().
+		CreateNotification(context.TODO(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, notification models.TransactionNotification) (uint, error) {
+			assert.Equal(t, models.UrgencyChanged, notification.Action)
+			assert.Equal(t, uint(5), notification.MemberID)
+			assert.Equal(t, "urgency changed to very fast", notification.Summary)
+			return 1, nil
+		})
+
+	helpEventService := service.NewHelpEvent(repo)
+
+	err := helpEventService.UpdateHelpEvent(context.TODO(), models.HelpEvent{
+		ID:      1,
+		Urgency: models.VeryFastUrgency,
+		Needs: []models.Need{
+			{ID: 10, Urgency: models.FastUrgency},
+			{ID: 11, Urgency: models.FastUrgency},
+		},
+	}, 2)
+	assert.NoError(t, err)
+}
+
+func TestUpdateHelpEventWithInvalidUrgency(t *testing.T) {
+	tests := []struct {
+		name  string
+		event models.HelpEvent
+	}{
+		{
+			name:  "unknown urgency",
+			event: models.HelpEvent{ID: 1, Urgency: "urgent"},
+		},
+		{
+			name:  "need of another event",
+			event: models.HelpEvent{ID: 1, Needs: []models.Need{{ID: 12, Urgency: models.FastUrgency}}},
+		},
+		{
+			name:  "need without urgency",
+			event: models.HelpEvent{ID: 1, Needs: []models.Need{{ID: 10}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			repo := mock_service.NewMockRepositorier(mockCtrl)
+
+			repo.EXPECT().
+				GetEventByID(context.TODO(), models.ID(1)).
+				Return(urgentHelpEvent, nil)
+
+			helpEventService := service.NewHelpEvent(repo)
+
+			err := helpEventService.UpdateHelpEvent(context.TODO(), tt.event, 2)
+			assert.ErrorIs(t, err, models.ErrInvalidUrgency)
+		})
+	}
+}
+
+func TestSearchHelpEventsWithInvalidUrgency(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+	helpEventService := service.NewHelpEvent(repo)
+
+	_, err := helpEventService.GetHelpEventBySearch(context.TODO(), models.HelpSearchInternal{
+		SortField: models.UrgencySortField,
+		Urgency:   []models.Urgency{"urgent"},
+	})
+	assert.ErrorIs(t, err, models.ErrInvalidUrgency)
+}
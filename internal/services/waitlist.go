@@ -0,0 +1,237 @@
+package service
+
+import (
+	"Kurajj/internal/models"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/samber/lo"
+	"time"
+)
+
+// slotReleasingStatuses are the final transaction statuses, a transaction in one of them
+// does not occupy a slot of the proposal event anymore.
+var slotReleasingStatuses = []models.TransactionStatus{
+	models.Completed,
+	models.Canceled,
+	models.Interrupted,
+	models.Aborted,
+}
+
+// waitlistConfirmWindow is how long a promoted member has to confirm the offered slot
+// before it goes to the next member in the waitlist.
+const waitlistConfirmWindow = 24 * time.Hour
+
+func (p *ProposalEvent) JoinWaitlist(ctx context.Context, proposalEventID, memberID uint, comment string,
+	answers models.QuestionnaireAnswers) (models.WaitlistJoinResponse, error) {
+	proposalEvent, err := p.repo.GetEvent(ctx, proposalEventID)
+	if err != nil {
+		return models.WaitlistJoinResponse{}, err
+	}
+	if proposalEvent.AuthorID == memberID {
+		return models.WaitlistJoinResponse{}, fmt.Errorf("event creator cannot response his/her own events")
+	}
+	if proposalEvent.Status == models.InActive {
+		return models.WaitlistJoinResponse{}, models.ErrNotFound
+	}
+	if proposalEvent.Status != models.Active {
+		return models.WaitlistJoinResponse{}, fmt.Errorf("waitlist of the event in %s state is closed", proposalEvent.Status)
+	}
+	if hasActiveTransaction(proposalEvent, memberID) {
+		return models.WaitlistJoinResponse{}, fmt.Errorf("user already has transaction in this event")
+	}
+	if proposalEvent.RemainingHelps > 0 {
+		return models.WaitlistJoinResponse{}, fmt.Errorf("event has free slots, response to it directly")
+	}
+	err = proposalEvent.Questionnaire.CheckAnswers(answers)
+	if err != nil {
+		return models.WaitlistJoinResponse{}, err
+	}
+
+	_, err = p.repo.GetMemberWaitlistEntry(ctx, proposalEventID, memberID)
+	if err == nil {
+		return models.WaitlistJoinResponse{}, fmt.Errorf("user is already in the waitlist of this event")
+	}
+	if !errors.Is(err, models.ErrNotFound) {
+		return models.WaitlistJoinResponse{}, err
+	}
+
+	id, err := p.repo.AddToWaitlist(ctx, models.WaitlistEntry{
+		EventID:      proposalEventID,
+		MemberID:     memberID,
+		Comment:      comment,
+		Status:       models.WaitlistWaiting,
+		CreationDate: time.Now(),
+		Answers:      answers,
+	})
+	if err != nil {
+		return models.WaitlistJoinResponse{}, err
+	}
+
+	waitlist, err := p.repo.GetWaitlist(ctx, proposalEventID)
+	if err != nil {
+		return models.WaitlistJoinResponse{}, err
+	}
+	_, position, _ := lo.FindIndexOf(waitlist, func(entry models.WaitlistEntry) bool {
+		return entry.ID == id
+	})
+
+	return models.WaitlistJoinResponse{ID: id, Position: position + 1}, nil
+}
+
+func (p *ProposalEvent) LeaveWaitlist(ctx context.Context, proposalEventID, memberID uint) error {
+	entry, err := p.repo.GetMemberWaitlistEntry(ctx, proposalEventID, memberID)
+	if err != nil {
+		return err
+	}
+
+	err = p.repo.UpdateWaitlistEntry(ctx, entry.ID, map[string]any{
+		"status": models.WaitlistLeft,
+	})
+	if err != nil {
+		return err
+	}
+
+	if entry.Status == models.WaitlistPromoted {
+		return p.releaseSlot(ctx, proposalEventID, time.Now())
+	}
+
+	return nil
+}
+
+// ConfirmWaitlistSlot turns the slot reserved for a promoted member into a regular transaction.
+func (p *ProposalEvent) ConfirmWaitlistSlot(ctx context.Context, proposalEventID, memberID uint) error {
+	entry, err := p.repo.GetMemberWaitlistEntry(ctx, proposalEventID, memberID)
+	if err != nil {
+		return err
+	}
+	if entry.Status != models.WaitlistPromoted {
+		return fmt.Errorf("there is no free slot offered to the user yet")
+	}
+	if time.Now().After(entry.ConfirmDeadline.Time) {
+		return fmt.Errorf("time to confirm the slot is over")
+	}
+
+	proposalEvent, err := p.repo.GetEvent(ctx, proposalEventID)
+	if err != nil {
+		return err
+	}
+
+	id, err := p.CreateTransaction(ctx, models.Transaction{
+		CreatorID:            memberID,
+		EventID:              proposalEventID,
+		Comment:              entry.Comment,
+		EventType:            models.ProposalEventType,
+		CreationDate:         time.Now(),
+		TransactionStatus:    models.Waiting,
+		ResponderStatus:      models.NotStarted,
+		Occurrence:           proposalEvent.Occurrence,
+		QuestionnaireAnswers: entry.Answers,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = p.repo.UpdateWaitlistEntry(ctx, entry.ID, map[string]any{
+		"status": models.WaitlistConfirmed,
+	})
+	if err != nil {
+		return err
+	}
+
+	return notifyOrganizers(ctx, p.repo, models.TransactionNotification{
+		EventType:     models.ProposalEventType,
+		EventID:       proposalEventID,
+		Action:        models.Created,
+		TransactionID: id,
+		IsRead:        false,
+		CreationTime:  time.Now(),
+	}, proposalEvent.AuthorID, proposalEvent.CoOrganizers)
+}
+
+func (p *ProposalEvent) GetWaitlist(ctx context.Context, proposalEventID uint) ([]models.WaitlistEntry, error) {
+	return p.repo.GetWaitlist(ctx, proposalEventID)
+}
+
+// releaseTransactionSlot gives the transaction's slot back to the event once
+// and offers it to the head of the waitlist.
+func (p *ProposalEvent) releaseTransactionSlot(ctx context.Context, transaction models.Transaction) error {
+	now := time.Now()
+	head, err := p.repo.ReleaseTransactionSlotToWaitlist(ctx, transaction.ID, now, now.Add(waitlistConfirmWindow))
+
+	return p.notifySlotOffered(ctx, head, now, err)
+}
+
+// releaseSlot gives a slot reserved for a waitlisted member back to the event
+// and offers it to the next member in the waitlist.
+func (p *ProposalEvent) releaseSlot(ctx context.Context, proposalEventID uint, now time.Time) error {
+	head, err := p.repo.ReleaseSlot(ctx, proposalEventID, now, now.Add(waitlistConfirmWindow))
+
+	return p.notifySlotOffered(ctx, head, now, err)
+}
+
+// notifySlotOffered tells the promoted member that a slot is reserved for them,
+// promoteErr is the error of the promotion, models.ErrNotFound means nobody was promoted.
+// The slot stays reserved until the member confirms it, leaves or the confirm window is over.
+func (p *ProposalEvent) notifySlotOffered(ctx context.Context, head models.WaitlistEntry, now time.Time,
+	promoteErr error) error {
+	if errors.Is(promoteErr, models.ErrNotFound) {
+		return nil
+	}
+	if promoteErr != nil {
+		return promoteErr
+	}
+
+	return p.createNotification(ctx, models.TransactionNotification{
+		EventType:    models.ProposalEventType,
+		EventID:      head.EventID,
+		Action:       models.SlotOffered,
+		IsRead:       false,
+		CreationTime: now,
+		MemberID:     head.MemberID,
+	})
+}
+
+// expireWaitlistPromotions drops promoted members who did not confirm their slot until the deadline
+// and offers the slot to the next member. Waitlists of closed events are expired first,
+// so their slots are not offered to anybody.
+func (p *ProposalEvent) expireWaitlistPromotions(ctx context.Context, now time.Time) error {
+	err := p.repo.ExpireClosedEventWaitlists(ctx)
+	if err != nil {
+		return err
+	}
+	entries, err := p.repo.GetExpiredWaitlistPromotions(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	var lastErr error
+	for _, entry := range entries {
+		err = p.repo.UpdateWaitlistEntry(ctx, entry.ID, map[string]any{
+			"status": models.WaitlistExpired,
+		})
+		if err != nil {
+			lastErr = fmt.Errorf("waitlist entry %d: %w", entry.ID, err)
+			continue
+		}
+
+		err = p.releaseSlot(ctx, entry.EventID, now)
+		if err != nil {
+			lastErr = fmt.Errorf("waitlist entry %d: %w", entry.ID, err)
+		}
+	}
+	return lastErr
+}
+
+// hasActiveTransaction checks whether the member takes part in the current occurrence of the event.
+func hasActiveTransaction(proposalEvent models.ProposalEvent, memberID uint) bool {
+	return lo.ContainsBy(proposalEvent.Transactions, func(transaction models.Transaction) bool {
+		return transaction.CreatorID == memberID &&
+			transaction.Occurrence == proposalEvent.Occurrence &&
+			lo.Contains([]models.TransactionStatus{
+				models.Accepted,
+				models.InProcess,
+				models.Waiting,
+			}, transaction.TransactionStatus)
+	})
+}
@@ -0,0 +1,212 @@
+package service_test
+
+import (
+	"Kurajj/internal/models"
+	service "Kurajj/internal/services"
+	mock_service "Kurajj/internal/services/mocks"
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestResponseWhenNoFreeSlots(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().
+		GetEvent(context.TODO(), uint(1)).
+		Return(models.ProposalEvent{
+			ID:                    1,
+			AuthorID:              2,
+			Status:                models.Active,
+			MaxConcurrentRequests: 1,
+			RemainingHelps:        0,
+		}, nil)
+	repo.EXPECT().
+		GetMemberQuotaPolicies(context.TODO(), uint(1)).
+		Return([]models.QuotaPolicy{}, nil)
+	repo.EXPECT().
+		CountPendingResponses(context.TODO(), uint(1)).
+		Return(0, nil)
+
+	proposalEventService := service.NewProposalEvent(repo)
+
+	err := proposalEventService.Response(context.TODO(), 1, 1, 0, "", nil)
+	assert.ErrorIs(t, err, models.ErrNoFreeSlots)
+}
+
+func TestJoinWaitlist(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().
+		GetEvent(context.TODO(), uint(1)).
+		Return(models.ProposalEvent{
+			ID:                    1,
+			AuthorID:              2,
+			Status:                models.Active,
+			MaxConcurrentRequests: 1,
+			RemainingHelps:        0,
+		}, nil)
+	repo.EXPECT().
+		GetMemberWaitlistEntry(context.TODO(), uint(1), uint(3)).
+		Return(models.WaitlistEntry{}, models.ErrNotFound)
+	repo.EXPECT().
+		AddToWaitlist(context.TODO(), gomock.Any()).
+		Return(uint(7), nil)
+	repo.EXPECT().
+		GetWaitlist(context.TODO(), uint(1)).
+		Return([]models.WaitlistEntry{
+			{ID: 5, EventID: 1, MemberID: 4, Status: models.WaitlistWaiting},
+			{ID: 7, EventID: 1, MemberID: 3, Status: models.WaitlistWaiting},
+		}, nil)
+
+	proposalEventService := service.NewProposalEvent(repo)
+
+	resp, err := proposalEventService.JoinWaitlist(context.TODO(), 1, 3, "", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, models.WaitlistJoinResponse{ID: 7, Position: 2}, resp)
+}
+
+func TestUpdateStatusReleasesSlotOnce(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().
+		GetTransactionByID(context.TODO(), uint(1)).
+		Return(models.Transaction{
+			ID:                1,
+			CreatorID:         3,
+			EventID:           2,
+			EventType:         models.ProposalEventType,
+			TransactionStatus: models.InProcess,
+			SlotReleased:      true,
+		}, nil)
+	repo.EXPECT().
+		ReleaseTransactionSlotToWaitlist(context.TODO(), uint(1), gomock.Any(), gomock.Any()).
+		Return(models.WaitlistEntry{}, models.ErrNotFound)
+	repo.EXPECT().
+		UpdateTransactionByID(context.TODO(), uint(1), gomock.Any())
+	repo.EXPECT().
+		CreateNotification(context.TODO(), gomock.Any())
+
+	proposalEventService := service.NewProposalEvent(repo)
+
+	err := proposalEventService.UpdateStatus(context.TODO(), models.Aborted, 1, 3, nil, "", "")
+	assert.NoError(t, err)
+}
+
+func TestUpdateStatusPromotesWaitlistHead(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().
+		GetTransactionByID(context.TODO(), uint(1)).
+		Return(models.Transaction{
+			ID:                1,
+			CreatorID:         3,
+			EventID:           2,
+			EventType:         models.ProposalEventType,
+			TransactionStatus: models.InProcess,
+		}, nil)
+
+	repo.EXPECT().
+		ReleaseTransactionSlotToWaitlist(context.TODO(), uint(1), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uint, promotedAt, confirmDeadline time.Time) (models.WaitlistEntry, error) {
+			assert.Equal(t, 24*time.Hour, confirmDeadline.Sub(promotedAt))
+			return models.WaitlistEntry{ID: 9, EventID: 2, MemberID: 4, Status: models.WaitlistPromoted}, nil
+		})
+	repo.EXPECT().
+		CreateNotification(context.TODO(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, notification models.TransactionNotification) (uint, error) {
+			assert.Equal(t, models.SlotOffered, notification.Action)
+			assert.Equal(t, uint(4), notification.MemberID)
+			return 1, nil
+		})
+	repo.EXPECT().
+		UpdateTransactionByID(context.TODO(), uint(1), gomock.Any())
+	repo.EXPECT().
+		CreateNotification(context.TODO(), gomock.Any())
+
+	proposalEventService := service.NewProposalEvent(repo)
+
+	err := proposalEventService.UpdateStatus(context.TODO(), models.Canceled, 1, 3, nil, "", "")
+	assert.NoError(t, err)
+}
+
+func TestExpireWaitlistPromotionsUsesJobClock(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+	now := time.Date(2026, 10, 8, 12, 0, 0, 0, time.UTC)
+
+	repo.EXPECT().
+		ExpireClosedEventWaitlists(context.TODO())
+	repo.EXPECT().
+		GetExpiredWaitlistPromotions(context.TODO(), now).
+		Return([]models.WaitlistEntry{{ID: 9, EventID: 2, MemberID: 4, Status: models.WaitlistPromoted}}, nil)
+	repo.EXPECT().
+		UpdateWaitlistEntry(context.TODO(), uint(9), map[string]any{"status": models.WaitlistExpired})
+	repo.EXPECT().
+		ReleaseSlot(context.TODO(), uint(2), now, now.Add(24*time.Hour)).
+		Return(models.WaitlistEntry{}, models.ErrNotFound)
+
+	err := service.ExpireWaitlistPromotions(service.NewProposalEvent(repo), context.TODO(), now)
+	assert.NoError(t, err)
+}
+
+func TestJoinWaitlistOfDoneEvent(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().
+		GetEvent(context.TODO(), uint(1)).
+		Return(models.ProposalEvent{
+			ID:                    1,
+			AuthorID:              2,
+			Status:                models.Done,
+			MaxConcurrentRequests: 1,
+			RemainingHelps:        0,
+		}, nil)
+
+	proposalEventService := service.NewProposalEvent(repo)
+
+	_, err := proposalEventService.JoinWaitlist(context.TODO(), 1, 3, "", nil)
+	assert.Error(t, err)
+}
+
+func TestUpdateStatusOfInterruptedTransaction(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	repo := mock_service.NewMockRepositorier(mockCtrl)
+
+	repo.EXPECT().
+		GetTransactionByID(context.TODO(), uint(1)).
+		Return(models.Transaction{
+			ID:                1,
+			CreatorID:         3,
+			EventID:           2,
+			EventType:         models.ProposalEventType,
+			TransactionStatus: models.Interrupted,
+			SlotReleased:      true,
+		}, nil)
+
+	proposalEventService := service.NewProposalEvent(repo)
+
+	err := proposalEventService.UpdateStatus(context.TODO(), models.InProcess, 1, 3, nil, "", "")
+	assert.Error(t, err)
+}
@@ -0,0 +1,7 @@
+package gazetteer
+
+// The tests parse the bundled list and broken ones through these aliases.
+var (
+	Parse          = parse
+	SettlementsCSV = settlementsCSV
+)
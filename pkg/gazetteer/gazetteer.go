@@ -0,0 +1,103 @@
+// Package gazetteer looks up coordinates of Ukrainian settlements in a list bundled with the binary.
+// The list holds the cities of every region and the former names of the renamed ones.
+package gazetteer
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+//go:embed settlements.csv
+var settlementsCSV string
+
+type Settlement struct {
+	Name      string
+	NameEn    string
+	Region    string
+	Latitude  float64
+	Longitude float64
+}
+
+var (
+	loadOnce    sync.Once
+	settlements map[string][]Settlement
+	loadErr     error
+)
+
+// Lookup finds a settlement by its ukrainian or english name. The region is only used to choose
+// between settlements with the same name, it may be empty. The error is the one of loading the list.
+func Lookup(region, city string) (Settlement, bool, error) {
+	loadOnce.Do(func() {
+		settlements, loadErr = parse(settlementsCSV)
+	})
+	if loadErr != nil {
+		return Settlement{}, false, loadErr
+	}
+
+	candidates := settlements[normalize(city)]
+	if len(candidates) == 0 {
+		return Settlement{}, false, nil
+	}
+
+	region = normalize(region)
+	if region != "" {
+		for _, candidate := range candidates {
+			if strings.HasPrefix(region, normalize(candidate.Region)) {
+				return candidate, true, nil
+			}
+		}
+	}
+
+	return candidates[0], true, nil
+}
+
+// parse indexes the settlements of the csv by their normalized names, the first row is the header.
+func parse(data string) (map[string][]Settlement, error) {
+	records, err := csv.NewReader(strings.NewReader(data)).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("could not read settlements: %w", err)
+	}
+	if len(records) < 2 {
+		return nil, fmt.Errorf("there are no settlements")
+	}
+
+	parsed := make(map[string][]Settlement)
+	for i, record := range records[1:] {
+		latitude, err := strconv.ParseFloat(record[3], 64)
+		if err != nil {
+			return nil, fmt.Errorf("settlement on line %d has invalid latitude: %w", i+2, err)
+		}
+		longitude, err := strconv.ParseFloat(record[4], 64)
+		if err != nil {
+			return nil, fmt.Errorf("settlement on line %d has invalid longitude: %w", i+2, err)
+		}
+		settlement := Settlement{
+			Name:      record[0],
+			NameEn:    record[1],
+			Region:    record[2],
+			Latitude:  latitude,
+			Longitude: longitude,
+		}
+		for _, name := range []string{settlement.Name, settlement.NameEn} {
+			key := normalize(name)
+			parsed[key] = append(parsed[key], settlement)
+		}
+	}
+
+	return parsed, nil
+}
+
+// normalize makes names typed by users comparable with the bundled ones.
+func normalize(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	name = strings.NewReplacer("’", "'", "ʼ", "'", "`", "'").Replace(name)
+	for _, prefix := range []string{"м.", "місто "} {
+		name = strings.TrimSpace(strings.TrimPrefix(name, prefix))
+	}
+
+	return name
+}
@@ -0,0 +1,73 @@
+package gazetteer_test
+
+import (
+	"Kurajj/pkg/gazetteer"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestLookup(t *testing.T) {
+	for _, city := range []string{"Львів", " львів ", "м. Львів", "Lviv"} {
+		settlement, ok, err := gazetteer.Lookup("", city)
+		assert.NoError(t, err)
+		assert.True(t, ok, city)
+		assert.Equal(t, "Львів", settlement.Name)
+		assert.InDelta(t, 49.84, settlement.Latitude, 0.01)
+		assert.InDelta(t, 24.03, settlement.Longitude, 0.01)
+	}
+
+	settlement, ok, err := gazetteer.Lookup("Донецька область", "Слов’янськ")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "Донецька", settlement.Region)
+
+	_, ok, err = gazetteer.Lookup("", "Atlantis")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestLookupSettlementsWithTheSameName(t *testing.T) {
+	settlement, ok, err := gazetteer.Lookup("Харківська область", "Золочів")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "Харківська", settlement.Region)
+	assert.InDelta(t, 50.28, settlement.Latitude, 0.01)
+
+	settlement, ok, err = gazetteer.Lookup("Львівська", "Zolochiv")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "Львівська", settlement.Region)
+	assert.InDelta(t, 49.80, settlement.Latitude, 0.01)
+}
+
+func TestLookupFormerName(t *testing.T) {
+	settlement, ok, err := gazetteer.Lookup("", "Кіровоград")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	current, _, _ := gazetteer.Lookup("", "Кропивницький")
+	assert.Equal(t, current.Latitude, settlement.Latitude)
+	assert.Equal(t, current.Longitude, settlement.Longitude)
+}
+
+func TestParseBundledSettlements(t *testing.T) {
+	settlements, err := gazetteer.Parse(gazetteer.SettlementsCSV)
+	assert.NoError(t, err)
+
+	rows := strings.Split(strings.TrimSpace(gazetteer.SettlementsCSV), "\n")[1:]
+	for _, row := range rows {
+		name := strings.Split(row, ",")[0]
+		assert.NotEmpty(t, settlements[strings.ToLower(name)], name)
+	}
+}
+
+func TestParseBrokenSettlements(t *testing.T) {
+	for _, data := range []string{
+		"name_uk,name_en,region_uk,latitude,longitude\nЛьвів,Lviv,Львівська,north,24.03\n",
+		"name_uk,name_en,region_uk,latitude,longitude\nЛьвів,Lviv,Львівська,49.84\n",
+		"name_uk,name_en,region_uk,latitude,longitude\n",
+	} {
+		_, err := gazetteer.Parse(data)
+		assert.Error(t, err)
+	}
+}